@@ -0,0 +1,41 @@
+package root
+
+import (
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/genesis"
+	"github.com/spf13/cobra"
+)
+
+var genesisCmd = &cobra.Command{
+	Use:   "genesis",
+	Short: "Check the genesis file applied by the first input",
+	Long:  `The genesis file lists the initial users, roles, social accounts, platform settings, allowed tokens and issuance fee of the rollup`,
+}
+
+var genesisValidateCmd = &cobra.Command{
+	Use:   "validate <file>",
+	Short: "Validate a genesis file and print its hash",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runGenesisValidate,
+}
+
+func init() {
+	genesisCmd.AddCommand(genesisValidateCmd)
+	Cmd.AddCommand(genesisCmd)
+}
+
+func runGenesisValidate(cmd *cobra.Command, args []string) error {
+	file, err := genesis.Load(args[0])
+	if err != nil {
+		return err
+	}
+	accounts := 0
+	for _, user := range file.Users {
+		accounts += len(user.SocialAccounts)
+	}
+	cmd.Printf("%s is valid (sha256 %s)\n", args[0], file.Hash)
+	cmd.Printf("%d users, %d social accounts, %d platform settings, %d allowed tokens\n", len(file.Users), accounts, len(file.Platforms), len(file.AllowedTokens))
+	if file.IssuanceFee != nil {
+		cmd.Printf("issuance fee %d basis points\n", *file.IssuanceFee)
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package root
+
+import (
+	"time"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/configs"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository/factory"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	migrateDatabaseUrl        string
+	migrateApplicationAddress string
+)
+
+var migrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "Inspect or apply the schema migrations of the database",
+	Long:  `The rollup applies the pending migrations when it starts; these commands let operators check and apply them beforehand`,
+}
+
+var migrateStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "List the migrations and whether each one is applied",
+	Args:  cobra.NoArgs,
+	RunE:  runMigrateStatus,
+}
+
+var migrateUpCmd = &cobra.Command{
+	Use:   "up",
+	Short: "Apply the pending migrations",
+	Args:  cobra.NoArgs,
+	RunE:  runMigrateUp,
+}
+
+func init() {
+	migrateCmd.PersistentFlags().StringVar(&migrateDatabaseUrl, "database-url", "sqlite:///mnt/data/rollup.db", "Database connection string, sqlite:// or postgres://")
+	migrateCmd.PersistentFlags().StringVar(&migrateApplicationAddress, "application-address", "", "Address of the application contract, which receives the backfilled collateral deposits")
+	cobra.CheckErr(viper.BindPFlag(configs.APPLICATION_ADDRESS, migrateCmd.PersistentFlags().Lookup("application-address")))
+	migrateCmd.AddCommand(migrateStatusCmd, migrateUpCmd)
+	Cmd.AddCommand(migrateCmd)
+}
+
+func runMigrateStatus(cmd *cobra.Command, args []string) error {
+	repo, err := factory.ConnectRepositoryFromConnectionString(cmd.Context(), migrateDatabaseUrl)
+	if err != nil {
+		return err
+	}
+	defer repo.Close()
+
+	status, err := repo.MigrationStatus()
+	if err != nil {
+		return err
+	}
+	for _, migration := range status {
+		if migration.AppliedAt == 0 {
+			cmd.Printf("%4d %-32s pending\n", migration.Version, migration.Name)
+			continue
+		}
+		cmd.Printf("%4d %-32s applied at %s\n", migration.Version, migration.Name, time.Unix(migration.AppliedAt, 0).UTC().Format(time.RFC3339))
+	}
+	return nil
+}
+
+func runMigrateUp(cmd *cobra.Command, args []string) error {
+	repo, err := factory.ConnectRepositoryFromConnectionString(cmd.Context(), migrateDatabaseUrl)
+	if err != nil {
+		return err
+	}
+	defer repo.Close()
+
+	applied, err := repo.MigrateUp()
+	if err != nil {
+		return err
+	}
+	for _, migration := range applied {
+		cmd.Printf("%4d %-32s applied\n", migration.Version, migration.Name)
+	}
+	cmd.Printf("%d migrations applied\n", len(applied))
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/configs"
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository/factory"
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/rollup"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/genesis"
 	"github.com/rollmelette/rollmelette"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -20,10 +21,7 @@ const (
 var (
 	maxStartupTime         int
 	databaseUrl            string
-	adminAddress           string
-	adminAddressTest       string
-	verifierAddress        string
-	verifierAddressTest    string
+	genesisFile            string
 	badgeFactoryAddress    string
 	emergencyWithdrawAddr  string
 	safeErc1155MintAddress string
@@ -44,16 +42,13 @@ func init() {
 	cobra.CheckErr(viper.BindPFlag(configs.MAX_STARTUP_TIME, Cmd.Flags().Lookup("max-startup-time")))
 
 	// Database flags
-	Cmd.Flags().StringVar(&databaseUrl, "database-url", "sqlite:///mnt/data/rollup.db", "SQLite database connection string")
+	Cmd.Flags().StringVar(&databaseUrl, "database-url", "sqlite:///mnt/data/rollup.db", "Database connection string, sqlite:// or postgres://")
 	cobra.CheckErr(viper.BindPFlag(configs.DATABASE_URL, Cmd.Flags().Lookup("database-url")))
 
-	// Contracts flags
-	Cmd.Flags().StringVar(&adminAddress, "admin-address", "", "Address of the admin user")
-	cobra.CheckErr(viper.BindPFlag(configs.ADMIN_ADDRESS, Cmd.Flags().Lookup("admin-address")))
-
-	Cmd.Flags().StringVar(&verifierAddress, "verifier-address", "", "Address of the verifier contract")
-	cobra.CheckErr(viper.BindPFlag(configs.VERIFIER_ADDRESS, Cmd.Flags().Lookup("verifier-address")))
+	Cmd.Flags().StringVar(&genesisFile, "genesis-file", "/opt/cartesi/dapp/genesis.json", "Path of the genesis file applied by the first input")
+	cobra.CheckErr(viper.BindPFlag(configs.GENESIS_FILE, Cmd.Flags().Lookup("genesis-file")))
 
+	// Contracts flags
 	Cmd.Flags().StringVar(&badgeFactoryAddress, "badge-factory-address", "", "Address of the badge factory contract")
 	cobra.CheckErr(viper.BindPFlag(configs.BADGE_FACTORY_ADDRESS, Cmd.Flags().Lookup("badge-factory-address")))
 
@@ -89,9 +84,16 @@ func run(cmd *cobra.Command, args []string) {
 
 	defer repo.Close()
 
+	genesisFile, err := genesis.Load(cfg.GenesisFile)
+	if err != nil {
+		slog.Error("Failed to load genesis file", "error", err)
+		os.Exit(1)
+	}
+
 	createInfo := &rollup.CreateInfo{
-		Repo:   repo,
-		Config: cfg,
+		Repo:    repo,
+		Config:  cfg,
+		Genesis: genesisFile,
 	}
 
 	r := rollup.Create(createInfo)
@@ -0,0 +1,75 @@
+package root
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository/factory"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/snapshot"
+	"github.com/spf13/cobra"
+)
+
+var snapshotDatabaseUrl string
+
+var snapshotCmd = &cobra.Command{
+	Use:   "snapshot",
+	Short: "Export or import the whole state as a canonical JSON snapshot",
+	Long:  `Snapshots hold every row of every table sorted by primary key, with the SHA-256 of their content, so equal states always produce the same file`,
+}
+
+var snapshotExportCmd = &cobra.Command{
+	Use:   "export <file>",
+	Short: "Write the state of the database to a snapshot file",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runSnapshotExport,
+}
+
+var snapshotImportCmd = &cobra.Command{
+	Use:   "import <file>",
+	Short: "Restore a snapshot file into an empty database",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runSnapshotImport,
+}
+
+func init() {
+	snapshotCmd.PersistentFlags().StringVar(&snapshotDatabaseUrl, "database-url", "sqlite:///mnt/data/rollup.db", "Database connection string, sqlite:// or postgres://")
+	snapshotCmd.AddCommand(snapshotExportCmd, snapshotImportCmd)
+	Cmd.AddCommand(snapshotCmd)
+}
+
+func runSnapshotExport(cmd *cobra.Command, args []string) error {
+	repo, err := factory.NewRepositoryFromConnectionString(cmd.Context(), snapshotDatabaseUrl)
+	if err != nil {
+		return err
+	}
+	defer repo.Close()
+
+	res, err := snapshot.NewExportSnapshotUseCase(repo).Execute()
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(args[0], res.Content, 0o644); err != nil {
+		return fmt.Errorf("failed to write snapshot: %w", err)
+	}
+	cmd.Printf("exported %d rows to %s (sha256 %s)\n", res.Rows, args[0], res.Hash)
+	return nil
+}
+
+func runSnapshotImport(cmd *cobra.Command, args []string) error {
+	content, err := os.ReadFile(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to read snapshot: %w", err)
+	}
+	repo, err := factory.NewRepositoryFromConnectionString(cmd.Context(), snapshotDatabaseUrl)
+	if err != nil {
+		return err
+	}
+	defer repo.Close()
+
+	res, err := snapshot.NewImportSnapshotUseCase(repo).Execute(&snapshot.ImportSnapshotInputDTO{Content: content})
+	if err != nil {
+		return err
+	}
+	cmd.Printf("imported %d rows from %s (sha256 %s)\n", res.Rows, args[0], res.Hash)
+	return nil
+}
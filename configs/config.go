@@ -23,8 +23,9 @@ func (r Redacted[T]) String() string {
 }
 
 type (
-	Address  = common.Address
-	Duration = time.Duration
+	Address    = common.Address
+	Duration   = time.Duration
+	StringList = []string
 )
 
 // ------------------------------------------------------------------------------------------------
@@ -51,6 +52,17 @@ func ToDurationFromSeconds(s string) (Duration, error) {
 	return time.ParseDuration(s + "s")
 }
 
+// ToStringListFromString splits a comma-separated list, dropping empty items.
+func ToStringListFromString(s string) (StringList, error) {
+	var list StringList
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list, nil
+}
+
 func ToAddressFromString(s string) (Address, error) {
 	if len(s) < 3 || (!strings.HasPrefix(s, "0x") && !strings.HasPrefix(s, "0X")) {
 		return Address{}, fmt.Errorf("invalid address '%s'", s)
@@ -76,17 +88,19 @@ func ToApplicationNameFromString(s string) (string, error) {
 
 // Aliases to be used by the generated functions.
 var (
-	toBool     = strconv.ParseBool
-	toUint64   = ToUint64FromString
-	toString   = ToStringFromString
-	toDuration = ToDurationFromSeconds
-	toAddress  = ToAddressFromString
+	toBool       = strconv.ParseBool
+	toUint64     = ToUint64FromString
+	toString     = ToStringFromString
+	toDuration   = ToDurationFromSeconds
+	toAddress    = ToAddressFromString
+	toStringList = ToStringListFromString
 )
 
 var (
-	notDefinedbool     = func() bool { return false }
-	notDefineduint64   = func() uint64 { return 0 }
-	notDefinedstring   = func() string { return "" }
-	notDefinedDuration = func() Duration { return 0 }
-	notDefinedAddress  = func() Address { return common.Address{} }
+	notDefinedbool       = func() bool { return false }
+	notDefineduint64     = func() uint64 { return 0 }
+	notDefinedstring     = func() string { return "" }
+	notDefinedDuration   = func() Duration { return 0 }
+	notDefinedAddress    = func() Address { return common.Address{} }
+	notDefinedStringList = func() StringList { return nil }
 )
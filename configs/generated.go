@@ -18,24 +18,29 @@ func init() {
 }
 
 const (
-	ADMIN_ADDRESS              = "ADMIN_ADDRESS"
-	ADMIN_ADDRESS_TEST         = "ADMIN_ADDRESS_TEST"
+	ADDRESS_ROTATION_APPROVAL  = "ADDRESS_ROTATION_APPROVAL"
+	APPLICATION_ADDRESS        = "APPLICATION_ADDRESS"
 	BADGE_FACTORY_ADDRESS      = "BADGE_FACTORY_ADDRESS"
 	EMERGENCY_WITHDRAW_ADDRESS = "EMERGENCY_WITHDRAW_ADDRESS"
 	SAFE_ERC1155_MINT_ADDRESS  = "SAFE_ERC1155_MINT_ADDRESS"
-	VERIFIER_ADDRESS           = "VERIFIER_ADDRESS"
-	VERIFIER_ADDRESS_TEST      = "VERIFIER_ADDRESS_TEST"
 	DATABASE_URL               = "DATABASE_URL"
+	EMERGENCY_WITHDRAW_DELAY   = "EMERGENCY_WITHDRAW_DELAY"
+	GOVERNANCE_PROPOSAL_TTL    = "GOVERNANCE_PROPOSAL_TTL"
+	GOVERNANCE_ROUTES          = "GOVERNANCE_ROUTES"
+	GOVERNANCE_THRESHOLD       = "GOVERNANCE_THRESHOLD"
+	REPUTATION_GATES           = "REPUTATION_GATES"
+	GENESIS_FILE               = "GENESIS_FILE"
 	ISSUANCE_FEE               = "ISSUANCE_FEE"
 	MAX_STARTUP_TIME           = "MAX_STARTUP_TIME"
+	SOCIAL_CHALLENGE_TTL       = "SOCIAL_CHALLENGE_TTL"
 )
 
 func SetDefaults() {
 	// Set defaults based on the TOML definitions.
 
-	viper.SetDefault(ADMIN_ADDRESS, "0xD554153658E8D466428Fa48487f5aba18dF5E628")
+	viper.SetDefault(ADDRESS_ROTATION_APPROVAL, "false")
 
-	viper.SetDefault(ADMIN_ADDRESS_TEST, "0x976EA74026E726554dB657fA54763abd0C3a0aa9")
+	// no default for APPLICATION_ADDRESS
 
 	viper.SetDefault(BADGE_FACTORY_ADDRESS, "0x0000000000000000000000000000000000000013")
 
@@ -43,26 +48,33 @@ func SetDefaults() {
 
 	viper.SetDefault(SAFE_ERC1155_MINT_ADDRESS, "0x0000000000000000000000000000000000000007")
 
-	viper.SetDefault(VERIFIER_ADDRESS, "0xc2D8eb4a934AEc7268E414a3Fa3D20E0572d714b")
+	viper.SetDefault(DATABASE_URL, "sqlite:///mnt/data/rollup.db")
 
-	viper.SetDefault(VERIFIER_ADDRESS_TEST, "0x0000000000000000000000000000000000000025")
+	viper.SetDefault(EMERGENCY_WITHDRAW_DELAY, "172800")
 
-	viper.SetDefault(DATABASE_URL, "sqlite:///mnt/data/rollup.db")
+	viper.SetDefault(GOVERNANCE_PROPOSAL_TTL, "86400")
+
+	viper.SetDefault(GOVERNANCE_ROUTES, "user/admin/delete,user/admin/emergency-erc20-withdraw,user/admin/emergency-ether-withdraw")
+
+	viper.SetDefault(GOVERNANCE_THRESHOLD, "1")
+
+	viper.SetDefault(REPUTATION_GATES, "0:0")
+
+	viper.SetDefault(GENESIS_FILE, "/opt/cartesi/dapp/genesis.json")
 
 	viper.SetDefault(ISSUANCE_FEE, "500")
 
 	viper.SetDefault(MAX_STARTUP_TIME, "10")
 
+	viper.SetDefault(SOCIAL_CHALLENGE_TTL, "86400")
+
 }
 
 // RollupConfig holds configuration values for the rollup service.
 type RollupConfig struct {
 
-	// Address of the admin user
-	AdminAddress Address `mapstructure:"ADMIN_ADDRESS"`
-
-	// Address of the admin user
-	AdminAddressTest Address `mapstructure:"ADMIN_ADDRESS_TEST"`
+	// Whether an accepted address rotation also needs the approval of an admin before the user moves to the new address
+	AddressRotationApproval bool `mapstructure:"ADDRESS_ROTATION_APPROVAL"`
 
 	// Address of the badge factory contract, who can deploy the badges
 	BadgeFactoryAddress Address `mapstructure:"BADGE_FACTORY_ADDRESS"`
@@ -73,20 +85,35 @@ type RollupConfig struct {
 	// Address of the safe ERC1155 mint address
 	SafeErc1155MintAddress Address `mapstructure:"SAFE_ERC1155_MINT_ADDRESS"`
 
-	// Address of the verifier contract, who can verify the social accounts
-	VerifierAddress Address `mapstructure:"VERIFIER_ADDRESS"`
+	// Database connection string: sqlite:// for the rollup, or postgres:// for an off-chain replica
+	DatabaseUrl string `mapstructure:"DATABASE_URL"`
 
-	// Address of the verifier contract, who can verify the social accounts
-	VerifierAddressTest Address `mapstructure:"VERIFIER_ADDRESS_TEST"`
+	// Time in seconds a queued emergency withdrawal waits, and can be canceled by any admin, before it can be executed
+	EmergencyWithdrawDelay Duration `mapstructure:"EMERGENCY_WITHDRAW_DELAY"`
 
-	// SQLite database connection string
-	DatabaseUrl string `mapstructure:"DATABASE_URL"`
+	// Time in seconds a proposal can collect approvals before it expires
+	GovernanceProposalTtl Duration `mapstructure:"GOVERNANCE_PROPOSAL_TTL"`
+
+	// Comma-separated advance routes that create a proposal instead of running immediately
+	GovernanceRoutes StringList `mapstructure:"GOVERNANCE_ROUTES"`
+
+	// Number of admin approvals, the proposer included, a governed route needs to run. The default 1 leaves governance effectively off, as every governed route runs as soon as an admin proposes it
+	GovernanceThreshold uint64 `mapstructure:"GOVERNANCE_THRESHOLD"`
+
+	// Comma-separated <debt_issued>:<min_score> pairs. Creating an issuance of at least debt_issued requires a creator reputation score of at least min_score. The default 0:0 disables the gate
+	ReputationGates StringList `mapstructure:"REPUTATION_GATES"`
 
-	// Issuance fee in basis points (e.g., 500 = 5%, 250 = 2.5%, 1000 = 10%)
+	// Path of the genesis file listing the initial users, roles, social accounts, platform settings, allowed tokens and issuance fee. It is applied once, by the first accepted input
+	GenesisFile string `mapstructure:"GENESIS_FILE"`
+
+	// Issuance fee in basis points (e.g., 500 = 5%, 250 = 2.5%, 1000 = 10%). The issuance_fee of the genesis file overrides it
 	IssuanceFee uint64 `mapstructure:"ISSUANCE_FEE"`
 
 	// Maximum startup time for the rollup service
 	MaxStartupTime Duration `mapstructure:"MAX_STARTUP_TIME"`
+
+	// Time in seconds a social account challenge can be used to verify an account after it is requested
+	SocialChallengeTtl Duration `mapstructure:"SOCIAL_CHALLENGE_TTL"`
 }
 
 // LoadRollupConfig reads configuration from environment variables, a config file, and defaults.
@@ -105,18 +132,11 @@ func LoadRollupConfig() (*RollupConfig, error) {
 	var cfg RollupConfig
 	var err error
 
-	cfg.AdminAddress, err = GetAdminAddress()
-	if err != nil && err != ErrNotDefined {
-		return nil, fmt.Errorf("failed to get ADMIN_ADDRESS: %w", err)
-	} else if err == ErrNotDefined {
-		return nil, fmt.Errorf("ADMIN_ADDRESS is required for the rollup service: %w", err)
-	}
-
-	cfg.AdminAddressTest, err = GetAdminAddressTest()
+	cfg.AddressRotationApproval, err = GetAddressRotationApproval()
 	if err != nil && err != ErrNotDefined {
-		return nil, fmt.Errorf("failed to get ADMIN_ADDRESS_TEST: %w", err)
+		return nil, fmt.Errorf("failed to get ADDRESS_ROTATION_APPROVAL: %w", err)
 	} else if err == ErrNotDefined {
-		return nil, fmt.Errorf("ADMIN_ADDRESS_TEST is required for the rollup service: %w", err)
+		return nil, fmt.Errorf("ADDRESS_ROTATION_APPROVAL is required for the rollup service: %w", err)
 	}
 
 	cfg.BadgeFactoryAddress, err = GetBadgeFactoryAddress()
@@ -140,25 +160,53 @@ func LoadRollupConfig() (*RollupConfig, error) {
 		return nil, fmt.Errorf("SAFE_ERC1155_MINT_ADDRESS is required for the rollup service: %w", err)
 	}
 
-	cfg.VerifierAddress, err = GetVerifierAddress()
+	cfg.DatabaseUrl, err = GetDatabaseUrl()
 	if err != nil && err != ErrNotDefined {
-		return nil, fmt.Errorf("failed to get VERIFIER_ADDRESS: %w", err)
+		return nil, fmt.Errorf("failed to get DATABASE_URL: %w", err)
 	} else if err == ErrNotDefined {
-		return nil, fmt.Errorf("VERIFIER_ADDRESS is required for the rollup service: %w", err)
+		return nil, fmt.Errorf("DATABASE_URL is required for the rollup service: %w", err)
 	}
 
-	cfg.VerifierAddressTest, err = GetVerifierAddressTest()
+	cfg.EmergencyWithdrawDelay, err = GetEmergencyWithdrawDelay()
 	if err != nil && err != ErrNotDefined {
-		return nil, fmt.Errorf("failed to get VERIFIER_ADDRESS_TEST: %w", err)
+		return nil, fmt.Errorf("failed to get EMERGENCY_WITHDRAW_DELAY: %w", err)
 	} else if err == ErrNotDefined {
-		return nil, fmt.Errorf("VERIFIER_ADDRESS_TEST is required for the rollup service: %w", err)
+		return nil, fmt.Errorf("EMERGENCY_WITHDRAW_DELAY is required for the rollup service: %w", err)
 	}
 
-	cfg.DatabaseUrl, err = GetDatabaseUrl()
+	cfg.GovernanceProposalTtl, err = GetGovernanceProposalTtl()
 	if err != nil && err != ErrNotDefined {
-		return nil, fmt.Errorf("failed to get DATABASE_URL: %w", err)
+		return nil, fmt.Errorf("failed to get GOVERNANCE_PROPOSAL_TTL: %w", err)
 	} else if err == ErrNotDefined {
-		return nil, fmt.Errorf("DATABASE_URL is required for the rollup service: %w", err)
+		return nil, fmt.Errorf("GOVERNANCE_PROPOSAL_TTL is required for the rollup service: %w", err)
+	}
+
+	cfg.GovernanceRoutes, err = GetGovernanceRoutes()
+	if err != nil && err != ErrNotDefined {
+		return nil, fmt.Errorf("failed to get GOVERNANCE_ROUTES: %w", err)
+	} else if err == ErrNotDefined {
+		return nil, fmt.Errorf("GOVERNANCE_ROUTES is required for the rollup service: %w", err)
+	}
+
+	cfg.GovernanceThreshold, err = GetGovernanceThreshold()
+	if err != nil && err != ErrNotDefined {
+		return nil, fmt.Errorf("failed to get GOVERNANCE_THRESHOLD: %w", err)
+	} else if err == ErrNotDefined {
+		return nil, fmt.Errorf("GOVERNANCE_THRESHOLD is required for the rollup service: %w", err)
+	}
+
+	cfg.ReputationGates, err = GetReputationGates()
+	if err != nil && err != ErrNotDefined {
+		return nil, fmt.Errorf("failed to get REPUTATION_GATES: %w", err)
+	} else if err == ErrNotDefined {
+		return nil, fmt.Errorf("REPUTATION_GATES is required for the rollup service: %w", err)
+	}
+
+	cfg.GenesisFile, err = GetGenesisFile()
+	if err != nil && err != ErrNotDefined {
+		return nil, fmt.Errorf("failed to get GENESIS_FILE: %w", err)
+	} else if err == ErrNotDefined {
+		return nil, fmt.Errorf("GENESIS_FILE is required for the rollup service: %w", err)
 	}
 
 	cfg.IssuanceFee, err = GetIssuanceFee()
@@ -175,33 +223,40 @@ func LoadRollupConfig() (*RollupConfig, error) {
 		return nil, fmt.Errorf("MAX_STARTUP_TIME is required for the rollup service: %w", err)
 	}
 
+	cfg.SocialChallengeTtl, err = GetSocialChallengeTtl()
+	if err != nil && err != ErrNotDefined {
+		return nil, fmt.Errorf("failed to get SOCIAL_CHALLENGE_TTL: %w", err)
+	} else if err == ErrNotDefined {
+		return nil, fmt.Errorf("SOCIAL_CHALLENGE_TTL is required for the rollup service: %w", err)
+	}
+
 	return &cfg, nil
 }
 
-// GetAdminAddress returns the value for the environment variable ADMIN_ADDRESS.
-func GetAdminAddress() (Address, error) {
-	s := viper.GetString(ADMIN_ADDRESS)
+// GetAddressRotationApproval returns the value for the environment variable ADDRESS_ROTATION_APPROVAL.
+func GetAddressRotationApproval() (bool, error) {
+	s := viper.GetString(ADDRESS_ROTATION_APPROVAL)
 	if s != "" {
-		v, err := toAddress(s)
+		v, err := toBool(s)
 		if err != nil {
-			return v, fmt.Errorf("failed to parse %s: %w", ADMIN_ADDRESS, err)
+			return v, fmt.Errorf("failed to parse %s: %w", ADDRESS_ROTATION_APPROVAL, err)
 		}
 		return v, nil
 	}
-	return notDefinedAddress(), fmt.Errorf("%s: %w", ADMIN_ADDRESS, ErrNotDefined)
+	return notDefinedbool(), fmt.Errorf("%s: %w", ADDRESS_ROTATION_APPROVAL, ErrNotDefined)
 }
 
-// GetAdminAddressTest returns the value for the environment variable ADMIN_ADDRESS_TEST.
-func GetAdminAddressTest() (Address, error) {
-	s := viper.GetString(ADMIN_ADDRESS_TEST)
+// GetApplicationAddress returns the value for the environment variable APPLICATION_ADDRESS.
+func GetApplicationAddress() (Address, error) {
+	s := viper.GetString(APPLICATION_ADDRESS)
 	if s != "" {
 		v, err := toAddress(s)
 		if err != nil {
-			return v, fmt.Errorf("failed to parse %s: %w", ADMIN_ADDRESS_TEST, err)
+			return v, fmt.Errorf("failed to parse %s: %w", APPLICATION_ADDRESS, err)
 		}
 		return v, nil
 	}
-	return notDefinedAddress(), fmt.Errorf("%s: %w", ADMIN_ADDRESS_TEST, ErrNotDefined)
+	return notDefinedAddress(), fmt.Errorf("%s: %w", APPLICATION_ADDRESS, ErrNotDefined)
 }
 
 // GetBadgeFactoryAddress returns the value for the environment variable BADGE_FACTORY_ADDRESS.
@@ -243,43 +298,95 @@ func GetSafeErc1155MintAddress() (Address, error) {
 	return notDefinedAddress(), fmt.Errorf("%s: %w", SAFE_ERC1155_MINT_ADDRESS, ErrNotDefined)
 }
 
-// GetVerifierAddress returns the value for the environment variable VERIFIER_ADDRESS.
-func GetVerifierAddress() (Address, error) {
-	s := viper.GetString(VERIFIER_ADDRESS)
+// GetDatabaseUrl returns the value for the environment variable DATABASE_URL.
+func GetDatabaseUrl() (string, error) {
+	s := viper.GetString(DATABASE_URL)
+	if s != "" {
+		v, err := toString(s)
+		if err != nil {
+			return v, fmt.Errorf("failed to parse %s: %w", DATABASE_URL, err)
+		}
+		return v, nil
+	}
+	return notDefinedstring(), fmt.Errorf("%s: %w", DATABASE_URL, ErrNotDefined)
+}
+
+// GetEmergencyWithdrawDelay returns the value for the environment variable EMERGENCY_WITHDRAW_DELAY.
+func GetEmergencyWithdrawDelay() (Duration, error) {
+	s := viper.GetString(EMERGENCY_WITHDRAW_DELAY)
 	if s != "" {
-		v, err := toAddress(s)
+		v, err := toDuration(s)
 		if err != nil {
-			return v, fmt.Errorf("failed to parse %s: %w", VERIFIER_ADDRESS, err)
+			return v, fmt.Errorf("failed to parse %s: %w", EMERGENCY_WITHDRAW_DELAY, err)
 		}
 		return v, nil
 	}
-	return notDefinedAddress(), fmt.Errorf("%s: %w", VERIFIER_ADDRESS, ErrNotDefined)
+	return notDefinedDuration(), fmt.Errorf("%s: %w", EMERGENCY_WITHDRAW_DELAY, ErrNotDefined)
 }
 
-// GetVerifierAddressTest returns the value for the environment variable VERIFIER_ADDRESS_TEST.
-func GetVerifierAddressTest() (Address, error) {
-	s := viper.GetString(VERIFIER_ADDRESS_TEST)
+// GetGovernanceProposalTtl returns the value for the environment variable GOVERNANCE_PROPOSAL_TTL.
+func GetGovernanceProposalTtl() (Duration, error) {
+	s := viper.GetString(GOVERNANCE_PROPOSAL_TTL)
 	if s != "" {
-		v, err := toAddress(s)
+		v, err := toDuration(s)
 		if err != nil {
-			return v, fmt.Errorf("failed to parse %s: %w", VERIFIER_ADDRESS_TEST, err)
+			return v, fmt.Errorf("failed to parse %s: %w", GOVERNANCE_PROPOSAL_TTL, err)
 		}
 		return v, nil
 	}
-	return notDefinedAddress(), fmt.Errorf("%s: %w", VERIFIER_ADDRESS_TEST, ErrNotDefined)
+	return notDefinedDuration(), fmt.Errorf("%s: %w", GOVERNANCE_PROPOSAL_TTL, ErrNotDefined)
 }
 
-// GetDatabaseUrl returns the value for the environment variable DATABASE_URL.
-func GetDatabaseUrl() (string, error) {
-	s := viper.GetString(DATABASE_URL)
+// GetGovernanceRoutes returns the value for the environment variable GOVERNANCE_ROUTES.
+func GetGovernanceRoutes() (StringList, error) {
+	s := viper.GetString(GOVERNANCE_ROUTES)
+	if s != "" {
+		v, err := toStringList(s)
+		if err != nil {
+			return v, fmt.Errorf("failed to parse %s: %w", GOVERNANCE_ROUTES, err)
+		}
+		return v, nil
+	}
+	return notDefinedStringList(), fmt.Errorf("%s: %w", GOVERNANCE_ROUTES, ErrNotDefined)
+}
+
+// GetGovernanceThreshold returns the value for the environment variable GOVERNANCE_THRESHOLD.
+func GetGovernanceThreshold() (uint64, error) {
+	s := viper.GetString(GOVERNANCE_THRESHOLD)
+	if s != "" {
+		v, err := toUint64(s)
+		if err != nil {
+			return v, fmt.Errorf("failed to parse %s: %w", GOVERNANCE_THRESHOLD, err)
+		}
+		return v, nil
+	}
+	return notDefineduint64(), fmt.Errorf("%s: %w", GOVERNANCE_THRESHOLD, ErrNotDefined)
+}
+
+// GetReputationGates returns the value for the environment variable REPUTATION_GATES.
+func GetReputationGates() (StringList, error) {
+	s := viper.GetString(REPUTATION_GATES)
+	if s != "" {
+		v, err := toStringList(s)
+		if err != nil {
+			return v, fmt.Errorf("failed to parse %s: %w", REPUTATION_GATES, err)
+		}
+		return v, nil
+	}
+	return notDefinedStringList(), fmt.Errorf("%s: %w", REPUTATION_GATES, ErrNotDefined)
+}
+
+// GetGenesisFile returns the value for the environment variable GENESIS_FILE.
+func GetGenesisFile() (string, error) {
+	s := viper.GetString(GENESIS_FILE)
 	if s != "" {
 		v, err := toString(s)
 		if err != nil {
-			return v, fmt.Errorf("failed to parse %s: %w", DATABASE_URL, err)
+			return v, fmt.Errorf("failed to parse %s: %w", GENESIS_FILE, err)
 		}
 		return v, nil
 	}
-	return notDefinedstring(), fmt.Errorf("%s: %w", DATABASE_URL, ErrNotDefined)
+	return notDefinedstring(), fmt.Errorf("%s: %w", GENESIS_FILE, ErrNotDefined)
 }
 
 // GetIssuanceFee returns the value for the environment variable ISSUANCE_FEE.
@@ -307,3 +414,16 @@ func GetMaxStartupTime() (Duration, error) {
 	}
 	return notDefinedDuration(), fmt.Errorf("%s: %w", MAX_STARTUP_TIME, ErrNotDefined)
 }
+
+// GetSocialChallengeTtl returns the value for the environment variable SOCIAL_CHALLENGE_TTL.
+func GetSocialChallengeTtl() (Duration, error) {
+	s := viper.GetString(SOCIAL_CHALLENGE_TTL)
+	if s != "" {
+		v, err := toDuration(s)
+		if err != nil {
+			return v, fmt.Errorf("failed to parse %s: %w", SOCIAL_CHALLENGE_TTL, err)
+		}
+		return v, nil
+	}
+	return notDefinedDuration(), fmt.Errorf("%s: %w", SOCIAL_CHALLENGE_TTL, ErrNotDefined)
+}
@@ -0,0 +1,133 @@
+package entity
+
+import (
+	"fmt"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain"
+	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+)
+
+var (
+	ErrAddressRotationNotFound  = domain.ErrAddressRotationNotFound
+	ErrInvalidAddressRotation   = domain.ErrInvalidAddressRotation
+	ErrAddressRotationState     = domain.ErrAddressRotationState
+	ErrAddressRotationForbidden = domain.ErrAddressRotationForbidden
+	ErrAddressRotationPending   = domain.ErrAddressRotationPending
+	ErrAddressRotationStale     = domain.ErrAddressRotationStale
+)
+
+type AddressRotationState string
+
+const (
+	AddressRotationStateProposed  AddressRotationState = "proposed"
+	AddressRotationStateAccepted  AddressRotationState = "accepted"
+	AddressRotationStateCompleted AddressRotationState = "completed"
+	AddressRotationStateCanceled  AddressRotationState = "canceled"
+)
+
+// PendingAddressRotationStates are the states of rotations that have not completed or been
+// canceled yet.
+var PendingAddressRotationStates = []AddressRotationState{
+	AddressRotationStateProposed,
+	AddressRotationStateAccepted,
+}
+
+// AddressRotation moves a user from OldAddress to NewAddress. The old address proposes it,
+// the new address accepts it and, when RequiresApproval is set, an admin approves it.
+type AddressRotation struct {
+	Id               uint                 `json:"id" gorm:"primaryKey"`
+	UserId           uint                 `json:"user_id" gorm:"not null;index"`
+	OldAddress       Address              `json:"old_address" gorm:"types:text;not null"`
+	NewAddress       Address              `json:"new_address" gorm:"types:text;not null"`
+	State            AddressRotationState `json:"state" gorm:"types:text;not null"`
+	RequiresApproval bool                 `json:"requires_approval" gorm:"not null"`
+	ApprovedBy       Address              `json:"approved_by" gorm:"types:text"`
+	AcceptedAt       int64                `json:"accepted_at,omitempty" gorm:"default:0"`
+	CompletedAt      int64                `json:"completed_at,omitempty" gorm:"default:0"`
+	CanceledAt       int64                `json:"canceled_at,omitempty" gorm:"default:0"`
+	CreatedAt        int64                `json:"created_at" gorm:"not null"`
+	UpdatedAt        int64                `json:"updated_at" gorm:"default:0;autoUpdateTime:false"`
+}
+
+func NewAddressRotation(userId uint, oldAddress Address, newAddress Address, requiresApproval bool, createdAt int64) (*AddressRotation, error) {
+	rotation := &AddressRotation{
+		UserId:           userId,
+		OldAddress:       oldAddress,
+		NewAddress:       newAddress,
+		State:            AddressRotationStateProposed,
+		RequiresApproval: requiresApproval,
+		CreatedAt:        createdAt,
+	}
+	if err := rotation.validate(); err != nil {
+		return nil, err
+	}
+	return rotation, nil
+}
+
+func (r *AddressRotation) validate() error {
+	if r.UserId == 0 {
+		return fmt.Errorf("%w: user ID cannot be zero", ErrInvalidAddressRotation)
+	}
+	if r.OldAddress == (Address{}) || r.NewAddress == (Address{}) {
+		return fmt.Errorf("%w: addresses cannot be empty", ErrInvalidAddressRotation)
+	}
+	if r.OldAddress == r.NewAddress {
+		return fmt.Errorf("%w: new address must differ from the current one", ErrInvalidAddressRotation)
+	}
+	if r.CreatedAt == 0 {
+		return fmt.Errorf("%w: creation date is missing", ErrInvalidAddressRotation)
+	}
+	return nil
+}
+
+// Accept records the acceptance of the new address. The rotation completes unless it
+// still needs an admin approval.
+func (r *AddressRotation) Accept(acceptedBy Address, acceptedAt int64) error {
+	if r.State != AddressRotationStateProposed {
+		return ErrAddressRotationState.New(r.Id, r.State)
+	}
+	if acceptedBy != r.NewAddress {
+		return ErrAddressRotationForbidden.New(acceptedBy, "accept", r.Id)
+	}
+	r.State = AddressRotationStateAccepted
+	r.AcceptedAt = acceptedAt
+	r.UpdatedAt = acceptedAt
+	if !r.RequiresApproval {
+		r.complete(acceptedAt)
+	}
+	return nil
+}
+
+// Approve completes an accepted rotation that requires an admin approval.
+func (r *AddressRotation) Approve(approvedBy Address, approvedAt int64) error {
+	if r.State != AddressRotationStateAccepted {
+		return ErrAddressRotationState.New(r.Id, r.State)
+	}
+	r.ApprovedBy = approvedBy
+	r.complete(approvedAt)
+	return nil
+}
+
+// Cancel aborts a rotation that has not completed. Only the old address can cancel it.
+func (r *AddressRotation) Cancel(canceledBy Address, canceledAt int64) error {
+	if r.State != AddressRotationStateProposed && r.State != AddressRotationStateAccepted {
+		return ErrAddressRotationState.New(r.Id, r.State)
+	}
+	if canceledBy != r.OldAddress {
+		return ErrAddressRotationForbidden.New(canceledBy, "cancel", r.Id)
+	}
+	r.State = AddressRotationStateCanceled
+	r.CanceledAt = canceledAt
+	r.UpdatedAt = canceledAt
+	return nil
+}
+
+func (r *AddressRotation) Completed() bool {
+	return r.State == AddressRotationStateCompleted
+}
+
+func (r *AddressRotation) complete(completedAt int64) {
+	r.State = AddressRotationStateCompleted
+	r.CompletedAt = completedAt
+	r.UpdatedAt = completedAt
+}
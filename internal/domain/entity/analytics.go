@@ -0,0 +1,22 @@
+package entity
+
+import (
+	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+	"github.com/holiman/uint256"
+)
+
+// TokenAmounts holds the amounts of a token selected by an aggregate query, one per issuance or
+// order, so they can be summed without losing precision.
+type TokenAmounts struct {
+	Token   Address
+	Amounts []*uint256.Int
+}
+
+// ActivityBucket aggregates the issuances and orders created in [Start, Start+interval).
+type ActivityBucket struct {
+	Start     int64
+	Issuances uint64
+	Orders    uint64
+	Investors uint64
+	Volume    []*TokenAmounts
+}
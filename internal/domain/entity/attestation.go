@@ -0,0 +1,123 @@
+package entity
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain"
+	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+)
+
+var (
+	ErrAttestationNotFound = domain.ErrAttestationNotFound
+	ErrInvalidAttestation  = domain.ErrInvalidAttestation
+	ErrAttestationRevoked  = domain.ErrAttestationRevoked
+)
+
+type AttestationType string
+
+const (
+	AttestationTypeKYC                AttestationType = "kyc"
+	AttestationTypeAccreditedInvestor AttestationType = "accredited_investor"
+	AttestationTypeJurisdiction       AttestationType = "jurisdiction"
+)
+
+func (t AttestationType) valid() bool {
+	switch t {
+	case AttestationTypeKYC, AttestationTypeAccreditedInvestor, AttestationTypeJurisdiction:
+		return true
+	}
+	return false
+}
+
+var evidenceHashPattern = regexp.MustCompile(`^0x[0-9a-fA-F]{64}$`)
+
+// Attestation is a claim about a user, such as a passed KYC check, issued by a verifier
+// who keeps the evidence off-chain. Only the hash of the evidence is recorded.
+type Attestation struct {
+	Id           uint            `json:"id" gorm:"primaryKey"`
+	SubjectId    uint            `json:"subject_id" gorm:"not null;index"`
+	Type         AttestationType `json:"type" gorm:"types:text;not null"`
+	Jurisdiction string          `json:"jurisdiction,omitempty" gorm:"types:text"`
+	Issuer       Address         `json:"issuer" gorm:"types:text;not null"`
+	EvidenceHash string          `json:"evidence_hash" gorm:"types:text;not null"`
+	ExpiresAt    int64           `json:"expires_at" gorm:"not null"`
+	RevokedBy    Address         `json:"revoked_by" gorm:"types:text"`
+	RevokedAt    int64           `json:"revoked_at,omitempty" gorm:"default:0"`
+	CreatedAt    int64           `json:"created_at" gorm:"not null"`
+	UpdatedAt    int64           `json:"updated_at" gorm:"default:0;autoUpdateTime:false"`
+}
+
+func NewAttestation(subjectId uint, attestationType string, jurisdiction string, issuer Address, evidenceHash string, expiresAt int64, createdAt int64) (*Attestation, error) {
+	attestation := &Attestation{
+		SubjectId:    subjectId,
+		Type:         AttestationType(attestationType),
+		Jurisdiction: jurisdiction,
+		Issuer:       issuer,
+		EvidenceHash: evidenceHash,
+		ExpiresAt:    expiresAt,
+		CreatedAt:    createdAt,
+	}
+	if err := attestation.validate(); err != nil {
+		return nil, err
+	}
+	return attestation, nil
+}
+
+func (a *Attestation) validate() error {
+	if a.SubjectId == 0 {
+		return fmt.Errorf("%w: subject ID cannot be zero", ErrInvalidAttestation)
+	}
+	if !a.Type.valid() {
+		return fmt.Errorf("%w: invalid type %q", ErrInvalidAttestation, a.Type)
+	}
+	if (a.Type == AttestationTypeJurisdiction) != (a.Jurisdiction != "") {
+		return fmt.Errorf("%w: jurisdiction must be set only for jurisdiction attestations", ErrInvalidAttestation)
+	}
+	if a.Issuer == (Address{}) {
+		return fmt.Errorf("%w: invalid issuer address", ErrInvalidAttestation)
+	}
+	if !evidenceHashPattern.MatchString(a.EvidenceHash) {
+		return fmt.Errorf("%w: evidence hash must be a 32-byte hex string", ErrInvalidAttestation)
+	}
+	if a.CreatedAt == 0 {
+		return fmt.Errorf("%w: creation date is missing", ErrInvalidAttestation)
+	}
+	if a.ExpiresAt <= a.CreatedAt {
+		return fmt.Errorf("%w: expiration date must be after the creation date", ErrInvalidAttestation)
+	}
+	return nil
+}
+
+// Valid reports whether the attestation is neither revoked nor expired at the given time.
+func (a *Attestation) Valid(at int64) bool {
+	return a.RevokedAt == 0 && at < a.ExpiresAt
+}
+
+func (a *Attestation) Revoke(revokedBy Address, revokedAt int64) error {
+	if a.RevokedAt != 0 {
+		return ErrAttestationRevoked.New(a.Id)
+	}
+	a.RevokedBy = revokedBy
+	a.RevokedAt = revokedAt
+	a.UpdatedAt = revokedAt
+	return nil
+}
+
+// MissingAttestation returns the first required type that none of the attestations
+// covers at the given time.
+func MissingAttestation(required []AttestationType, attestations []*Attestation, at int64) (AttestationType, bool) {
+	for _, t := range required {
+		covered := false
+		for _, a := range attestations {
+			if a.Type == t && a.Valid(at) {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			return t, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,117 @@
+package entity
+
+import (
+	"fmt"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain"
+	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+)
+
+var (
+	ErrInvalidEmergencyWithdrawal    = domain.ErrInvalidEmergencyWithdrawal
+	ErrEmergencyWithdrawalNotFound   = domain.ErrEmergencyWithdrawalNotFound
+	ErrEmergencyWithdrawalNotPending = domain.ErrEmergencyWithdrawalNotPending
+	ErrEmergencyWithdrawalLocked     = domain.ErrEmergencyWithdrawalLocked
+	ErrEmergencyWithdrawalUnlocked   = domain.ErrEmergencyWithdrawalUnlocked
+)
+
+type EmergencyWithdrawalKind string
+
+const (
+	EmergencyWithdrawalKindERC20 EmergencyWithdrawalKind = "erc20"
+	EmergencyWithdrawalKindEther EmergencyWithdrawalKind = "ether"
+)
+
+type EmergencyWithdrawalState string
+
+const (
+	EmergencyWithdrawalStatePending  EmergencyWithdrawalState = "pending"
+	EmergencyWithdrawalStateCanceled EmergencyWithdrawalState = "canceled"
+	EmergencyWithdrawalStateExecuted EmergencyWithdrawalState = "executed"
+)
+
+// EmergencyWithdrawal is a withdrawal of the whole application balance of a token, or of
+// Ether, queued until ExecutableAt so admins can cancel it.
+type EmergencyWithdrawal struct {
+	Id           uint                     `json:"id" gorm:"primaryKey"`
+	Kind         EmergencyWithdrawalKind  `json:"kind,omitempty" gorm:"types:text;not null"`
+	Token        Address                  `json:"token,omitempty" gorm:"types:text"`
+	To           Address                  `json:"to,omitempty" gorm:"types:text;not null"`
+	State        EmergencyWithdrawalState `json:"state,omitempty" gorm:"types:text;not null"`
+	RequestedBy  Address                  `json:"requested_by,omitempty" gorm:"types:text;not null"`
+	CanceledBy   Address                  `json:"canceled_by,omitempty" gorm:"types:text"`
+	ExecutedBy   Address                  `json:"executed_by,omitempty" gorm:"types:text"`
+	ExecutableAt int64                    `json:"executable_at,omitempty" gorm:"not null"`
+	CanceledAt   int64                    `json:"canceled_at,omitempty" gorm:"default:0"`
+	ExecutedAt   int64                    `json:"executed_at,omitempty" gorm:"default:0"`
+	CreatedAt    int64                    `json:"created_at,omitempty" gorm:"not null"`
+	UpdatedAt    int64                    `json:"updated_at,omitempty" gorm:"default:0;autoUpdateTime:false"`
+}
+
+func NewEmergencyWithdrawal(kind EmergencyWithdrawalKind, token Address, to Address, requestedBy Address, executableAt int64, createdAt int64) (*EmergencyWithdrawal, error) {
+	withdrawal := &EmergencyWithdrawal{
+		Kind:         kind,
+		Token:        token,
+		To:           to,
+		State:        EmergencyWithdrawalStatePending,
+		RequestedBy:  requestedBy,
+		ExecutableAt: executableAt,
+		CreatedAt:    createdAt,
+	}
+	if err := withdrawal.validate(); err != nil {
+		return nil, err
+	}
+	return withdrawal, nil
+}
+
+func (w *EmergencyWithdrawal) validate() error {
+	if w.Kind != EmergencyWithdrawalKindERC20 && w.Kind != EmergencyWithdrawalKindEther {
+		return fmt.Errorf("%w: kind must be 'erc20' or 'ether'", ErrInvalidEmergencyWithdrawal)
+	}
+	if w.Kind == EmergencyWithdrawalKindERC20 && w.Token == (Address{}) {
+		return fmt.Errorf("%w: token cannot be empty", ErrInvalidEmergencyWithdrawal)
+	}
+	if w.To == (Address{}) {
+		return fmt.Errorf("%w: destination address cannot be empty", ErrInvalidEmergencyWithdrawal)
+	}
+	if w.RequestedBy == (Address{}) {
+		return fmt.Errorf("%w: requester address cannot be empty", ErrInvalidEmergencyWithdrawal)
+	}
+	if w.CreatedAt == 0 {
+		return fmt.Errorf("%w: creation date is missing", ErrInvalidEmergencyWithdrawal)
+	}
+	if w.ExecutableAt < w.CreatedAt {
+		return fmt.Errorf("%w: execution date cannot be before creation date", ErrInvalidEmergencyWithdrawal)
+	}
+	return nil
+}
+
+// Cancel cancels the withdrawal during its delay. Once ExecutableAt is reached the withdrawal
+// can only be executed.
+func (w *EmergencyWithdrawal) Cancel(canceledBy Address, canceledAt int64) error {
+	if w.State != EmergencyWithdrawalStatePending {
+		return ErrEmergencyWithdrawalNotPending.New(w.Id, w.State)
+	}
+	if canceledAt >= w.ExecutableAt {
+		return ErrEmergencyWithdrawalUnlocked.New(w.Id, w.ExecutableAt)
+	}
+	w.State = EmergencyWithdrawalStateCanceled
+	w.CanceledBy = canceledBy
+	w.CanceledAt = canceledAt
+	w.UpdatedAt = canceledAt
+	return nil
+}
+
+func (w *EmergencyWithdrawal) Execute(executedBy Address, executedAt int64) error {
+	if w.State != EmergencyWithdrawalStatePending {
+		return ErrEmergencyWithdrawalNotPending.New(w.Id, w.State)
+	}
+	if executedAt < w.ExecutableAt {
+		return ErrEmergencyWithdrawalLocked.New(w.Id, w.ExecutableAt)
+	}
+	w.State = EmergencyWithdrawalStateExecuted
+	w.ExecutedBy = executedBy
+	w.ExecutedAt = executedAt
+	w.UpdatedAt = executedAt
+	return nil
+}
@@ -0,0 +1,72 @@
+package entity
+
+import (
+	"fmt"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain"
+	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+)
+
+var (
+	ErrInvalidGenesis        = domain.ErrInvalidGenesis
+	ErrGenesisNotFound       = domain.ErrGenesisNotFound
+	ErrGenesisAlreadyApplied = domain.ErrGenesisAlreadyApplied
+	ErrTokenNotAllowed       = domain.ErrTokenNotAllowed
+)
+
+// Genesis records the genesis file applied to the database by the first accepted input. The
+// table holds at most one row.
+type Genesis struct {
+	Hash       string `json:"hash" gorm:"primaryKey"`
+	InputIndex uint   `json:"input_index" gorm:"not null"`
+	AppliedAt  int64  `json:"applied_at" gorm:"not null"`
+}
+
+func (Genesis) TableName() string {
+	return "genesis"
+}
+
+func NewGenesis(hash string, inputIndex uint, appliedAt int64) (*Genesis, error) {
+	genesis := &Genesis{
+		Hash:       hash,
+		InputIndex: inputIndex,
+		AppliedAt:  appliedAt,
+	}
+	if genesis.Hash == "" {
+		return nil, fmt.Errorf("%w: hash cannot be empty", ErrInvalidGenesis)
+	}
+	if genesis.AppliedAt == 0 {
+		return nil, fmt.Errorf("%w: application date is missing", ErrInvalidGenesis)
+	}
+	return genesis, nil
+}
+
+// AllowedToken is a token issuances may be created with. When no token is allowed explicitly,
+// every token is.
+type AllowedToken struct {
+	Token     Address `json:"token" gorm:"primaryKey;types:text"`
+	CreatedAt int64   `json:"created_at" gorm:"not null"`
+}
+
+func NewAllowedToken(token Address, createdAt int64) (*AllowedToken, error) {
+	if token == (Address{}) {
+		return nil, fmt.Errorf("%w: allowed token cannot be the zero address", ErrInvalidGenesis)
+	}
+	return &AllowedToken{
+		Token:     token,
+		CreatedAt: createdAt,
+	}, nil
+}
+
+// CheckTokenAllowed fails if tokens are restricted and token is not one of them.
+func CheckTokenAllowed(token Address, allowed []*AllowedToken) error {
+	if len(allowed) == 0 {
+		return nil
+	}
+	for _, a := range allowed {
+		if a.Token == token {
+			return nil
+		}
+	}
+	return ErrTokenNotAllowed.New(token)
+}
@@ -1,16 +1,16 @@
 package entity
 
 import (
-	"errors"
 	"fmt"
 
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain"
 	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
 	"github.com/holiman/uint256"
 )
 
 var (
-	ErrIssuanceNotFound = errors.New("issuance not found")
-	ErrInvalidIssuance  = errors.New("invalid issuance")
+	ErrIssuanceNotFound = domain.ErrIssuanceNotFound
+	ErrInvalidIssuance  = domain.ErrInvalidIssuance
 )
 
 type IssuanceState string
@@ -24,44 +24,48 @@ const (
 )
 
 type Issuance struct {
-	Id                uint          `json:"id" gorm:"primaryKey"`
-	Title             string        `json:"title,omitempty" gorm:"not null"`
-	Description       string        `json:"description,omitempty" gorm:"not null"`
-	Promotion         string        `json:"promotion,omitempty" gorm:"not null"`
-	Token             Address       `json:"token,omitempty" gorm:"types:text;not null"`
-	CreatorAddress    Address       `json:"creator_address,omitempty" gorm:"types:text;not null"`
-	CollateralAddress Address       `json:"collateral_address,omitempty" gorm:"types:text;not null"`
-	CollateralAmount  *uint256.Int  `json:"collateral_amount,omitempty" gorm:"types:text;not null"`
-	BadgeAddress      Address       `json:"badge_address,omitempty" gorm:"types:text;not null"`
-	DebtIssued        *uint256.Int  `json:"debt_issued,omitempty" gorm:"types:text;not null"`
-	MaxInterestRate   *uint256.Int  `json:"max_interest_rate,omitempty" gorm:"types:text;not null"`
-	TotalObligation   *uint256.Int  `json:"total_obligation,omitempty" gorm:"types:text;not null;default:0"`
-	TotalRaised       *uint256.Int  `json:"total_raised,omitempty" gorm:"types:text;not null;default:0"`
-	State             IssuanceState `json:"state,omitempty" gorm:"types:text;not null"`
-	Orders            []*Order      `json:"orders,omitempty" gorm:"foreignKey:IssuanceId;constraint:OnDelete:CASCADE"`
-	ClosesAt          int64         `json:"closes_at,omitempty" gorm:"not null"`
-	MaturityAt        int64         `json:"maturity_at,omitempty" gorm:"not null"`
-	CreatedAt         int64         `json:"created_at,omitempty" gorm:"not null"`
-	UpdatedAt         int64         `json:"updated_at,omitempty" gorm:"default:0"`
+	Id                           uint              `json:"id" gorm:"primaryKey"`
+	Title                        string            `json:"title,omitempty" gorm:"not null"`
+	Description                  string            `json:"description,omitempty" gorm:"not null"`
+	Promotion                    string            `json:"promotion,omitempty" gorm:"not null"`
+	Token                        Address           `json:"token,omitempty" gorm:"types:text;not null"`
+	CreatorAddress               Address           `json:"creator_address,omitempty" gorm:"types:text;not null"`
+	CollateralAddress            Address           `json:"collateral_address,omitempty" gorm:"types:text;not null"`
+	CollateralAmount             *uint256.Int      `json:"collateral_amount,omitempty" gorm:"types:text;not null"`
+	BadgeAddress                 Address           `json:"badge_address,omitempty" gorm:"types:text;not null"`
+	DebtIssued                   *uint256.Int      `json:"debt_issued,omitempty" gorm:"types:text;not null"`
+	MaxInterestRate              *uint256.Int      `json:"max_interest_rate,omitempty" gorm:"types:text;not null"`
+	TotalObligation              *uint256.Int      `json:"total_obligation,omitempty" gorm:"types:text;not null;default:0"`
+	TotalRaised                  *uint256.Int      `json:"total_raised,omitempty" gorm:"types:text;not null;default:0"`
+	State                        IssuanceState     `json:"state,omitempty" gorm:"types:text;not null"`
+	RequiredCreatorAttestations  []AttestationType `json:"required_creator_attestations,omitempty" gorm:"serializer:json"`
+	RequiredInvestorAttestations []AttestationType `json:"required_investor_attestations,omitempty" gorm:"serializer:json"`
+	Orders                       []*Order          `json:"orders,omitempty" gorm:"foreignKey:IssuanceId;constraint:OnDelete:CASCADE"`
+	ClosesAt                     int64             `json:"closes_at,omitempty" gorm:"not null"`
+	MaturityAt                   int64             `json:"maturity_at,omitempty" gorm:"not null"`
+	CreatedAt                    int64             `json:"created_at,omitempty" gorm:"not null"`
+	UpdatedAt                    int64             `json:"updated_at,omitempty" gorm:"default:0;autoUpdateTime:false"`
 }
 
-func NewIssuance(title string, description string, promotion string, token Address, creatorAddress Address, collateralAddress Address, collateralAmount *uint256.Int, badgeAddress Address, debtIssued *uint256.Int, maxInterestRate *uint256.Int, closesAt int64, maturityAt int64, createdAt int64) (*Issuance, error) {
+func NewIssuance(title string, description string, promotion string, token Address, creatorAddress Address, collateralAddress Address, collateralAmount *uint256.Int, badgeAddress Address, debtIssued *uint256.Int, maxInterestRate *uint256.Int, requiredCreatorAttestations []AttestationType, requiredInvestorAttestations []AttestationType, closesAt int64, maturityAt int64, createdAt int64) (*Issuance, error) {
 	issuance := &Issuance{
-		Title:             title,
-		Description:       description,
-		Promotion:         promotion,
-		Token:             token,
-		CreatorAddress:    creatorAddress,
-		CollateralAddress: collateralAddress,
-		CollateralAmount:  collateralAmount,
-		BadgeAddress:      badgeAddress,
-		DebtIssued:        debtIssued,
-		MaxInterestRate:   maxInterestRate,
-		State:             IssuanceStateOngoing,
-		Orders:            []*Order{},
-		ClosesAt:          closesAt,
-		MaturityAt:        maturityAt,
-		CreatedAt:         createdAt,
+		Title:                        title,
+		Description:                  description,
+		Promotion:                    promotion,
+		Token:                        token,
+		CreatorAddress:               creatorAddress,
+		CollateralAddress:            collateralAddress,
+		CollateralAmount:             collateralAmount,
+		BadgeAddress:                 badgeAddress,
+		DebtIssued:                   debtIssued,
+		MaxInterestRate:              maxInterestRate,
+		State:                        IssuanceStateOngoing,
+		RequiredCreatorAttestations:  requiredCreatorAttestations,
+		RequiredInvestorAttestations: requiredInvestorAttestations,
+		Orders:                       []*Order{},
+		ClosesAt:                     closesAt,
+		MaturityAt:                   maturityAt,
+		CreatedAt:                    createdAt,
 	}
 	if err := issuance.validate(); err != nil {
 		return nil, err
@@ -100,6 +104,13 @@ func (a *Issuance) validate() error {
 	if a.MaxInterestRate.Sign() == 0 {
 		return fmt.Errorf("%w: max interest rate cannot be zero", ErrInvalidIssuance)
 	}
+	for _, required := range [][]AttestationType{a.RequiredCreatorAttestations, a.RequiredInvestorAttestations} {
+		for _, t := range required {
+			if !t.valid() {
+				return fmt.Errorf("%w: invalid required attestation type %q", ErrInvalidIssuance, t)
+			}
+		}
+	}
 	if a.CreatedAt == 0 {
 		return fmt.Errorf("%w: creation date is missing", ErrInvalidIssuance)
 	}
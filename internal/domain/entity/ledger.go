@@ -0,0 +1,84 @@
+package entity
+
+import (
+	"fmt"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain"
+	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+	"github.com/holiman/uint256"
+)
+
+var (
+	ErrInvalidLedgerEntry = domain.ErrInvalidLedgerEntry
+)
+
+type LedgerReason string
+
+const (
+	LedgerReasonCollateralDeposit   LedgerReason = "collateral_deposit"
+	LedgerReasonOrderEscrow         LedgerReason = "order_escrow"
+	LedgerReasonOrderCancelRefund   LedgerReason = "order_cancel_refund"
+	LedgerReasonOrderRejectRefund   LedgerReason = "order_reject_refund"
+	LedgerReasonIssuanceFee         LedgerReason = "issuance_fee"
+	LedgerReasonIssuanceProceeds    LedgerReason = "issuance_proceeds"
+	LedgerReasonSettlement          LedgerReason = "settlement"
+	LedgerReasonCollateralExecution LedgerReason = "collateral_execution"
+	LedgerReasonFeeWithdrawal       LedgerReason = "fee_withdrawal"
+	LedgerReasonAddressRotation     LedgerReason = "address_rotation"
+)
+
+// LedgerEntry records a transfer of Amount of Token between two application wallets. The
+// debit account receives the tokens and the credit account gives them, so the balance of an
+// account is the sum of its debits minus the sum of its credits.
+type LedgerEntry struct {
+	Id            uint         `json:"id" gorm:"primaryKey"`
+	DebitAccount  Address      `json:"debit_account,omitempty" gorm:"types:text;not null;index"`
+	CreditAccount Address      `json:"credit_account,omitempty" gorm:"types:text;not null;index"`
+	Token         Address      `json:"token,omitempty" gorm:"types:text;not null"`
+	Amount        *uint256.Int `json:"amount,omitempty" gorm:"types:text;not null"`
+	Reason        LedgerReason `json:"reason,omitempty" gorm:"types:text;not null"`
+	IssuanceId    uint         `json:"issuance_id,omitempty" gorm:"default:0;index"`
+	OrderId       uint         `json:"order_id,omitempty" gorm:"default:0"`
+	InputIndex    uint         `json:"input_index" gorm:"not null"`
+	CreatedAt     int64        `json:"created_at,omitempty" gorm:"not null"`
+}
+
+func NewLedgerEntry(debitAccount Address, creditAccount Address, token Address, amount *uint256.Int, reason LedgerReason, issuanceId uint, orderId uint, inputIndex uint, createdAt int64) (*LedgerEntry, error) {
+	entry := &LedgerEntry{
+		DebitAccount:  debitAccount,
+		CreditAccount: creditAccount,
+		Token:         token,
+		Amount:        amount,
+		Reason:        reason,
+		IssuanceId:    issuanceId,
+		OrderId:       orderId,
+		InputIndex:    inputIndex,
+		CreatedAt:     createdAt,
+	}
+	if err := entry.validate(); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+func (e *LedgerEntry) validate() error {
+	if e.DebitAccount == (Address{}) || e.CreditAccount == (Address{}) {
+		return fmt.Errorf("%w: accounts cannot be empty", ErrInvalidLedgerEntry)
+	}
+	if e.DebitAccount == e.CreditAccount {
+		return fmt.Errorf("%w: debit and credit accounts must differ", ErrInvalidLedgerEntry)
+	}
+	if e.Token == (Address{}) {
+		return fmt.Errorf("%w: token cannot be empty", ErrInvalidLedgerEntry)
+	}
+	if e.Amount == nil || e.Amount.Sign() <= 0 {
+		return fmt.Errorf("%w: amount cannot be zero", ErrInvalidLedgerEntry)
+	}
+	if e.Reason == "" {
+		return fmt.Errorf("%w: reason cannot be empty", ErrInvalidLedgerEntry)
+	}
+	if e.CreatedAt == 0 {
+		return fmt.Errorf("%w: creation date is missing", ErrInvalidLedgerEntry)
+	}
+	return nil
+}
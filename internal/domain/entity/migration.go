@@ -0,0 +1,13 @@
+package entity
+
+// Migration is a row of the schema_migrations table, recording a schema migration applied to
+// the database. AppliedAt is zero for migrations still pending.
+type Migration struct {
+	Version   uint   `json:"version" gorm:"primaryKey;autoIncrement:false"`
+	Name      string `json:"name" gorm:"not null"`
+	AppliedAt int64  `json:"applied_at" gorm:"not null"`
+}
+
+func (Migration) TableName() string {
+	return "schema_migrations"
+}
@@ -1,16 +1,16 @@
 package entity
 
 import (
-	"errors"
 	"fmt"
 
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain"
 	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
 	"github.com/holiman/uint256"
 )
 
 var (
-	ErrInvalidOrder  = errors.New("invalid order")
-	ErrOrderNotFound = errors.New("order not found")
+	ErrInvalidOrder  = domain.ErrInvalidOrder
+	ErrOrderNotFound = domain.ErrOrderNotFound
 )
 
 type OrderState string
@@ -33,7 +33,7 @@ type Order struct {
 	InterestRate    *uint256.Int `json:"interest_rate,omitempty" gorm:"types:text;not null"`
 	State           OrderState   `json:"state,omitempty" gorm:"types:text;not null"`
 	CreatedAt       int64        `json:"created_at,omitempty" gorm:"not null"`
-	UpdatedAt       int64        `json:"updated_at,omitempty" gorm:"default:0"`
+	UpdatedAt       int64        `json:"updated_at,omitempty" gorm:"default:0;autoUpdateTime:false"`
 }
 
 func NewOrder(issuanceId uint, investorAddress Address, amount *uint256.Int, interestRate *uint256.Int, state OrderState, createdAt int64) (*Order, error) {
@@ -0,0 +1,58 @@
+package entity
+
+// Permission is a named capability granted to users through their roles.
+type Permission string
+
+const (
+	PermissionUserManage        Permission = "user.manage"
+	PermissionRoleManage        Permission = "role.manage"
+	PermissionEmergencyWithdraw Permission = "emergency.withdraw"
+	PermissionEmergencyCancel   Permission = "emergency.cancel"
+	PermissionProposalApprove   Permission = "proposal.approve"
+	PermissionIssuanceCreate    Permission = "issuance.create"
+	PermissionIssuanceSettle    Permission = "issuance.settle"
+	PermissionOrderCreate       Permission = "order.create"
+	PermissionOrderCancel       Permission = "order.cancel"
+	PermissionSocialVerify      Permission = "social.verify"
+	PermissionSocialManage      Permission = "social.manage"
+	PermissionAttestationIssue  Permission = "attestation.issue"
+	PermissionAttestationRevoke Permission = "attestation.revoke"
+)
+
+var rolePermissions = map[UserRole][]Permission{
+	UserRoleAdmin: {
+		PermissionUserManage,
+		PermissionRoleManage,
+		PermissionEmergencyWithdraw,
+		PermissionEmergencyCancel,
+		PermissionProposalApprove,
+		PermissionSocialManage,
+	},
+	UserRoleCreator: {
+		PermissionIssuanceCreate,
+		PermissionIssuanceSettle,
+	},
+	UserRoleInvestor: {
+		PermissionOrderCreate,
+		PermissionOrderCancel,
+	},
+	UserRoleVerifier: {
+		PermissionSocialVerify,
+		PermissionAttestationIssue,
+		PermissionAttestationRevoke,
+	},
+}
+
+// Permissions returns the permissions granted by the role.
+func (r UserRole) Permissions() []Permission {
+	return append([]Permission{}, rolePermissions[r]...)
+}
+
+func (r UserRole) Grants(permission Permission) bool {
+	for _, p := range rolePermissions[r] {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,168 @@
+package entity
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain"
+	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+)
+
+var (
+	ErrUnsupportedPlatform = domain.ErrUnsupportedPlatform
+	ErrPlatformDisabled    = domain.ErrPlatformDisabled
+)
+
+type Platform string
+
+const (
+	PlatformTwitter   Platform = "twitter"
+	PlatformInstagram Platform = "instagram"
+	PlatformGitHub    Platform = "github"
+	PlatformLinkedIn  Platform = "linkedin"
+	PlatformYouTube   Platform = "youtube"
+	PlatformTikTok    Platform = "tiktok"
+	PlatformFarcaster Platform = "farcaster"
+	PlatformENS       Platform = "ens"
+)
+
+// PlatformSpec describes the usernames of a social platform. Usernames are canonicalized
+// before being validated against the length limits and the pattern.
+type PlatformSpec struct {
+	Platform  Platform
+	MinLength int
+	MaxLength int
+	Pattern   *regexp.Regexp
+	// Canonicalize defaults to CanonicalUsername when nil.
+	Canonicalize func(username string) string
+}
+
+// CanonicalUsername trims the username, strips a leading @ and lowercases it.
+func CanonicalUsername(username string) string {
+	return strings.ToLower(strings.TrimPrefix(strings.TrimSpace(username), "@"))
+}
+
+func (s PlatformSpec) canonical(username string) string {
+	if s.Canonicalize == nil {
+		return CanonicalUsername(username)
+	}
+	return s.Canonicalize(username)
+}
+
+// Username returns the canonical form of username, failing if it is not valid on the platform.
+func (s PlatformSpec) Username(username string) (string, error) {
+	canonical := s.canonical(username)
+	if len(canonical) < s.MinLength || len(canonical) > s.MaxLength {
+		return "", fmt.Errorf("%s usernames must have between %d and %d characters", s.Platform, s.MinLength, s.MaxLength)
+	}
+	if !s.Pattern.MatchString(canonical) {
+		return "", fmt.Errorf("%q is not a valid %s username", canonical, s.Platform)
+	}
+	return canonical, nil
+}
+
+var (
+	platforms     = map[Platform]PlatformSpec{}
+	platformOrder []Platform
+)
+
+// RegisterPlatform adds a platform to the registry. It panics if the platform is already
+// registered, so it is meant to be called during initialization.
+func RegisterPlatform(spec PlatformSpec) {
+	if _, exists := platforms[spec.Platform]; exists {
+		panic(fmt.Sprintf("duplicate social platform: %s", spec.Platform))
+	}
+	platforms[spec.Platform] = spec
+	platformOrder = append(platformOrder, spec.Platform)
+}
+
+func init() {
+	RegisterPlatform(PlatformSpec{Platform: PlatformTwitter, MinLength: 1, MaxLength: 15, Pattern: regexp.MustCompile(`^[a-z0-9_]+$`)})
+	RegisterPlatform(PlatformSpec{Platform: PlatformInstagram, MinLength: 1, MaxLength: 30, Pattern: regexp.MustCompile(`^[a-z0-9_]([a-z0-9._]*[a-z0-9_])?$`)})
+	RegisterPlatform(PlatformSpec{Platform: PlatformGitHub, MinLength: 1, MaxLength: 39, Pattern: regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)})
+	RegisterPlatform(PlatformSpec{Platform: PlatformLinkedIn, MinLength: 3, MaxLength: 100, Pattern: regexp.MustCompile(`^[a-z0-9-]+$`)})
+	RegisterPlatform(PlatformSpec{Platform: PlatformYouTube, MinLength: 3, MaxLength: 30, Pattern: regexp.MustCompile(`^[a-z0-9_-]([a-z0-9._-]*[a-z0-9_-])?$`)})
+	RegisterPlatform(PlatformSpec{Platform: PlatformTikTok, MinLength: 2, MaxLength: 24, Pattern: regexp.MustCompile(`^[a-z0-9_.]*[a-z0-9_]$`)})
+	RegisterPlatform(PlatformSpec{Platform: PlatformFarcaster, MinLength: 1, MaxLength: 16, Pattern: regexp.MustCompile(`^[a-z0-9][a-z0-9-]*$`)})
+	RegisterPlatform(PlatformSpec{Platform: PlatformENS, MinLength: 7, MaxLength: 255, Pattern: regexp.MustCompile(`^([a-z0-9-]+\.)+eth$`)})
+}
+
+// LookupPlatform returns the spec of a registered platform.
+func LookupPlatform(platform Platform) (PlatformSpec, bool) {
+	spec, ok := platforms[platform]
+	return spec, ok
+}
+
+// Platforms returns the registered platforms in registration order.
+func Platforms() []Platform {
+	return append([]Platform{}, platformOrder...)
+}
+
+func (p Platform) valid() bool {
+	_, ok := platforms[p]
+	return ok
+}
+
+// canonical canonicalizes username if the platform is registered, leaving validation to
+// canonicalUsername.
+func (p Platform) canonical(username string) string {
+	if spec, ok := LookupPlatform(p); ok {
+		return spec.canonical(username)
+	}
+	return username
+}
+
+// canonicalUsername returns the canonical form of username on the platform.
+func (p Platform) canonicalUsername(username string) (string, error) {
+	spec, ok := LookupPlatform(p)
+	if !ok {
+		return "", ErrUnsupportedPlatform.New(p)
+	}
+	return spec.Username(username)
+}
+
+// PlatformSetting records whether admins enabled a platform. Registered platforms without a
+// setting are enabled.
+type PlatformSetting struct {
+	Platform  Platform `json:"platform" gorm:"primaryKey;types:text"`
+	Enabled   bool     `json:"enabled" gorm:"not null"`
+	UpdatedBy Address  `json:"updated_by" gorm:"types:text"`
+	UpdatedAt int64    `json:"updated_at" gorm:"default:0;autoUpdateTime:false"`
+}
+
+func NewPlatformSetting(platform string, enabled bool, updatedBy Address, updatedAt int64) (*PlatformSetting, error) {
+	if !Platform(platform).valid() {
+		return nil, ErrUnsupportedPlatform.New(platform)
+	}
+	return &PlatformSetting{
+		Platform:  Platform(platform),
+		Enabled:   enabled,
+		UpdatedBy: updatedBy,
+		UpdatedAt: updatedAt,
+	}, nil
+}
+
+// PlatformEnabled reports whether the platform is registered and not disabled by settings.
+func PlatformEnabled(platform Platform, settings []*PlatformSetting) bool {
+	if !platform.valid() {
+		return false
+	}
+	for _, setting := range settings {
+		if setting.Platform == platform {
+			return setting.Enabled
+		}
+	}
+	return true
+}
+
+// CheckPlatformEnabled fails if the platform is unsupported or disabled by settings.
+func CheckPlatformEnabled(platform Platform, settings []*PlatformSetting) error {
+	if !platform.valid() {
+		return ErrUnsupportedPlatform.New(platform)
+	}
+	if !PlatformEnabled(platform, settings) {
+		return ErrPlatformDisabled.New(platform)
+	}
+	return nil
+}
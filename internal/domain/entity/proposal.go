@@ -0,0 +1,116 @@
+package entity
+
+import (
+	"fmt"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain"
+	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+)
+
+var (
+	ErrInvalidProposal         = domain.ErrInvalidProposal
+	ErrProposalNotFound        = domain.ErrProposalNotFound
+	ErrProposalExpired         = domain.ErrProposalExpired
+	ErrProposalNotPending      = domain.ErrProposalNotPending
+	ErrProposalAlreadyApproved = domain.ErrProposalAlreadyApproved
+	ErrAdminsBelowThreshold    = domain.ErrAdminsBelowThreshold
+)
+
+type ProposalState string
+
+const (
+	ProposalStatePending  ProposalState = "pending"
+	ProposalStateExecuted ProposalState = "executed"
+)
+
+// Proposal is a governed advance input waiting for the approval of Threshold admins.
+type Proposal struct {
+	Id        uint                `json:"id" gorm:"primaryKey"`
+	Path      string              `json:"path,omitempty" gorm:"not null"`
+	Payload   string              `json:"payload,omitempty" gorm:"type:text"`
+	Proposer  Address             `json:"proposer,omitempty" gorm:"types:text;not null"`
+	Threshold uint                `json:"threshold,omitempty" gorm:"not null"`
+	State     ProposalState       `json:"state,omitempty" gorm:"types:text;not null"`
+	Approvals []*ProposalApproval `json:"approvals,omitempty" gorm:"foreignKey:ProposalId;constraint:OnDelete:CASCADE"`
+	ExpiresAt int64               `json:"expires_at,omitempty" gorm:"not null"`
+	CreatedAt int64               `json:"created_at,omitempty" gorm:"not null"`
+	UpdatedAt int64               `json:"updated_at,omitempty" gorm:"default:0;autoUpdateTime:false"`
+}
+
+type ProposalApproval struct {
+	ProposalId uint    `json:"proposal_id" gorm:"primaryKey"`
+	Approver   Address `json:"approver" gorm:"types:text;primaryKey"`
+	CreatedAt  int64   `json:"created_at" gorm:"not null"`
+}
+
+// NewProposal creates a pending proposal already approved by its proposer.
+func NewProposal(path string, payload string, proposer Address, threshold uint, expiresAt int64, createdAt int64) (*Proposal, error) {
+	proposal := &Proposal{
+		Path:      path,
+		Payload:   payload,
+		Proposer:  proposer,
+		Threshold: threshold,
+		State:     ProposalStatePending,
+		Approvals: []*ProposalApproval{{Approver: proposer, CreatedAt: createdAt}},
+		ExpiresAt: expiresAt,
+		CreatedAt: createdAt,
+	}
+	if err := proposal.validate(); err != nil {
+		return nil, err
+	}
+	return proposal, nil
+}
+
+func (p *Proposal) validate() error {
+	if p.Path == "" {
+		return fmt.Errorf("%w: path cannot be empty", ErrInvalidProposal)
+	}
+	if p.Proposer == (Address{}) {
+		return fmt.Errorf("%w: proposer address cannot be empty", ErrInvalidProposal)
+	}
+	if p.Threshold == 0 {
+		return fmt.Errorf("%w: threshold cannot be zero", ErrInvalidProposal)
+	}
+	if p.CreatedAt == 0 {
+		return fmt.Errorf("%w: creation date is missing", ErrInvalidProposal)
+	}
+	if p.ExpiresAt <= p.CreatedAt {
+		return fmt.Errorf("%w: expiration date must be after creation date", ErrInvalidProposal)
+	}
+	return nil
+}
+
+// Approve records the approval of approver and returns it.
+func (p *Proposal) Approve(approver Address, approvedAt int64) (*ProposalApproval, error) {
+	if p.State != ProposalStatePending {
+		return nil, ErrProposalNotPending.New(p.Id, p.State)
+	}
+	if approvedAt > p.ExpiresAt {
+		return nil, ErrProposalExpired.New(p.Id, p.ExpiresAt)
+	}
+	for _, approval := range p.Approvals {
+		if approval.Approver == approver {
+			return nil, ErrProposalAlreadyApproved.New(approver, p.Id)
+		}
+	}
+	approval := &ProposalApproval{ProposalId: p.Id, Approver: approver, CreatedAt: approvedAt}
+	p.Approvals = append(p.Approvals, approval)
+	p.UpdatedAt = approvedAt
+	return approval, nil
+}
+
+// Approved reports whether the proposal collected enough approvals to run. Only the approvals
+// of admins, the active users holding the admin role, count, so revoking or deactivating an
+// admin withdraws the approvals they gave.
+func (p *Proposal) Approved(admins []*User) bool {
+	var approvals uint
+	for _, approval := range p.Approvals {
+		for _, admin := range admins {
+			if admin.Address == approval.Approver && admin.Active() && admin.HasRole(UserRoleAdmin) {
+				approvals++
+				break
+			}
+		}
+	}
+	return approvals >= p.Threshold
+}
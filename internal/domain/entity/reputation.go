@@ -0,0 +1,19 @@
+package entity
+
+import (
+	"github.com/holiman/uint256"
+)
+
+// CreatorReputation is the track record of a creator and the score derived from it. It is
+// recomputed from the issuances of the creator on every issuance lifecycle transition.
+type CreatorReputation struct {
+	UserId               uint         `json:"-" gorm:"primaryKey"`
+	Score                uint64       `json:"score" gorm:"not null"`
+	Issuances            uint64       `json:"issuances" gorm:"not null;default:0"`
+	SettledOnTime        uint64       `json:"settled_on_time" gorm:"not null;default:0"`
+	SettledLate          uint64       `json:"settled_late" gorm:"not null;default:0"`
+	CollateralExecutions uint64       `json:"collateral_executions" gorm:"not null;default:0"`
+	Cancellations        uint64       `json:"cancellations" gorm:"not null;default:0"`
+	TotalVolume          *uint256.Int `json:"total_volume" gorm:"types:text;not null;default:0"`
+	UpdatedAt            int64        `json:"updated_at" gorm:"default:0;autoUpdateTime:false"`
+}
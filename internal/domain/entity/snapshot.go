@@ -0,0 +1,26 @@
+package entity
+
+import (
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain"
+)
+
+var (
+	ErrInvalidSnapshot      = domain.ErrInvalidSnapshot
+	ErrSnapshotHashMismatch = domain.ErrSnapshotHashMismatch
+	ErrRepositoryNotEmpty   = domain.ErrRepositoryNotEmpty
+)
+
+// SnapshotVersion is the version of the snapshot format written by this build.
+const SnapshotVersion = 1
+
+// SnapshotRow is a table row keyed by column name. Values are integers, strings or nil, as
+// stored in the database.
+type SnapshotRow map[string]any
+
+// Snapshot holds every row of every table, sorted by primary key. Hash is the hex encoded
+// SHA-256 of the canonical JSON encoding of Tables.
+type Snapshot struct {
+	Version uint                     `json:"version"`
+	Hash    string                   `json:"hash"`
+	Tables  map[string][]SnapshotRow `json:"tables"`
+}
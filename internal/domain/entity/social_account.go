@@ -1,36 +1,45 @@
 package entity
 
 import (
-	"errors"
 	"fmt"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain"
+	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
 )
 
 var (
-	ErrInvalidSocialAccount  = errors.New("invalid social account")
-	ErrSocialAccountNotFound = errors.New("social account not found")
+	ErrInvalidSocialAccount  = domain.ErrInvalidSocialAccount
+	ErrSocialAccountNotFound = domain.ErrSocialAccountNotFound
 )
 
-type Platform string
-
-const (
-	PlatformTwitter   Platform = "twitter"
-	PlatformInstagram Platform = "instagram"
-)
+// SocialAccountEvidence proves that the owner of a wallet controls a social account: the
+// post holding the challenge nonce and the wallet signature of the challenge message.
+type SocialAccountEvidence struct {
+	ChallengeId uint    `json:"challenge_id" gorm:"not null;default:0"`
+	PostId      string  `json:"post_id" gorm:"types:text"`
+	ContentHash string  `json:"content_hash" gorm:"types:text"`
+	Signature   string  `json:"signature" gorm:"types:text"`
+	VerifiedBy  Address `json:"verified_by" gorm:"types:text"`
+}
 
+// SocialAccount is a social account verified to belong to a user. Its Evidence is only
+// exposed by the social account routes, keeping the user payloads compact.
 type SocialAccount struct {
-	Id        uint     `json:"id" gorm:"primaryKey"`
-	UserId    uint     `json:"user_id,omitempty" gorm:"not null"`
-	Username  string   `json:"username,omitempty" gorm:"types:text;not null;uniqueIndex:idx_username_platform"`
-	Platform  Platform `json:"platform,omitempty" gorm:"not null;uniqueIndex:idx_username_platform"`
-	CreatedAt int64    `json:"created_at,omitempty" gorm:"not null"`
-	UpdatedAt int64    `json:"updated_at,omitempty" gorm:"default:0"`
+	Id        uint                  `json:"id" gorm:"primaryKey"`
+	UserId    uint                  `json:"user_id,omitempty" gorm:"not null"`
+	Username  string                `json:"username,omitempty" gorm:"types:text;not null;uniqueIndex:idx_username_platform"`
+	Platform  Platform              `json:"platform,omitempty" gorm:"not null;uniqueIndex:idx_username_platform"`
+	Evidence  SocialAccountEvidence `json:"-" gorm:"embedded;embeddedPrefix:evidence_"`
+	CreatedAt int64                 `json:"created_at,omitempty" gorm:"not null"`
+	UpdatedAt int64                 `json:"updated_at,omitempty" gorm:"default:0;autoUpdateTime:false"`
 }
 
-func NewSocialAccount(userID uint, username string, platform string, createdAt int64) (*SocialAccount, error) {
+func NewSocialAccount(userID uint, username string, platform string, evidence SocialAccountEvidence, createdAt int64) (*SocialAccount, error) {
 	socialAccount := &SocialAccount{
 		UserId:    userID,
-		Username:  username,
+		Username:  Platform(platform).canonical(username),
 		Platform:  Platform(platform),
+		Evidence:  evidence,
 		CreatedAt: createdAt,
 	}
 	if err := socialAccount.validate(); err != nil {
@@ -39,18 +48,52 @@ func NewSocialAccount(userID uint, username string, platform string, createdAt i
 	return socialAccount, nil
 }
 
+// NewGenesisSocialAccount creates a social account listed in the genesis file. It carries no
+// ownership evidence: the operator who wrote the genesis vouches for it.
+func NewGenesisSocialAccount(userID uint, username string, platform string, createdAt int64) (*SocialAccount, error) {
+	socialAccount := &SocialAccount{
+		UserId:    userID,
+		Username:  Platform(platform).canonical(username),
+		Platform:  Platform(platform),
+		CreatedAt: createdAt,
+	}
+	if err := socialAccount.validateAccount(); err != nil {
+		return nil, err
+	}
+	return socialAccount, nil
+}
+
 func (s *SocialAccount) validate() error {
+	if err := s.validateAccount(); err != nil {
+		return err
+	}
+	if s.Evidence.ChallengeId == 0 || s.Evidence.PostId == "" || s.Evidence.Signature == "" {
+		return fmt.Errorf("%w: ownership evidence is missing", ErrInvalidSocialAccount)
+	}
+	if !evidenceHashPattern.MatchString(s.Evidence.ContentHash) {
+		return fmt.Errorf("%w: content hash must be a 32-byte hex string", ErrInvalidSocialAccount)
+	}
+	if s.Evidence.VerifiedBy == (Address{}) {
+		return fmt.Errorf("%w: invalid verifier address", ErrInvalidSocialAccount)
+	}
+	return nil
+}
+
+// validateAccount checks the account itself, leaving its evidence aside.
+func (s *SocialAccount) validateAccount() error {
 	if s.UserId == 0 {
 		return fmt.Errorf("%w: user ID cannot be zero", ErrInvalidSocialAccount)
 	}
-	if s.Username == "" {
-		return fmt.Errorf("%w: username cannot be empty", ErrInvalidSocialAccount)
-	}
 	if s.Platform == "" {
 		return fmt.Errorf("%w: platform cannot be empty", ErrInvalidSocialAccount)
 	}
-	if s.Platform != PlatformTwitter && s.Platform != PlatformInstagram {
-		return fmt.Errorf("%w: platform must be 'twitter' or 'instagram'", ErrInvalidSocialAccount)
+	if !s.Platform.valid() {
+		return ErrUnsupportedPlatform.New(s.Platform)
+	}
+	if username, err := s.Platform.canonicalUsername(s.Username); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidSocialAccount, err)
+	} else if username != s.Username {
+		return fmt.Errorf("%w: username must be in canonical form %q", ErrInvalidSocialAccount, username)
 	}
 	if s.CreatedAt == 0 {
 		return fmt.Errorf("%w: creation date is missing", ErrInvalidSocialAccount)
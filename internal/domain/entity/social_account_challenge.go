@@ -0,0 +1,123 @@
+package entity
+
+import (
+	"fmt"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain"
+	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var (
+	ErrSocialChallengeNotFound = domain.ErrSocialChallengeNotFound
+	ErrInvalidSocialChallenge  = domain.ErrInvalidSocialChallenge
+	ErrSocialChallengeUsed     = domain.ErrSocialChallengeUsed
+	ErrSocialChallengeExpired  = domain.ErrSocialChallengeExpired
+	ErrInvalidSocialSignature  = domain.ErrInvalidSocialSignature
+)
+
+// SocialAccountChallenge binds a social account to the wallet that requested it. The user
+// publishes the nonce on the platform and signs Message with the wallet, then a verifier
+// submits both to register the account.
+type SocialAccountChallenge struct {
+	Id        uint     `json:"id" gorm:"primaryKey"`
+	UserId    uint     `json:"user_id" gorm:"not null;index"`
+	Address   Address  `json:"address" gorm:"types:text;not null"`
+	Username  string   `json:"username" gorm:"types:text;not null"`
+	Platform  Platform `json:"platform" gorm:"types:text;not null"`
+	Nonce     string   `json:"nonce" gorm:"types:text;not null;uniqueIndex"`
+	ExpiresAt int64    `json:"expires_at" gorm:"not null"`
+	UsedAt    int64    `json:"used_at,omitempty" gorm:"default:0"`
+	CreatedAt int64    `json:"created_at" gorm:"not null"`
+	UpdatedAt int64    `json:"updated_at" gorm:"default:0;autoUpdateTime:false"`
+}
+
+func NewSocialAccountChallenge(userId uint, address Address, username string, platform string, nonce string, expiresAt int64, createdAt int64) (*SocialAccountChallenge, error) {
+	challenge := &SocialAccountChallenge{
+		UserId:    userId,
+		Address:   address,
+		Username:  Platform(platform).canonical(username),
+		Platform:  Platform(platform),
+		Nonce:     nonce,
+		ExpiresAt: expiresAt,
+		CreatedAt: createdAt,
+	}
+	if err := challenge.validate(); err != nil {
+		return nil, err
+	}
+	return challenge, nil
+}
+
+func (c *SocialAccountChallenge) validate() error {
+	if c.UserId == 0 {
+		return fmt.Errorf("%w: user ID cannot be zero", ErrInvalidSocialChallenge)
+	}
+	if c.Address == (Address{}) {
+		return fmt.Errorf("%w: invalid address", ErrInvalidSocialChallenge)
+	}
+	if !c.Platform.valid() {
+		return ErrUnsupportedPlatform.New(c.Platform)
+	}
+	if username, err := c.Platform.canonicalUsername(c.Username); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidSocialChallenge, err)
+	} else if username != c.Username {
+		return fmt.Errorf("%w: username must be in canonical form %q", ErrInvalidSocialChallenge, username)
+	}
+	if c.Nonce == "" {
+		return fmt.Errorf("%w: nonce cannot be empty", ErrInvalidSocialChallenge)
+	}
+	if c.CreatedAt == 0 {
+		return fmt.Errorf("%w: creation date is missing", ErrInvalidSocialChallenge)
+	}
+	if c.ExpiresAt <= c.CreatedAt {
+		return fmt.Errorf("%w: expiration date must be after the creation date", ErrInvalidSocialChallenge)
+	}
+	return nil
+}
+
+// Message is the text the wallet signs with EIP-191 (personal_sign).
+func (c *SocialAccountChallenge) Message() string {
+	return fmt.Sprintf("I control the %s account %s and the wallet %s. Nonce: %s", c.Platform, c.Username, c.Address, c.Nonce)
+}
+
+// Use checks that signature is the EIP-191 signature of Message by the challenged address
+// and consumes the challenge.
+func (c *SocialAccountChallenge) Use(signature string, usedAt int64) error {
+	if c.UsedAt != 0 {
+		return ErrSocialChallengeUsed.New(c.Id)
+	}
+	if usedAt >= c.ExpiresAt {
+		return ErrSocialChallengeExpired.New(c.Id, c.ExpiresAt)
+	}
+	signer, err := recoverPersonalSigner(c.Message(), signature)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidSocialChallenge, err)
+	}
+	if signer != c.Address {
+		return ErrInvalidSocialSignature.New(c.Id, c.Address)
+	}
+	c.UsedAt = usedAt
+	c.UpdatedAt = usedAt
+	return nil
+}
+
+func recoverPersonalSigner(message string, signature string) (Address, error) {
+	sig, err := hexutil.Decode(signature)
+	if err != nil {
+		return Address{}, fmt.Errorf("invalid signature encoding: %w", err)
+	}
+	if len(sig) != crypto.SignatureLength {
+		return Address{}, fmt.Errorf("signature must be %d bytes long", crypto.SignatureLength)
+	}
+	// wallets produce a recovery id of 27 or 28, crypto expects 0 or 1
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+	hash := crypto.Keccak256([]byte(fmt.Sprintf("\x19Ethereum Signed Message:\n%d%s", len(message), message)))
+	pub, err := crypto.SigToPub(hash, sig)
+	if err != nil {
+		return Address{}, fmt.Errorf("failed to recover signer: %w", err)
+	}
+	return Address(crypto.PubkeyToAddress(*pub)), nil
+}
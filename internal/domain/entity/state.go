@@ -0,0 +1,29 @@
+package entity
+
+import (
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain"
+)
+
+var (
+	ErrStateLeafNotFound = domain.ErrStateLeafNotFound
+)
+
+// StateRow is a row of a table with the key of its state leaf: the table name followed by the
+// values of its primary key, separated by slashes, e.g. issuances/1 or user_roles/3/creator.
+type StateRow struct {
+	Key     string
+	Table   string
+	Content SnapshotRow
+}
+
+// StateLeaf commits to the canonical JSON encoding of a row. The leaves of every table,
+// sorted by key, are the leaves of the state Merkle tree.
+type StateLeaf struct {
+	Key   string `json:"key" gorm:"primaryKey"`
+	Table string `json:"table" gorm:"not null;index"`
+	Hash  string `json:"hash" gorm:"not null"`
+}
+
+func (StateLeaf) TableName() string {
+	return "state_leaves"
+}
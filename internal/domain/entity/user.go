@@ -1,15 +1,22 @@
 package entity
 
 import (
-	"errors"
 	"fmt"
+	"sort"
 
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain"
 	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
 )
 
 var (
-	ErrInvalidUser  = errors.New("invalid user")
-	ErrUserNotFound = errors.New("user not found")
+	ErrInvalidUser        = domain.ErrInvalidUser
+	ErrUserNotFound       = domain.ErrUserNotFound
+	ErrRoleAlreadyGranted = domain.ErrRoleAlreadyGranted
+	ErrRoleNotGranted     = domain.ErrRoleNotGranted
+	ErrLastRole           = domain.ErrLastRole
+	ErrUserDeactivated    = domain.ErrUserDeactivated
+	ErrUserNotDeactivated = domain.ErrUserNotDeactivated
+	ErrAddressInUse       = domain.ErrAddressInUse
 )
 
 type UserRole string
@@ -21,20 +28,47 @@ const (
 	UserRoleInvestor UserRole = "investor"
 )
 
+type UserStatus string
+
+const (
+	UserStatusActive      UserStatus = "active"
+	UserStatusDeactivated UserStatus = "deactivated"
+)
+
+func (r UserRole) valid() bool {
+	_, ok := rolePermissions[r]
+	return ok
+}
+
+// UserRoleAssignment is a row of the user_roles join table, granting a role to a user.
+type UserRoleAssignment struct {
+	UserId    uint     `json:"user_id" gorm:"primaryKey"`
+	Role      UserRole `json:"role" gorm:"primaryKey"`
+	CreatedAt int64    `json:"created_at" gorm:"not null"`
+}
+
+func (UserRoleAssignment) TableName() string {
+	return "user_roles"
+}
+
 type User struct {
-	Id             uint             `json:"id" gorm:"primaryKey"`
-	Role           UserRole         `json:"role,omitempty" gorm:"not null"`
-	Address        Address          `json:"address,omitempty" gorm:"types:text;uniqueIndex;not null"`
-	SocialAccounts []*SocialAccount `json:"social_accounts,omitempty" gorm:"foreignKey:UserId;constraint:OnDelete:CASCADE"`
-	CreatedAt      int64            `json:"created_at,omitempty" gorm:"not null"`
-	UpdatedAt      int64            `json:"updated_at,omitempty" gorm:"default:0"`
+	Id             uint                  `json:"id" gorm:"primaryKey"`
+	Roles          []*UserRoleAssignment `json:"roles,omitempty" gorm:"foreignKey:UserId;constraint:OnDelete:CASCADE"`
+	Address        Address               `json:"address,omitempty" gorm:"types:text;uniqueIndex;not null"`
+	Status         UserStatus            `json:"status,omitempty" gorm:"types:text;not null;default:active"`
+	SocialAccounts []*SocialAccount      `json:"social_accounts,omitempty" gorm:"foreignKey:UserId;constraint:OnDelete:CASCADE"`
+	Reputation     *CreatorReputation    `json:"reputation,omitempty" gorm:"foreignKey:UserId;constraint:OnDelete:CASCADE"`
+	DeactivatedAt  int64                 `json:"deactivated_at,omitempty" gorm:"default:0"`
+	CreatedAt      int64                 `json:"created_at,omitempty" gorm:"not null"`
+	UpdatedAt      int64                 `json:"updated_at,omitempty" gorm:"default:0;autoUpdateTime:false"`
 }
 
 func NewUser(role string, address Address, createdAt int64) (*User, error) {
 	user := &User{
-		Role:           UserRole(role),
+		Roles:          []*UserRoleAssignment{{Role: UserRole(role), CreatedAt: createdAt}},
 		SocialAccounts: []*SocialAccount{},
 		Address:        address,
+		Status:         UserStatusActive,
 		CreatedAt:      createdAt,
 	}
 	if err := user.validate(); err != nil {
@@ -44,11 +78,16 @@ func NewUser(role string, address Address, createdAt int64) (*User, error) {
 }
 
 func (u *User) validate() error {
-	if u.Role == "" {
+	if len(u.Roles) == 0 {
 		return fmt.Errorf("%w: role cannot be empty", ErrInvalidUser)
 	}
-	if u.Role != UserRoleAdmin && u.Role != UserRoleCreator && u.Role != UserRoleInvestor && u.Role != UserRoleVerifier {
-		return fmt.Errorf("%w: invalid role", ErrInvalidUser)
+	for _, role := range u.Roles {
+		if role.Role == "" {
+			return fmt.Errorf("%w: role cannot be empty", ErrInvalidUser)
+		}
+		if !role.Role.valid() {
+			return fmt.Errorf("%w: invalid role", ErrInvalidUser)
+		}
 	}
 	if u.Address == (Address{}) {
 		return fmt.Errorf("%w: address cannot be empty", ErrInvalidUser)
@@ -58,3 +97,120 @@ func (u *User) validate() error {
 	}
 	return nil
 }
+
+func (u *User) HasRole(role UserRole) bool {
+	for _, assignment := range u.Roles {
+		if assignment.Role == role {
+			return true
+		}
+	}
+	return false
+}
+
+// HasPermission reports whether any of the user roles grants the permission.
+func (u *User) HasPermission(permission Permission) bool {
+	for _, assignment := range u.Roles {
+		if assignment.Role.Grants(permission) {
+			return true
+		}
+	}
+	return false
+}
+
+// RoleNames returns the roles of the user sorted by name.
+func (u *User) RoleNames() []string {
+	names := make([]string, len(u.Roles))
+	for i, assignment := range u.Roles {
+		names[i] = string(assignment.Role)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// GrantRole adds role to the user and returns the new assignment.
+func (u *User) GrantRole(role UserRole, grantedAt int64) (*UserRoleAssignment, error) {
+	if !role.valid() {
+		return nil, fmt.Errorf("%w: invalid role", ErrInvalidUser)
+	}
+	if u.HasRole(role) {
+		return nil, ErrRoleAlreadyGranted.New(u.Address, role)
+	}
+	assignment := &UserRoleAssignment{UserId: u.Id, Role: role, CreatedAt: grantedAt}
+	u.Roles = append(u.Roles, assignment)
+	u.UpdatedAt = grantedAt
+	return assignment, nil
+}
+
+// ChangeRole replaces every role of the user with role. It returns the new assignment,
+// nil when the user already had role, and the revoked roles.
+func (u *User) ChangeRole(role UserRole, changedAt int64) (*UserRoleAssignment, []UserRole, error) {
+	if !role.valid() {
+		return nil, nil, fmt.Errorf("%w: invalid role", ErrInvalidUser)
+	}
+	if len(u.Roles) == 1 && u.HasRole(role) {
+		return nil, nil, ErrRoleAlreadyGranted.New(u.Address, role)
+	}
+	var kept *UserRoleAssignment
+	var revoked []UserRole
+	for _, assignment := range u.Roles {
+		if assignment.Role == role {
+			kept = assignment
+		} else {
+			revoked = append(revoked, assignment.Role)
+		}
+	}
+	var granted *UserRoleAssignment
+	if kept == nil {
+		granted = &UserRoleAssignment{UserId: u.Id, Role: role, CreatedAt: changedAt}
+		kept = granted
+	}
+	u.Roles = []*UserRoleAssignment{kept}
+	u.UpdatedAt = changedAt
+	return granted, revoked, nil
+}
+
+// RevokeRole removes role from the user, who must keep at least one role.
+func (u *User) RevokeRole(role UserRole, revokedAt int64) error {
+	if !u.HasRole(role) {
+		return ErrRoleNotGranted.New(u.Address, role)
+	}
+	if len(u.Roles) == 1 {
+		return ErrLastRole.New(u.Address, role)
+	}
+	roles := make([]*UserRoleAssignment, 0, len(u.Roles)-1)
+	for _, assignment := range u.Roles {
+		if assignment.Role != role {
+			roles = append(roles, assignment)
+		}
+	}
+	u.Roles = roles
+	u.UpdatedAt = revokedAt
+	return nil
+}
+
+// Active reports whether the user has not been deactivated.
+func (u *User) Active() bool {
+	return u.Status != UserStatusDeactivated
+}
+
+// Deactivate soft deletes the user. The record is kept for audit.
+func (u *User) Deactivate(deactivatedAt int64) error {
+	if !u.Active() {
+		return ErrUserDeactivated.New(u.Address)
+	}
+	u.Status = UserStatusDeactivated
+	u.DeactivatedAt = deactivatedAt
+	u.UpdatedAt = deactivatedAt
+	return nil
+}
+
+// Reactivate restores a deactivated user.
+func (u *User) Reactivate(reactivatedAt int64) error {
+	if u.Active() {
+		return ErrUserNotDeactivated.New(u.Address)
+	}
+	u.Status = UserStatusActive
+	u.DeactivatedAt = 0
+	u.UpdatedAt = reactivatedAt
+	return nil
+}
@@ -0,0 +1,280 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Category groups error codes by the HTTP status a gateway would answer them with.
+type Category string
+
+const (
+	CategoryBadRequest    Category = "bad_request"
+	CategoryForbidden     Category = "forbidden"
+	CategoryNotFound      Category = "not_found"
+	CategoryConflict      Category = "conflict"
+	CategoryUnprocessable Category = "unprocessable"
+	CategoryInternal      Category = "internal"
+)
+
+var categoryStatus = map[Category]int{
+	CategoryBadRequest:    400,
+	CategoryForbidden:     403,
+	CategoryNotFound:      404,
+	CategoryConflict:      409,
+	CategoryUnprocessable: 422,
+	CategoryInternal:      500,
+}
+
+// Status returns the HTTP status code equivalent to the category.
+func (c Category) Status() int {
+	if status, ok := categoryStatus[c]; ok {
+		return status
+	}
+	return 500
+}
+
+// ErrorDef is an entry of the error catalog. Message is an english template where every
+// parameter appears as {name}, so clients can localize it by code and fill in the parameters.
+// An ErrorDef is itself an error, and errors created from it match it with errors.Is.
+type ErrorDef struct {
+	Code     string   `json:"code"`
+	Category Category `json:"category"`
+	Status   int      `json:"status"`
+	Message  string   `json:"message"`
+	Params   []string `json:"params"`
+}
+
+var catalog = map[string]*ErrorDef{}
+
+// NewErrorDef registers a new entry in the error catalog. Codes are part of the public API:
+// never rename or reuse them.
+func NewErrorDef(code string, category Category, message string, params ...string) *ErrorDef {
+	if _, exists := catalog[code]; exists {
+		panic(fmt.Sprintf("duplicate error code: %s", code))
+	}
+	def := &ErrorDef{
+		Code:     code,
+		Category: category,
+		Status:   category.Status(),
+		Message:  message,
+		Params:   append([]string{}, params...),
+	}
+	catalog[code] = def
+	return def
+}
+
+// Catalog returns every registered error definition sorted by code.
+func Catalog() []*ErrorDef {
+	defs := make([]*ErrorDef, 0, len(catalog))
+	for _, def := range catalog {
+		defs = append(defs, def)
+	}
+	sort.Slice(defs, func(i, j int) bool {
+		return defs[i].Code < defs[j].Code
+	})
+	return defs
+}
+
+// New creates an error from the definition, binding args to the definition params in order.
+func (d *ErrorDef) New(args ...any) *Error {
+	params := make(map[string]string, len(d.Params))
+	for i, name := range d.Params {
+		if i < len(args) {
+			params[name] = fmt.Sprint(args[i])
+		}
+	}
+	return &Error{Def: d, Params: params}
+}
+
+func (d *ErrorDef) Error() string {
+	return d.Message
+}
+
+func (d *ErrorDef) ErrorCode() string {
+	return d.Code
+}
+
+func (d *ErrorDef) ErrorCategory() string {
+	return string(d.Category)
+}
+
+func (d *ErrorDef) ErrorParams() map[string]string {
+	return nil
+}
+
+// Error is an occurrence of a catalog entry with its parameters filled in.
+type Error struct {
+	Def    *ErrorDef
+	Params map[string]string
+}
+
+// Error fills in the parameters in the order the definition declares them, so the message is
+// the same on every run even if a value contains a placeholder.
+func (e *Error) Error() string {
+	message := e.Def.Message
+	for _, name := range e.Def.Params {
+		if value, ok := e.Params[name]; ok {
+			message = strings.ReplaceAll(message, "{"+name+"}", value)
+		}
+	}
+	return message
+}
+
+func (e *Error) Is(target error) bool {
+	def, ok := target.(*ErrorDef)
+	return ok && def == e.Def
+}
+
+func (e *Error) ErrorCode() string {
+	return e.Def.Code
+}
+
+func (e *Error) ErrorCategory() string {
+	return string(e.Def.Category)
+}
+
+func (e *Error) ErrorParams() map[string]string {
+	return e.Params
+}
+
+// Access control
+var (
+	ErrPermissionDenied = NewErrorDef("PERMISSION_DENIED", CategoryForbidden, "user {user} lacks required permissions: {permissions}", "user", "permissions")
+)
+
+// Users
+var (
+	ErrUserNotFound       = NewErrorDef("USER_NOT_FOUND", CategoryNotFound, "user not found")
+	ErrInvalidUser        = NewErrorDef("INVALID_USER", CategoryBadRequest, "invalid user")
+	ErrRoleAlreadyGranted = NewErrorDef("ROLE_ALREADY_GRANTED", CategoryConflict, "user {user} already has role {role}", "user", "role")
+	ErrRoleNotGranted     = NewErrorDef("ROLE_NOT_GRANTED", CategoryConflict, "user {user} does not have role {role}", "user", "role")
+	ErrLastRole           = NewErrorDef("LAST_ROLE", CategoryUnprocessable, "cannot revoke {role}, the last role of user {user}", "user", "role")
+	ErrUserDeactivated    = NewErrorDef("USER_DEACTIVATED", CategoryForbidden, "user {user} is deactivated", "user")
+	ErrUserNotDeactivated = NewErrorDef("USER_NOT_DEACTIVATED", CategoryConflict, "user {user} is not deactivated", "user")
+	ErrUserOpenPositions  = NewErrorDef("USER_OPEN_POSITIONS", CategoryConflict, "user {user} has open positions: {positions}", "user", "positions")
+	ErrAddressInUse       = NewErrorDef("ADDRESS_IN_USE", CategoryConflict, "address {address} already belongs to a user", "address")
+)
+
+// Address rotations
+var (
+	ErrAddressRotationNotFound  = NewErrorDef("ADDRESS_ROTATION_NOT_FOUND", CategoryNotFound, "address rotation not found")
+	ErrInvalidAddressRotation   = NewErrorDef("INVALID_ADDRESS_ROTATION", CategoryBadRequest, "invalid address rotation")
+	ErrAddressRotationState     = NewErrorDef("ADDRESS_ROTATION_STATE", CategoryConflict, "address rotation {rotation_id} is {state}", "rotation_id", "state")
+	ErrAddressRotationForbidden = NewErrorDef("ADDRESS_ROTATION_FORBIDDEN", CategoryForbidden, "address {address} cannot {action} address rotation {rotation_id}", "address", "action", "rotation_id")
+	ErrAddressRotationPending   = NewErrorDef("ADDRESS_ROTATION_PENDING", CategoryConflict, "user {user} already has pending address rotation {rotation_id}", "user", "rotation_id")
+	ErrAddressRotationStale     = NewErrorDef("ADDRESS_ROTATION_STALE", CategoryConflict, "address rotation {rotation_id} moves {old_address}, which its user no longer holds", "rotation_id", "old_address")
+)
+
+// Social accounts
+var (
+	ErrSocialAccountNotFound   = NewErrorDef("SOCIAL_ACCOUNT_NOT_FOUND", CategoryNotFound, "social account not found")
+	ErrInvalidSocialAccount    = NewErrorDef("INVALID_SOCIAL_ACCOUNT", CategoryBadRequest, "invalid social account")
+	ErrSocialChallengeNotFound = NewErrorDef("SOCIAL_CHALLENGE_NOT_FOUND", CategoryNotFound, "social account challenge not found")
+	ErrInvalidSocialChallenge  = NewErrorDef("INVALID_SOCIAL_CHALLENGE", CategoryBadRequest, "invalid social account challenge")
+	ErrSocialChallengeUsed     = NewErrorDef("SOCIAL_CHALLENGE_USED", CategoryConflict, "social account challenge {challenge_id} was already used", "challenge_id")
+	ErrSocialChallengeExpired  = NewErrorDef("SOCIAL_CHALLENGE_EXPIRED", CategoryConflict, "social account challenge {challenge_id} expired at {expires_at}", "challenge_id", "expires_at")
+	ErrInvalidSocialSignature  = NewErrorDef("INVALID_SOCIAL_SIGNATURE", CategoryForbidden, "signature of social account challenge {challenge_id} was not made by {address}", "challenge_id", "address")
+	ErrUnsupportedPlatform     = NewErrorDef("UNSUPPORTED_SOCIAL_PLATFORM", CategoryBadRequest, "social platform {platform} is not supported", "platform")
+	ErrPlatformDisabled        = NewErrorDef("SOCIAL_PLATFORM_DISABLED", CategoryUnprocessable, "social platform {platform} is disabled", "platform")
+)
+
+// Reputation
+var (
+	ErrReputationTooLow       = NewErrorDef("REPUTATION_TOO_LOW", CategoryForbidden, "creator {creator} has a reputation score of {score}, issuing {debt_issued} requires {required}", "creator", "score", "debt_issued", "required")
+	ErrInvalidReputationGates = NewErrorDef("INVALID_REPUTATION_GATES", CategoryInternal, "invalid reputation gate {gate}, expected <debt_issued>:<min_score>", "gate")
+)
+
+// Attestations
+var (
+	ErrAttestationNotFound = NewErrorDef("ATTESTATION_NOT_FOUND", CategoryNotFound, "attestation not found")
+	ErrInvalidAttestation  = NewErrorDef("INVALID_ATTESTATION", CategoryBadRequest, "invalid attestation")
+	ErrAttestationRevoked  = NewErrorDef("ATTESTATION_REVOKED", CategoryConflict, "attestation {attestation_id} is already revoked", "attestation_id")
+	ErrAttestationRequired = NewErrorDef("ATTESTATION_REQUIRED", CategoryForbidden, "{party} {user} lacks a valid {type} attestation", "party", "user", "type")
+)
+
+// Deposits
+var (
+	ErrInvalidDeposit      = NewErrorDef("INVALID_DEPOSIT", CategoryBadRequest, "invalid deposit type: {type}", "type")
+	ErrInvalidDepositToken = NewErrorDef("INVALID_DEPOSIT_TOKEN", CategoryBadRequest, "invalid deposit token {token}, expected {expected}", "token", "expected")
+)
+
+// Issuances
+var (
+	ErrIssuanceNotFound           = NewErrorDef("ISSUANCE_NOT_FOUND", CategoryNotFound, "issuance not found")
+	ErrInvalidIssuance            = NewErrorDef("INVALID_ISSUANCE", CategoryBadRequest, "invalid issuance")
+	ErrCreatorWithoutSocial       = NewErrorDef("CREATOR_WITHOUT_SOCIAL_ACCOUNT", CategoryUnprocessable, "creator {creator} has no social accounts, please verify at least one social account", "creator")
+	ErrIssuanceCloseTooFar        = NewErrorDef("ISSUANCE_CLOSE_TOO_FAR", CategoryUnprocessable, "close date cannot be more than {max_days} days away", "max_days")
+	ErrIssuanceCloseAfterMaturity = NewErrorDef("ISSUANCE_CLOSE_AFTER_MATURITY", CategoryUnprocessable, "close date {closes_at} cannot be greater than maturity date {maturity_at}", "closes_at", "maturity_at")
+	ErrIssuanceCloseInPast        = NewErrorDef("ISSUANCE_CLOSE_IN_PAST", CategoryUnprocessable, "close date {closes_at} must be greater than creation date {created_at}", "closes_at", "created_at")
+	ErrCreatorHasActiveIssuance   = NewErrorDef("CREATOR_HAS_ACTIVE_ISSUANCE", CategoryConflict, "creator has an active issuance (id: {issuance_id}, state: {state})", "issuance_id", "state")
+	ErrIssuanceNotExpired         = NewErrorDef("ISSUANCE_NOT_EXPIRED", CategoryConflict, "issuance {issuance_id} closes at {closes_at}, cannot close it yet", "issuance_id", "closes_at")
+	ErrIssuanceUnderfunded        = NewErrorDef("ISSUANCE_UNDERFUNDED", CategoryUnprocessable, "issuance {issuance_id} canceled due to insufficient funds collected, expected at least {minimum}, got: {collected}", "issuance_id", "minimum", "collected")
+	ErrIssuanceClosed             = NewErrorDef("ISSUANCE_CLOSED", CategoryConflict, "issuance {issuance_id} is closed", "issuance_id")
+	ErrIssuanceNotClosed          = NewErrorDef("ISSUANCE_NOT_CLOSED", CategoryConflict, "issuance {issuance_id} is not closed", "issuance_id")
+	ErrIssuanceAlreadySettled     = NewErrorDef("ISSUANCE_ALREADY_SETTLED", CategoryConflict, "issuance {issuance_id} is already settled", "issuance_id")
+	ErrIssuanceMatured            = NewErrorDef("ISSUANCE_MATURED", CategoryConflict, "the maturity date of issuance {issuance_id} has passed", "issuance_id")
+	ErrIssuanceNotMatured         = NewErrorDef("ISSUANCE_NOT_MATURED", CategoryConflict, "the maturity date of issuance {issuance_id} has not passed", "issuance_id")
+	ErrInsufficientSettlement     = NewErrorDef("INSUFFICIENT_SETTLEMENT", CategoryUnprocessable, "deposit amount {amount} is lower than the total obligation {total_obligation}", "amount", "total_obligation")
+	ErrNotIssuanceCreator         = NewErrorDef("NOT_ISSUANCE_CREATOR", CategoryForbidden, "only the creator of issuance {issuance_id} can settle it", "issuance_id")
+)
+
+// Orders
+var (
+	ErrOrderNotFound       = NewErrorDef("ORDER_NOT_FOUND", CategoryNotFound, "order not found")
+	ErrInvalidOrder        = NewErrorDef("INVALID_ORDER", CategoryBadRequest, "invalid order")
+	ErrInterestRateTooHigh = NewErrorDef("INTEREST_RATE_TOO_HIGH", CategoryUnprocessable, "order interest rate {interest_rate} exceeds issuance max interest rate {max_interest_rate}", "interest_rate", "max_interest_rate")
+	ErrNotOrderInvestor    = NewErrorDef("NOT_ORDER_INVESTOR", CategoryForbidden, "only the investor can cancel order {order_id}", "order_id")
+	ErrOrderNotCancellable = NewErrorDef("ORDER_NOT_CANCELLABLE", CategoryConflict, "cannot cancel order {order_id} after issuance {issuance_id} closes", "order_id", "issuance_id")
+	ErrOrderNotPending     = NewErrorDef("ORDER_NOT_PENDING", CategoryConflict, "order {order_id} is {state}", "order_id", "state")
+)
+
+// Ledger
+var (
+	ErrInvalidLedgerEntry = NewErrorDef("INVALID_LEDGER_ENTRY", CategoryInternal, "invalid ledger entry")
+)
+
+// Invariants
+var (
+	ErrInvariantViolated = NewErrorDef("INVARIANT_VIOLATED", CategoryInternal, "invariant {invariant} violated for token {token}: expected {expected}, got {actual}", "invariant", "token", "expected", "actual")
+)
+
+// Snapshots
+var (
+	ErrInvalidSnapshot      = NewErrorDef("INVALID_SNAPSHOT", CategoryBadRequest, "invalid snapshot: {reason}", "reason")
+	ErrSnapshotHashMismatch = NewErrorDef("SNAPSHOT_HASH_MISMATCH", CategoryBadRequest, "snapshot hash {hash} does not match its content, expected {expected}", "hash", "expected")
+	ErrRepositoryNotEmpty   = NewErrorDef("REPOSITORY_NOT_EMPTY", CategoryConflict, "cannot import a snapshot, table {table} is not empty", "table")
+)
+
+// Genesis
+var (
+	ErrInvalidGenesis        = NewErrorDef("INVALID_GENESIS", CategoryBadRequest, "invalid genesis: {reason}", "reason")
+	ErrGenesisNotFound       = NewErrorDef("GENESIS_NOT_FOUND", CategoryNotFound, "genesis not applied yet")
+	ErrGenesisAlreadyApplied = NewErrorDef("GENESIS_ALREADY_APPLIED", CategoryConflict, "genesis {hash} was already applied", "hash")
+	ErrTokenNotAllowed       = NewErrorDef("TOKEN_NOT_ALLOWED", CategoryUnprocessable, "token {token} is not allowed", "token")
+)
+
+// State commitment
+var (
+	ErrStateLeafNotFound = NewErrorDef("STATE_LEAF_NOT_FOUND", CategoryNotFound, "state leaf {key} not found", "key")
+)
+
+// Governance
+var (
+	ErrProposalNotFound        = NewErrorDef("PROPOSAL_NOT_FOUND", CategoryNotFound, "proposal not found")
+	ErrInvalidProposal         = NewErrorDef("INVALID_PROPOSAL", CategoryBadRequest, "invalid proposal")
+	ErrProposalExpired         = NewErrorDef("PROPOSAL_EXPIRED", CategoryConflict, "proposal {proposal_id} expired at {expires_at}", "proposal_id", "expires_at")
+	ErrProposalNotPending      = NewErrorDef("PROPOSAL_NOT_PENDING", CategoryConflict, "proposal {proposal_id} is {state}", "proposal_id", "state")
+	ErrProposalAlreadyApproved = NewErrorDef("PROPOSAL_ALREADY_APPROVED", CategoryConflict, "admin {approver} already approved proposal {proposal_id}", "approver", "proposal_id")
+	ErrAdminsBelowThreshold    = NewErrorDef("ADMINS_BELOW_THRESHOLD", CategoryConflict, "proposal {proposal_id} would leave {admins} active admins, fewer than the threshold of {threshold}", "proposal_id", "admins", "threshold")
+)
+
+// Emergency withdrawals
+var (
+	ErrEmergencyWithdrawalNotFound   = NewErrorDef("EMERGENCY_WITHDRAWAL_NOT_FOUND", CategoryNotFound, "emergency withdrawal not found")
+	ErrInvalidEmergencyWithdrawal    = NewErrorDef("INVALID_EMERGENCY_WITHDRAWAL", CategoryBadRequest, "invalid emergency withdrawal")
+	ErrEmergencyWithdrawalNotPending = NewErrorDef("EMERGENCY_WITHDRAWAL_NOT_PENDING", CategoryConflict, "emergency withdrawal {withdrawal_id} is {state}", "withdrawal_id", "state")
+	ErrEmergencyWithdrawalLocked     = NewErrorDef("EMERGENCY_WITHDRAWAL_LOCKED", CategoryConflict, "emergency withdrawal {withdrawal_id} cannot be executed before {executable_at}", "withdrawal_id", "executable_at")
+	ErrEmergencyWithdrawalUnlocked   = NewErrorDef("EMERGENCY_WITHDRAWAL_UNLOCKED", CategoryConflict, "emergency withdrawal {withdrawal_id} cannot be canceled since {executable_at}", "withdrawal_id", "executable_at")
+)
@@ -6,24 +6,33 @@ import (
 	"strings"
 
 	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository/postgres"
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository/sqlite"
 )
 
+// NewRepositoryFromConnectionString opens the database and applies the pending migrations.
 func NewRepositoryFromConnectionString(ctx context.Context, conn string) (Repository, error) {
 	lowerConn := strings.ToLower(conn)
 	switch {
 	case strings.HasPrefix(lowerConn, "sqlite://"):
-		return newSQLiteRepository(ctx, conn)
+		return sqlite.OpenSQLiteRepository(ctx, conn)
+	case strings.HasPrefix(lowerConn, "postgres://"), strings.HasPrefix(lowerConn, "postgresql://"):
+		return postgres.OpenPostgresRepository(ctx, conn)
 	default:
 		return nil, fmt.Errorf("unrecognized connection string format: %s", conn)
 	}
 }
 
-func newSQLiteRepository(ctx context.Context, conn string) (Repository, error) {
-	sqliteRepo, err := sqlite.NewSQLiteRepository(ctx, conn)
-	if err != nil {
-		return nil, err
+// ConnectRepositoryFromConnectionString opens the database as is, without migrating it, for
+// tools that manage its migrations.
+func ConnectRepositoryFromConnectionString(ctx context.Context, conn string) (Repository, error) {
+	lowerConn := strings.ToLower(conn)
+	switch {
+	case strings.HasPrefix(lowerConn, "sqlite://"):
+		return sqlite.ConnectSQLiteRepository(ctx, conn)
+	case strings.HasPrefix(lowerConn, "postgres://"), strings.HasPrefix(lowerConn, "postgresql://"):
+		return postgres.ConnectPostgresRepository(ctx, conn)
+	default:
+		return nil, fmt.Errorf("unrecognized connection string format: %s", conn)
 	}
-
-	return sqliteRepo, nil
 }
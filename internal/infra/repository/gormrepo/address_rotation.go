@@ -0,0 +1,53 @@
+package gormrepo
+
+import (
+	"fmt"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
+	"gorm.io/gorm"
+)
+
+func (r *Repository) CreateAddressRotation(input *entity.AddressRotation) (*entity.AddressRotation, error) {
+	if err := r.Db.Create(input).Error; err != nil {
+		return nil, fmt.Errorf("failed to create address rotation: %w", err)
+	}
+	return input, nil
+}
+
+func (r *Repository) FindAddressRotationById(id uint) (*entity.AddressRotation, error) {
+	var rotation entity.AddressRotation
+	if err := r.Db.First(&rotation, id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, entity.ErrAddressRotationNotFound
+		}
+		return nil, fmt.Errorf("failed to find address rotation by ID: %w", err)
+	}
+	return &rotation, nil
+}
+
+// FindPendingAddressRotationByUserId returns the rotation of the user that has not completed or
+// been canceled yet.
+func (r *Repository) FindPendingAddressRotationByUserId(userId uint) (*entity.AddressRotation, error) {
+	var rotation entity.AddressRotation
+	if err := r.Db.
+		Where("user_id = ? AND state IN ?", userId, entity.PendingAddressRotationStates).
+		Order("id").
+		First(&rotation).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, entity.ErrAddressRotationNotFound
+		}
+		return nil, fmt.Errorf("failed to find pending address rotation by user ID: %w", err)
+	}
+	return &rotation, nil
+}
+
+func (r *Repository) UpdateAddressRotation(input *entity.AddressRotation) (*entity.AddressRotation, error) {
+	if err := r.Db.Save(input).Error; err != nil {
+		return nil, fmt.Errorf("failed to update address rotation: %w", err)
+	}
+	rotation, err := r.FindAddressRotationById(input.Id)
+	if err != nil {
+		return nil, err
+	}
+	return rotation, nil
+}
@@ -0,0 +1,221 @@
+package gormrepo
+
+import (
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
+	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+	"github.com/holiman/uint256"
+	"gorm.io/gorm"
+)
+
+// raisedStates are the states of issuances whose orders were accepted.
+var raisedStates = []entity.IssuanceState{
+	entity.IssuanceStateClosed,
+	entity.IssuanceStateSettled,
+	entity.IssuanceStateCollateralExecuted,
+}
+
+// acceptedStates are the states of orders that were accepted when their issuance closed.
+var acceptedStates = []entity.OrderState{
+	entity.OrderStateAccepted,
+	entity.OrderStatePartiallyAccepted,
+	entity.OrderStateSettled,
+	entity.OrderStateSettledByCollateral,
+}
+
+// Aggregate queries select the amounts of each group with the Amounts of the dialect, either
+// summed or concatenated, and parseAmounts splits them back so they can be summed exactly.
+func parseAmounts(concatenated string) ([]*uint256.Int, error) {
+	if concatenated == "" {
+		return []*uint256.Int{}, nil
+	}
+	parts := strings.Split(concatenated, ",")
+	amounts := make([]*uint256.Int, len(parts))
+	for i, part := range parts {
+		amount, err := uint256.FromDecimal(part)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse amount %q: %w", part, err)
+		}
+		amounts[i] = amount
+	}
+	return amounts, nil
+}
+
+type tokenAmountsRow struct {
+	Start   int64
+	Token   Address
+	Amounts string
+}
+
+func (row tokenAmountsRow) toEntity() (*entity.TokenAmounts, error) {
+	amounts, err := parseAmounts(row.Amounts)
+	if err != nil {
+		return nil, err
+	}
+	return &entity.TokenAmounts{Token: row.Token, Amounts: amounts}, nil
+}
+
+func tokenAmounts(rows []tokenAmountsRow) ([]*entity.TokenAmounts, error) {
+	result := make([]*entity.TokenAmounts, len(rows))
+	for i, row := range rows {
+		amounts, err := row.toEntity()
+		if err != nil {
+			return nil, err
+		}
+		result[i] = amounts
+	}
+	return result, nil
+}
+
+func (r *Repository) FindRaisedAmountsByToken() ([]*entity.TokenAmounts, error) {
+	var rows []tokenAmountsRow
+	if err := r.Db.Model(&entity.Issuance{}).
+		Select("token, "+r.dialect.Amounts("total_raised")+" AS amounts").
+		Where("state IN ?", raisedStates).
+		Group("token").
+		Order("token").
+		Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to find raised amounts by token: %w", err)
+	}
+	result, err := tokenAmounts(rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find raised amounts by token: %w", err)
+	}
+	return result, nil
+}
+
+// FindAverageClearingRate averages the clearing rate of closed issuances, which is the highest
+// rate among their accepted orders. It returns zero when no issuance closed.
+func (r *Repository) FindAverageClearingRate() (float64, error) {
+	var rate sql.NullFloat64
+	if err := r.Db.Raw(
+		"SELECT AVG(rate) FROM (SELECT MAX(CAST(interest_rate AS INTEGER)) AS rate FROM orders WHERE state IN ? GROUP BY issuance_id) AS clearing_rates",
+		acceptedStates,
+	).Scan(&rate).Error; err != nil {
+		return 0, fmt.Errorf("failed to find average clearing rate: %w", err)
+	}
+	return rate.Float64, nil
+}
+
+func (r *Repository) CountIssuancesByState() (map[entity.IssuanceState]uint64, error) {
+	var rows []struct {
+		State entity.IssuanceState
+		Count uint64
+	}
+	if err := r.Db.Model(&entity.Issuance{}).
+		Select("state, COUNT(*) AS count").
+		Group("state").
+		Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to count issuances by state: %w", err)
+	}
+	counts := make(map[entity.IssuanceState]uint64, len(rows))
+	for _, row := range rows {
+		counts[row.State] = row.Count
+	}
+	return counts, nil
+}
+
+// CountInvestorsByOrderState counts the investors with orders in any of the given states, or
+// with any order when no state is given.
+func (r *Repository) CountInvestorsByOrderState(states ...entity.OrderState) (uint64, error) {
+	query := r.Db.Model(&entity.Order{})
+	if len(states) > 0 {
+		query = query.Where("state IN ?", states)
+	}
+	var count int64
+	if err := query.Distinct("investor_address").Count(&count).Error; err != nil {
+		return 0, fmt.Errorf("failed to count investors: %w", err)
+	}
+	return uint64(count), nil
+}
+
+// FindActivitySeries buckets the issuances and orders created between from and to, both
+// inclusive, by their creation time. Buckets are aligned to the Unix epoch and buckets without
+// activity are omitted. A zero to leaves the series open ended.
+func (r *Repository) FindActivitySeries(interval int64, from int64, to int64) ([]*entity.ActivityBucket, error) {
+	if interval <= 0 {
+		return nil, fmt.Errorf("failed to find activity series: invalid interval %d", interval)
+	}
+	within := func(query *gorm.DB, column string) *gorm.DB {
+		query = query.Where(column+" >= ?", from)
+		if to > 0 {
+			query = query.Where(column+" <= ?", to)
+		}
+		return query
+	}
+	start := func(column string) string {
+		return fmt.Sprintf("(%s / %d) * %d AS start", column, interval, interval)
+	}
+
+	buckets := make(map[int64]*entity.ActivityBucket)
+	bucketAt := func(start int64) *entity.ActivityBucket {
+		if bucket, ok := buckets[start]; ok {
+			return bucket
+		}
+		bucket := &entity.ActivityBucket{Start: start, Volume: []*entity.TokenAmounts{}}
+		buckets[start] = bucket
+		return bucket
+	}
+
+	var issuanceRows []struct {
+		Start int64
+		Count uint64
+	}
+	if err := within(r.Db.Model(&entity.Issuance{}), "created_at").
+		Select(start("created_at") + ", COUNT(*) AS count").
+		Group("start").
+		Scan(&issuanceRows).Error; err != nil {
+		return nil, fmt.Errorf("failed to find issuance series: %w", err)
+	}
+	for _, row := range issuanceRows {
+		bucketAt(row.Start).Issuances = row.Count
+	}
+
+	// closing an issuance splits a partially accepted order, keeping its creation time on the
+	// rejected remainder, so each partially accepted order stands for one extra row
+	var orderRows []struct {
+		Start     int64
+		Count     uint64
+		Investors uint64
+	}
+	if err := within(r.Db.Model(&entity.Order{}), "created_at").
+		Select(start("created_at")+", COUNT(*) - SUM(CASE WHEN state = ? THEN 1 ELSE 0 END) AS count, COUNT(DISTINCT investor_address) AS investors",
+			entity.OrderStatePartiallyAccepted).
+		Group("start").
+		Scan(&orderRows).Error; err != nil {
+		return nil, fmt.Errorf("failed to find order series: %w", err)
+	}
+	for _, row := range orderRows {
+		bucket := bucketAt(row.Start)
+		bucket.Orders = row.Count
+		bucket.Investors = row.Investors
+	}
+
+	var volumeRows []tokenAmountsRow
+	if err := within(r.Db.Table("orders").Joins("JOIN issuances ON issuances.id = orders.issuance_id"), "orders.created_at").
+		Select(start("orders.created_at") + ", issuances.token AS token, " + r.dialect.Amounts("orders.amount") + " AS amounts").
+		Group("start, issuances.token").
+		Order("issuances.token").
+		Scan(&volumeRows).Error; err != nil {
+		return nil, fmt.Errorf("failed to find volume series: %w", err)
+	}
+	for _, row := range volumeRows {
+		amounts, err := row.toEntity()
+		if err != nil {
+			return nil, fmt.Errorf("failed to find volume series: %w", err)
+		}
+		bucket := bucketAt(row.Start)
+		bucket.Volume = append(bucket.Volume, amounts)
+	}
+
+	series := make([]*entity.ActivityBucket, 0, len(buckets))
+	for _, bucket := range buckets {
+		series = append(series, bucket)
+	}
+	sort.Slice(series, func(i, j int) bool { return series[i].Start < series[j].Start })
+	return series, nil
+}
@@ -0,0 +1,45 @@
+package gormrepo
+
+import (
+	"fmt"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
+	"gorm.io/gorm"
+)
+
+func (r *Repository) CreateAttestation(input *entity.Attestation) (*entity.Attestation, error) {
+	if err := r.Db.Create(input).Error; err != nil {
+		return nil, fmt.Errorf("failed to create attestation: %w", err)
+	}
+	return input, nil
+}
+
+func (r *Repository) FindAttestationById(id uint) (*entity.Attestation, error) {
+	var attestation entity.Attestation
+	if err := r.Db.First(&attestation, id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, entity.ErrAttestationNotFound
+		}
+		return nil, fmt.Errorf("failed to find attestation by ID: %w", err)
+	}
+	return &attestation, nil
+}
+
+func (r *Repository) FindAttestationsBySubjectId(subjectId uint) ([]*entity.Attestation, error) {
+	var attestations []*entity.Attestation
+	if err := r.Db.Where("subject_id = ?", subjectId).Order("id").Find(&attestations).Error; err != nil {
+		return nil, fmt.Errorf("failed to find attestations by subject ID: %w", err)
+	}
+	return attestations, nil
+}
+
+func (r *Repository) UpdateAttestation(input *entity.Attestation) (*entity.Attestation, error) {
+	if err := r.Db.Save(input).Error; err != nil {
+		return nil, fmt.Errorf("failed to update attestation: %w", err)
+	}
+	attestation, err := r.FindAttestationById(input.Id)
+	if err != nil {
+		return nil, err
+	}
+	return attestation, nil
+}
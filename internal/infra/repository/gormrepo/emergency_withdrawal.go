@@ -0,0 +1,45 @@
+package gormrepo
+
+import (
+	"fmt"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
+	"gorm.io/gorm"
+)
+
+func (r *Repository) CreateEmergencyWithdrawal(input *entity.EmergencyWithdrawal) (*entity.EmergencyWithdrawal, error) {
+	if err := r.Db.Create(input).Error; err != nil {
+		return nil, fmt.Errorf("failed to create emergency withdrawal: %w", err)
+	}
+	return input, nil
+}
+
+func (r *Repository) FindEmergencyWithdrawalById(id uint) (*entity.EmergencyWithdrawal, error) {
+	var withdrawal entity.EmergencyWithdrawal
+	if err := r.Db.First(&withdrawal, id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, entity.ErrEmergencyWithdrawalNotFound
+		}
+		return nil, fmt.Errorf("failed to find emergency withdrawal by ID: %w", err)
+	}
+	return &withdrawal, nil
+}
+
+func (r *Repository) FindAllEmergencyWithdrawals() ([]*entity.EmergencyWithdrawal, error) {
+	var withdrawals []*entity.EmergencyWithdrawal
+	if err := r.Db.Order("id").Find(&withdrawals).Error; err != nil {
+		return nil, fmt.Errorf("failed to find all emergency withdrawals: %w", err)
+	}
+	return withdrawals, nil
+}
+
+func (r *Repository) UpdateEmergencyWithdrawal(input *entity.EmergencyWithdrawal) (*entity.EmergencyWithdrawal, error) {
+	if err := r.Db.Save(input).Error; err != nil {
+		return nil, fmt.Errorf("failed to update emergency withdrawal: %w", err)
+	}
+	withdrawal, err := r.FindEmergencyWithdrawalById(input.Id)
+	if err != nil {
+		return nil, err
+	}
+	return withdrawal, nil
+}
@@ -0,0 +1,42 @@
+package gormrepo
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
+)
+
+func (r *Repository) CreateGenesis(input *entity.Genesis) (*entity.Genesis, error) {
+	if err := r.Db.Create(input).Error; err != nil {
+		return nil, fmt.Errorf("failed to create genesis: %w", err)
+	}
+	return input, nil
+}
+
+func (r *Repository) FindGenesis() (*entity.Genesis, error) {
+	var genesis entity.Genesis
+	if err := r.Db.First(&genesis).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, entity.ErrGenesisNotFound
+		}
+		return nil, fmt.Errorf("failed to find genesis: %w", err)
+	}
+	return &genesis, nil
+}
+
+func (r *Repository) CreateAllowedToken(input *entity.AllowedToken) (*entity.AllowedToken, error) {
+	if err := r.Db.Create(input).Error; err != nil {
+		return nil, fmt.Errorf("failed to create allowed token: %w", err)
+	}
+	return input, nil
+}
+
+func (r *Repository) FindAllAllowedTokens() ([]*entity.AllowedToken, error) {
+	var tokens []*entity.AllowedToken
+	if err := r.Db.Order("token").Find(&tokens).Error; err != nil {
+		return nil, fmt.Errorf("failed to find allowed tokens: %w", err)
+	}
+	return tokens, nil
+}
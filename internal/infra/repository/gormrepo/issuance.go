@@ -1,4 +1,4 @@
-package sqlite
+package gormrepo
 
 import (
 	"fmt"
@@ -8,17 +8,17 @@ import (
 	"gorm.io/gorm"
 )
 
-func (r *SQLiteRepository) CreateIssuance(input *entity.Issuance) (*entity.Issuance, error) {
+func (r *Repository) CreateIssuance(input *entity.Issuance) (*entity.Issuance, error) {
 	if err := r.Db.Create(input).Error; err != nil {
 		return nil, fmt.Errorf("failed to create issuance: %w", err)
 	}
 	return input, nil
 }
 
-func (r *SQLiteRepository) FindIssuanceById(id uint) (*entity.Issuance, error) {
+func (r *Repository) FindIssuanceById(id uint) (*entity.Issuance, error) {
 	var issuance entity.Issuance
 	if err := r.Db.
-		Preload("Orders").
+		Preload("Orders", ordersById).
 		First(&issuance, id).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, entity.ErrIssuanceNotFound
@@ -28,44 +28,52 @@ func (r *SQLiteRepository) FindIssuanceById(id uint) (*entity.Issuance, error) {
 	return &issuance, nil
 }
 
-func (r *SQLiteRepository) FindAllIssuances() ([]*entity.Issuance, error) {
+// ordersById preloads the orders of issuances in creation order.
+func ordersById(db *gorm.DB) *gorm.DB {
+	return db.Order("id")
+}
+
+func (r *Repository) FindAllIssuances() ([]*entity.Issuance, error) {
 	var issuance []*entity.Issuance
 	if err := r.Db.
-		Preload("Orders").
+		Preload("Orders", ordersById).
+		Order("issuances.id").
 		Find(&issuance).Error; err != nil {
 		return nil, fmt.Errorf("failed to find all issuances: %w", err)
 	}
 	return issuance, nil
 }
 
-func (r *SQLiteRepository) FindIssuancesByInvestorAddress(investor Address) ([]*entity.Issuance, error) {
+func (r *Repository) FindIssuancesByInvestorAddress(investor Address) ([]*entity.Issuance, error) {
 	var issuance []*entity.Issuance
 	if err := r.Db.
 		Joins("JOIN orders ON orders.issuance_id = issuances.id").
 		Where("orders.investor_address = ?", investor).
-		Preload("Orders").
+		Preload("Orders", ordersById).
+		Order("issuances.id").
 		Find(&issuance).Error; err != nil {
 		return nil, fmt.Errorf("failed to find Issuances by investor: %w", err)
 	}
 	return issuance, nil
 }
 
-func (r *SQLiteRepository) FindIssuancesByCreatorAddress(creator Address) ([]*entity.Issuance, error) {
+func (r *Repository) FindIssuancesByCreatorAddress(creator Address) ([]*entity.Issuance, error) {
 	var issuance []*entity.Issuance
 	if err := r.Db.
 		Where("creator_address = ?", creator).
-		Preload("Orders").
+		Preload("Orders", ordersById).
+		Order("issuances.id").
 		Find(&issuance).Error; err != nil {
 		return nil, fmt.Errorf("failed to find issuances by creator: %w", err)
 	}
 	return issuance, nil
 }
 
-func (r *SQLiteRepository) FindOngoingIssuanceByCreatorAddress(creator Address) (*entity.Issuance, error) {
+func (r *Repository) FindOngoingIssuanceByCreatorAddress(creator Address) (*entity.Issuance, error) {
 	var issuance entity.Issuance
 	if err := r.Db.
 		Where("creator_address = ? AND state = ?", creator, entity.IssuanceStateOngoing).
-		Preload("Orders").
+		Preload("Orders", ordersById).
 		First(&issuance).Error; err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, entity.ErrIssuanceNotFound
@@ -75,7 +83,7 @@ func (r *SQLiteRepository) FindOngoingIssuanceByCreatorAddress(creator Address)
 	return &issuance, nil
 }
 
-func (r *SQLiteRepository) UpdateIssuance(input *entity.Issuance) (*entity.Issuance, error) {
+func (r *Repository) UpdateIssuance(input *entity.Issuance) (*entity.Issuance, error) {
 	if err := r.Db.Save(input).Error; err != nil {
 		return nil, fmt.Errorf("failed to update issuance: %w", err)
 	}
@@ -85,3 +93,22 @@ func (r *SQLiteRepository) UpdateIssuance(input *entity.Issuance) (*entity.Issua
 	}
 	return issuance, nil
 }
+
+// FindCollateralAmountsByToken selects the collateral amounts of the issuances in any of the
+// given states, grouped by collateral token.
+func (r *Repository) FindCollateralAmountsByToken(states ...entity.IssuanceState) ([]*entity.TokenAmounts, error) {
+	var rows []tokenAmountsRow
+	if err := r.Db.Model(&entity.Issuance{}).
+		Select("collateral_address AS token, "+r.dialect.Amounts("collateral_amount")+" AS amounts").
+		Where("state IN ?", states).
+		Group("collateral_address").
+		Order("collateral_address").
+		Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to find collateral amounts by token: %w", err)
+	}
+	result, err := tokenAmounts(rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find collateral amounts by token: %w", err)
+	}
+	return result, nil
+}
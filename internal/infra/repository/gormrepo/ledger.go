@@ -0,0 +1,39 @@
+package gormrepo
+
+import (
+	"fmt"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
+	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+)
+
+func (r *Repository) CreateLedgerEntry(input *entity.LedgerEntry) (*entity.LedgerEntry, error) {
+	if err := r.Db.Create(input).Error; err != nil {
+		return nil, fmt.Errorf("failed to create ledger entry: %w", err)
+	}
+	return input, nil
+}
+
+func (r *Repository) FindAllLedgerEntries() ([]*entity.LedgerEntry, error) {
+	var entries []*entity.LedgerEntry
+	if err := r.Db.Order("id").Find(&entries).Error; err != nil {
+		return nil, fmt.Errorf("failed to find all ledger entries: %w", err)
+	}
+	return entries, nil
+}
+
+func (r *Repository) FindLedgerEntriesByIssuanceId(issuanceId uint) ([]*entity.LedgerEntry, error) {
+	var entries []*entity.LedgerEntry
+	if err := r.Db.Where("issuance_id = ?", issuanceId).Order("id").Find(&entries).Error; err != nil {
+		return nil, fmt.Errorf("failed to find ledger entries by issuance ID: %w", err)
+	}
+	return entries, nil
+}
+
+func (r *Repository) FindLedgerEntriesByAccount(account Address) ([]*entity.LedgerEntry, error) {
+	var entries []*entity.LedgerEntry
+	if err := r.Db.Where("debit_account = ? OR credit_account = ?", account, account).Order("id").Find(&entries).Error; err != nil {
+		return nil, fmt.Errorf("failed to find ledger entries by account: %w", err)
+	}
+	return entries, nil
+}
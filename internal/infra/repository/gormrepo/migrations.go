@@ -0,0 +1,95 @@
+package gormrepo
+
+import (
+	"fmt"
+	"log/slog"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
+)
+
+// Migration changes the schema from the previous version to Version. Migrations are applied
+// in order, each in its own transaction, and recorded in the schema_migrations table. A
+// released migration must never change: add a new one instead, to every dialect.
+type Migration struct {
+	Version uint
+	Name    string
+	Up      func(tx *gorm.DB) error
+}
+
+// Exec runs the statements of a migration in order.
+func Exec(tx *gorm.DB, statements ...string) error {
+	for _, statement := range statements {
+		if err := tx.Exec(statement).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *Repository) appliedMigrations() (map[uint]*entity.Migration, error) {
+	if err := r.Db.Exec(r.dialect.MigrationsTable).Error; err != nil {
+		return nil, fmt.Errorf("failed to create schema_migrations table: %w", err)
+	}
+	var rows []*entity.Migration
+	if err := r.Db.Order("version").Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to find applied migrations: %w", err)
+	}
+	applied := make(map[uint]*entity.Migration, len(rows))
+	for _, row := range rows {
+		applied[row.Version] = row
+	}
+	migrations := r.dialect.Migrations
+	last := migrations[len(migrations)-1]
+	for version := range applied {
+		if version > last.Version {
+			return nil, fmt.Errorf("database is at migration %d, newer than the last migration %d known to this build", version, last.Version)
+		}
+	}
+	return applied, nil
+}
+
+// MigrationStatus returns every migration, with AppliedAt set for those already applied.
+func (r *Repository) MigrationStatus() ([]*entity.Migration, error) {
+	applied, err := r.appliedMigrations()
+	if err != nil {
+		return nil, err
+	}
+	status := make([]*entity.Migration, 0, len(r.dialect.Migrations))
+	for _, m := range r.dialect.Migrations {
+		if row, ok := applied[m.Version]; ok {
+			status = append(status, row)
+			continue
+		}
+		status = append(status, &entity.Migration{Version: m.Version, Name: m.Name})
+	}
+	return status, nil
+}
+
+// MigrateUp applies the pending migrations in order and returns them.
+func (r *Repository) MigrateUp() ([]*entity.Migration, error) {
+	applied, err := r.appliedMigrations()
+	if err != nil {
+		return nil, err
+	}
+	result := []*entity.Migration{}
+	for _, m := range r.dialect.Migrations {
+		if _, ok := applied[m.Version]; ok {
+			continue
+		}
+		row := &entity.Migration{Version: m.Version, Name: m.Name, AppliedAt: time.Now().Unix()}
+		if err := r.Db.Transaction(func(tx *gorm.DB) error {
+			if err := m.Up(tx); err != nil {
+				return err
+			}
+			return tx.Create(row).Error
+		}); err != nil {
+			return nil, fmt.Errorf("failed to apply migration %d %s: %w", m.Version, m.Name, err)
+		}
+		slog.Info("Applied migration", "version", m.Version, "name", m.Name)
+		result = append(result, row)
+	}
+	return result, nil
+}
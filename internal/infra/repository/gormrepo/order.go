@@ -0,0 +1,104 @@
+package gormrepo
+
+import (
+	"fmt"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
+	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+	"gorm.io/gorm"
+)
+
+func (r *Repository) CreateOrder(input *entity.Order) (*entity.Order, error) {
+	if err := r.Db.Create(input).Error; err != nil {
+		return nil, fmt.Errorf("failed to create order: %w", err)
+	}
+	return input, nil
+}
+
+func (r *Repository) FindOrderById(id uint) (*entity.Order, error) {
+	var order entity.Order
+	if err := r.Db.First(&order, id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, entity.ErrOrderNotFound
+		}
+		return nil, fmt.Errorf("failed to find order by ID: %w", err)
+	}
+	return &order, nil
+}
+
+func (r *Repository) FindOrdersByIssuanceId(id uint) ([]*entity.Order, error) {
+	var orders []*entity.Order
+	if err := r.Db.Where("issuance_id = ?", id).Order("id").Find(&orders).Error; err != nil {
+		return nil, fmt.Errorf("failed to find orders by issuance ID: %w", err)
+	}
+	return orders, nil
+}
+
+func (r *Repository) FindOrdersByState(issuanceId uint, state string) ([]*entity.Order, error) {
+	var orders []*entity.Order
+	if err := r.Db.
+		Where("issuance_id = ? AND state = ?", issuanceId, state).
+		Order("id").
+		Find(&orders).Error; err != nil {
+		return nil, fmt.Errorf("failed to find orders by state: %w", err)
+	}
+	return orders, nil
+}
+
+func (r *Repository) FindOrdersByInvestorAddress(investor Address) ([]*entity.Order, error) {
+	var orders []*entity.Order
+	if err := r.Db.Where("investor_address = ?", investor).Order("id").Find(&orders).Error; err != nil {
+		return nil, fmt.Errorf("failed to find orders by investor: %w", err)
+	}
+	return orders, nil
+}
+
+func (r *Repository) FindAllOrders() ([]*entity.Order, error) {
+	var orders []*entity.Order
+	if err := r.Db.Order("id").Find(&orders).Error; err != nil {
+		return nil, fmt.Errorf("failed to find all orders: %w", err)
+	}
+	return orders, nil
+}
+
+func (r *Repository) UpdateOrder(input *entity.Order) (*entity.Order, error) {
+	if err := r.Db.Save(input).Error; err != nil {
+		return nil, fmt.Errorf("failed to update order: %w", err)
+	}
+	order, err := r.FindOrderById(input.Id)
+	if err != nil {
+		return nil, err
+	}
+	return order, nil
+}
+
+func (r *Repository) DeleteOrder(id uint) error {
+	res := r.Db.Delete(&entity.Order{}, id)
+	if res.Error != nil {
+		return fmt.Errorf("failed to delete order: %w", res.Error)
+	}
+	if res.RowsAffected == 0 {
+		return entity.ErrOrderNotFound
+	}
+	return nil
+}
+
+// FindOrderAmountsByToken selects the amounts of the orders in any of the given states,
+// grouped by the token of their issuance.
+func (r *Repository) FindOrderAmountsByToken(states ...entity.OrderState) ([]*entity.TokenAmounts, error) {
+	var rows []tokenAmountsRow
+	if err := r.Db.Model(&entity.Order{}).
+		Select("issuances.token AS token, "+r.dialect.Amounts("orders.amount")+" AS amounts").
+		Joins("JOIN issuances ON issuances.id = orders.issuance_id").
+		Where("orders.state IN ?", states).
+		Group("issuances.token").
+		Order("issuances.token").
+		Scan(&rows).Error; err != nil {
+		return nil, fmt.Errorf("failed to find order amounts by token: %w", err)
+	}
+	result, err := tokenAmounts(rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find order amounts by token: %w", err)
+	}
+	return result, nil
+}
@@ -0,0 +1,56 @@
+package gormrepo
+
+import (
+	"fmt"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
+	"gorm.io/gorm"
+)
+
+func (r *Repository) CreateProposal(input *entity.Proposal) (*entity.Proposal, error) {
+	if err := r.Db.Create(input).Error; err != nil {
+		return nil, fmt.Errorf("failed to create proposal: %w", err)
+	}
+	return input, nil
+}
+
+func (r *Repository) FindProposalById(id uint) (*entity.Proposal, error) {
+	var proposal entity.Proposal
+	if err := r.Db.Preload("Approvals", func(db *gorm.DB) *gorm.DB {
+		return db.Order("created_at, approver")
+	}).First(&proposal, id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, entity.ErrProposalNotFound
+		}
+		return nil, fmt.Errorf("failed to find proposal by ID: %w", err)
+	}
+	return &proposal, nil
+}
+
+func (r *Repository) FindAllProposals() ([]*entity.Proposal, error) {
+	var proposals []*entity.Proposal
+	if err := r.Db.Preload("Approvals", func(db *gorm.DB) *gorm.DB {
+		return db.Order("created_at, approver")
+	}).Order("id").Find(&proposals).Error; err != nil {
+		return nil, fmt.Errorf("failed to find all proposals: %w", err)
+	}
+	return proposals, nil
+}
+
+func (r *Repository) CreateProposalApproval(input *entity.ProposalApproval) error {
+	if err := r.Db.Create(input).Error; err != nil {
+		return fmt.Errorf("failed to create proposal approval: %w", err)
+	}
+	return nil
+}
+
+func (r *Repository) UpdateProposal(input *entity.Proposal) (*entity.Proposal, error) {
+	if err := r.Db.Omit("Approvals").Save(input).Error; err != nil {
+		return nil, fmt.Errorf("failed to update proposal: %w", err)
+	}
+	proposal, err := r.FindProposalById(input.Id)
+	if err != nil {
+		return nil, err
+	}
+	return proposal, nil
+}
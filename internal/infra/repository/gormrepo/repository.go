@@ -0,0 +1,167 @@
+// Package gormrepo implements repository.Repository with gorm. It holds the queries every
+// database runs alike; the sqlite and postgres packages open the database and supply the
+// Dialect for the rest.
+package gormrepo
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
+)
+
+// Dialect holds what differs between the databases the repository runs on.
+type Dialect struct {
+	// Migrations create and evolve the schema, in the DDL of the database
+	Migrations []Migration
+	// MigrationsTable creates the schema_migrations table if it does not exist
+	MigrationsTable string
+	// Amounts aggregates a column of uint256 amounts per group into a comma separated list of
+	// decimals, summed or not
+	Amounts func(column string) string
+	// AfterImport runs in the import transaction for every imported table, once its rows are in
+	AfterImport func(tx *gorm.DB, table string, primaryKeys []string) error
+}
+
+type Repository struct {
+	Db      *gorm.DB
+	dialect Dialect
+	// root is the database Db points to while a transaction is in progress
+	root *gorm.DB
+	// tables are the tables of the models, parsed once
+	tables []modelTable
+	// changed holds the tables written since their state rows were last taken, and taken the
+	// tables taken in the transaction in progress, changed again if it is rolled back
+	changed map[string]bool
+	taken   map[string]bool
+	// touched holds the issuances whose row, orders or ledger entries were written in the
+	// transaction in progress
+	touched map[uint]bool
+}
+
+// New wraps an open database. It does not migrate it.
+func New(db *gorm.DB, dialect Dialect) (*Repository, error) {
+	repo := &Repository{Db: db, dialect: dialect}
+	var err error
+	if repo.tables, err = repo.modelTables(); err != nil {
+		return nil, err
+	}
+	if err := repo.trackChanges(); err != nil {
+		return nil, err
+	}
+	return repo, nil
+}
+
+func (r *Repository) Close() error {
+	sqlDB, err := r.Db.DB()
+	if err != nil {
+		return fmt.Errorf("failed to get database instance: %w", err)
+	}
+	return sqlDB.Close()
+}
+
+// models are the entities stored in their own table, in an order where every table comes
+// after the tables it references.
+var models = []any{
+	&entity.Issuance{},
+	&entity.Order{},
+	&entity.User{},
+	&entity.UserRoleAssignment{},
+	&entity.AddressRotation{},
+	&entity.SocialAccount{},
+	&entity.SocialAccountChallenge{},
+	&entity.PlatformSetting{},
+	&entity.CreatorReputation{},
+	&entity.Attestation{},
+	&entity.Proposal{},
+	&entity.ProposalApproval{},
+	&entity.EmergencyWithdrawal{},
+	&entity.LedgerEntry{},
+	&entity.Genesis{},
+	&entity.AllowedToken{},
+}
+
+type modelTable struct {
+	name        string
+	primaryKeys []string
+	// boolColumns are exported as 0 or 1, the way SQLite stores them, and imported back as
+	// booleans
+	boolColumns map[string]bool
+}
+
+func (r *Repository) modelTables() ([]modelTable, error) {
+	tables := make([]modelTable, 0, len(models))
+	for _, model := range models {
+		stmt := &gorm.Statement{DB: r.Db}
+		if err := stmt.Parse(model); err != nil {
+			return nil, fmt.Errorf("failed to parse model %T: %w", model, err)
+		}
+		table := modelTable{
+			name:        stmt.Schema.Table,
+			primaryKeys: stmt.Schema.PrimaryFieldDBNames,
+			boolColumns: make(map[string]bool),
+		}
+		for _, field := range stmt.Schema.Fields {
+			if field.DBName != "" && field.DataType == schema.Bool {
+				table.boolColumns[field.DBName] = true
+			}
+		}
+		tables = append(tables, table)
+	}
+	return tables, nil
+}
+
+// scanRows reads rows as the driver returns them, so amounts the database stores as numbers
+// are not rounded to floats, and normalizes the values: text as strings and booleans as 0 or 1.
+func scanRows(rows *sql.Rows) ([]entity.SnapshotRow, error) {
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+	result := []entity.SnapshotRow{}
+	for rows.Next() {
+		values := make([]any, len(columns))
+		pointers := make([]any, len(columns))
+		for i := range values {
+			pointers[i] = &values[i]
+		}
+		if err := rows.Scan(pointers...); err != nil {
+			return nil, err
+		}
+		row := make(entity.SnapshotRow, len(columns))
+		for i, column := range columns {
+			switch v := values[i].(type) {
+			case []byte:
+				row[column] = string(v)
+			case bool:
+				if v {
+					row[column] = int64(1)
+				} else {
+					row[column] = int64(0)
+				}
+			default:
+				row[column] = v
+			}
+		}
+		result = append(result, row)
+	}
+	return result, rows.Err()
+}
+
+// exportTable returns the rows of a table sorted by primary key.
+func (r *Repository) exportTable(table modelTable) ([]entity.SnapshotRow, error) {
+	rows, err := r.Db.Table(table.name).Order(strings.Join(table.primaryKeys, ", ")).Rows()
+	if err != nil {
+		return nil, fmt.Errorf("failed to export table %s: %w", table.name, err)
+	}
+	result, err := scanRows(rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export table %s: %w", table.name, err)
+	}
+	return result, nil
+}
@@ -0,0 +1,14 @@
+package gormrepo
+
+import (
+	"fmt"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
+)
+
+func (r *Repository) SaveCreatorReputation(input *entity.CreatorReputation) (*entity.CreatorReputation, error) {
+	if err := r.Db.Save(input).Error; err != nil {
+		return nil, fmt.Errorf("failed to save creator reputation: %w", err)
+	}
+	return input, nil
+}
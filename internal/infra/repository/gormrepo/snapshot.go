@@ -0,0 +1,91 @@
+package gormrepo
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
+)
+
+// snapshotBatchSize keeps the bound parameters of an insert below the SQLite limit.
+const snapshotBatchSize = 100
+
+func (r *Repository) ExportTables() (map[string][]entity.SnapshotRow, error) {
+	result := make(map[string][]entity.SnapshotRow, len(r.tables))
+	for _, table := range r.tables {
+		rows, err := r.exportTable(table)
+		if err != nil {
+			return nil, err
+		}
+		result[table.name] = rows
+	}
+	return result, nil
+}
+
+// ImportTables inserts the rows into an empty database, in a single transaction.
+func (r *Repository) ImportTables(input map[string][]entity.SnapshotRow) error {
+	known := make(map[string]bool, len(r.tables))
+	for _, table := range r.tables {
+		known[table.name] = true
+	}
+	for name := range input {
+		if !known[name] {
+			return entity.ErrInvalidSnapshot.New(fmt.Sprintf("unknown table %s", name))
+		}
+	}
+
+	return r.Db.Transaction(func(tx *gorm.DB) error {
+		for _, table := range r.tables {
+			var count int64
+			if err := tx.Table(table.name).Count(&count).Error; err != nil {
+				return fmt.Errorf("failed to count rows of table %s: %w", table.name, err)
+			}
+			if count > 0 {
+				return entity.ErrRepositoryNotEmpty.New(table.name)
+			}
+		}
+		for _, table := range r.tables {
+			rows := make([]map[string]any, 0, len(input[table.name]))
+			for _, row := range input[table.name] {
+				values := make(map[string]any, len(row))
+				for column, value := range row {
+					converted, err := importValue(value, table.boolColumns[column])
+					if err != nil {
+						return entity.ErrInvalidSnapshot.New(fmt.Sprintf("column %s of table %s: %v", column, table.name, err))
+					}
+					values[column] = converted
+				}
+				rows = append(rows, values)
+			}
+			if len(rows) == 0 {
+				continue
+			}
+			if err := tx.Table(table.name).CreateInBatches(rows, snapshotBatchSize).Error; err != nil {
+				return fmt.Errorf("failed to import table %s: %w", table.name, err)
+			}
+			if r.dialect.AfterImport != nil {
+				if err := r.dialect.AfterImport(tx, table.name, table.primaryKeys); err != nil {
+					return fmt.Errorf("failed to import table %s: %w", table.name, err)
+				}
+			}
+		}
+		return nil
+	})
+}
+
+// importValue binds a snapshot value as the type of its column. Snapshots hold booleans as
+// 0 or 1, which databases with a boolean type do not convert.
+func importValue(value any, boolean bool) (any, error) {
+	if !boolean {
+		return value, nil
+	}
+	switch v := value.(type) {
+	case int64:
+		return v != 0, nil
+	case nil, bool:
+		return v, nil
+	default:
+		return nil, fmt.Errorf("%v is not a boolean", value)
+	}
+}
@@ -0,0 +1,85 @@
+package gormrepo
+
+import (
+	"fmt"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
+	"gorm.io/gorm"
+)
+
+func (r *Repository) CreateSocialAccount(input *entity.SocialAccount) (*entity.SocialAccount, error) {
+	if err := r.Db.Create(input).Error; err != nil {
+		return nil, fmt.Errorf("failed to verify social account: %w", err)
+	}
+	return input, nil
+}
+
+func (r *Repository) FindSocialAccountById(id uint) (*entity.SocialAccount, error) {
+	var account entity.SocialAccount
+	if err := r.Db.First(&account, id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, entity.ErrSocialAccountNotFound
+		}
+		return nil, fmt.Errorf("failed to find social account by ID: %w", err)
+	}
+	return &account, nil
+}
+
+func (r *Repository) FindSocialAccountsByUserId(userID uint) ([]*entity.SocialAccount, error) {
+	var accounts []*entity.SocialAccount
+	if err := r.Db.Where("user_id = ?", userID).Order("id").Find(&accounts).Error; err != nil {
+		return nil, fmt.Errorf("failed to find social accounts by user ID: %w", err)
+	}
+	return accounts, nil
+}
+
+func (r *Repository) DeleteSocialAccount(id uint) error {
+	res := r.Db.Delete(&entity.SocialAccount{}, id)
+	if res.Error != nil {
+		return fmt.Errorf("failed to delete social account: %w", res.Error)
+	}
+	if res.RowsAffected == 0 {
+		return entity.ErrSocialAccountNotFound
+	}
+	return nil
+}
+
+func (r *Repository) CreateSocialAccountChallenge(input *entity.SocialAccountChallenge) (*entity.SocialAccountChallenge, error) {
+	if err := r.Db.Create(input).Error; err != nil {
+		return nil, fmt.Errorf("failed to create social account challenge: %w", err)
+	}
+	return input, nil
+}
+
+func (r *Repository) FindSocialAccountChallengeById(id uint) (*entity.SocialAccountChallenge, error) {
+	var challenge entity.SocialAccountChallenge
+	if err := r.Db.First(&challenge, id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, entity.ErrSocialChallengeNotFound
+		}
+		return nil, fmt.Errorf("failed to find social account challenge by ID: %w", err)
+	}
+	return &challenge, nil
+}
+
+func (r *Repository) UpdateSocialAccountChallenge(input *entity.SocialAccountChallenge) (*entity.SocialAccountChallenge, error) {
+	if err := r.Db.Save(input).Error; err != nil {
+		return nil, fmt.Errorf("failed to update social account challenge: %w", err)
+	}
+	return input, nil
+}
+
+func (r *Repository) FindAllPlatformSettings() ([]*entity.PlatformSetting, error) {
+	var settings []*entity.PlatformSetting
+	if err := r.Db.Order("platform").Find(&settings).Error; err != nil {
+		return nil, fmt.Errorf("failed to find platform settings: %w", err)
+	}
+	return settings, nil
+}
+
+func (r *Repository) SavePlatformSetting(input *entity.PlatformSetting) (*entity.PlatformSetting, error) {
+	if err := r.Db.Save(input).Error; err != nil {
+		return nil, fmt.Errorf("failed to save platform setting: %w", err)
+	}
+	return input, nil
+}
@@ -0,0 +1,167 @@
+package gormrepo
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
+)
+
+// trackChanges registers callbacks recording the tables every create, update and delete
+// writes to, so only the state leaves of those tables are recomputed.
+func (r *Repository) trackChanges() error {
+	r.changed = make(map[string]bool, len(r.tables))
+	r.markAllChanged()
+
+	callbacks := r.Db.Callback()
+	if err := callbacks.Create().After("gorm:create").Register("state:track_create", r.markChanged); err != nil {
+		return fmt.Errorf("failed to register create callback: %w", err)
+	}
+	if err := callbacks.Update().After("gorm:update").Register("state:track_update", r.markChanged); err != nil {
+		return fmt.Errorf("failed to register update callback: %w", err)
+	}
+	if err := callbacks.Delete().After("gorm:delete").Register("state:track_delete", r.markChanged); err != nil {
+		return fmt.Errorf("failed to register delete callback: %w", err)
+	}
+	return nil
+}
+
+func (r *Repository) markChanged(db *gorm.DB) {
+	if db.Statement.Table == "" {
+		r.markAllChanged()
+		return
+	}
+	r.changed[db.Statement.Table] = true
+	r.markTouched(db.Statement.Model)
+}
+
+// markTouched records the issuance a written issuance, order or ledger entry belongs to.
+// Writes made outside a transaction are not recorded.
+func (r *Repository) markTouched(model any) {
+	if r.touched == nil {
+		return
+	}
+	var issuanceId uint
+	switch m := model.(type) {
+	case *entity.Issuance:
+		issuanceId = m.Id
+	case *entity.Order:
+		issuanceId = m.IssuanceId
+	case *entity.LedgerEntry:
+		issuanceId = m.IssuanceId
+	}
+	if issuanceId != 0 {
+		r.touched[issuanceId] = true
+	}
+}
+
+// FindTouchedIssuanceIds returns the issuances whose row, orders or ledger entries were
+// written in the transaction in progress, sorted by ID.
+func (r *Repository) FindTouchedIssuanceIds() []uint {
+	ids := make([]uint, 0, len(r.touched))
+	for id := range r.touched {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+func (r *Repository) markAllChanged() {
+	for _, table := range r.tables {
+		r.changed[table.name] = true
+	}
+}
+
+func stateKey(table modelTable, row entity.SnapshotRow) string {
+	parts := []string{table.name}
+	for _, primaryKey := range table.primaryKeys {
+		parts = append(parts, fmt.Sprint(row[primaryKey]))
+	}
+	return strings.Join(parts, "/")
+}
+
+// TakeChangedStateRows returns every row of the tables written since the previous call, or of
+// every table on the first call, keyed by table name.
+func (r *Repository) TakeChangedStateRows() (map[string][]*entity.StateRow, error) {
+	result := make(map[string][]*entity.StateRow)
+	for _, table := range r.tables {
+		if !r.changed[table.name] {
+			continue
+		}
+		rows, err := r.exportTable(table)
+		if err != nil {
+			return nil, err
+		}
+		result[table.name] = make([]*entity.StateRow, 0, len(rows))
+		for _, row := range rows {
+			result[table.name] = append(result[table.name], &entity.StateRow{
+				Key:     stateKey(table, row),
+				Table:   table.name,
+				Content: row,
+			})
+		}
+	}
+
+	if r.root != nil {
+		if r.taken == nil {
+			r.taken = make(map[string]bool)
+		}
+		for name := range result {
+			r.taken[name] = true
+		}
+	}
+	r.changed = make(map[string]bool, len(r.tables))
+	return result, nil
+}
+
+func (r *Repository) FindStateRow(key string) (*entity.StateRow, error) {
+	parts := strings.Split(key, "/")
+	for _, table := range r.tables {
+		if table.name != parts[0] || len(parts)-1 != len(table.primaryKeys) {
+			continue
+		}
+		query := r.Db.Table(table.name)
+		for i, primaryKey := range table.primaryKeys {
+			query = query.Where(clause.Eq{Column: clause.Column{Name: primaryKey}, Value: parts[i+1]})
+		}
+		result, err := query.Limit(1).Rows()
+		if err != nil {
+			return nil, fmt.Errorf("failed to find state row: %w", err)
+		}
+		rows, err := scanRows(result)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find state row: %w", err)
+		}
+		if len(rows) == 0 {
+			break
+		}
+		return &entity.StateRow{Key: key, Table: table.name, Content: rows[0]}, nil
+	}
+	return nil, entity.ErrStateLeafNotFound.New(key)
+}
+
+// ReplaceStateLeaves replaces the leaves of the rows of a table.
+func (r *Repository) ReplaceStateLeaves(table string, leaves []*entity.StateLeaf) error {
+	if err := r.Db.Where(&entity.StateLeaf{Table: table}).Delete(&entity.StateLeaf{}).Error; err != nil {
+		return fmt.Errorf("failed to delete state leaves of table %s: %w", table, err)
+	}
+	if len(leaves) == 0 {
+		return nil
+	}
+	if err := r.Db.CreateInBatches(leaves, snapshotBatchSize).Error; err != nil {
+		return fmt.Errorf("failed to create state leaves of table %s: %w", table, err)
+	}
+	return nil
+}
+
+func (r *Repository) FindAllStateLeaves() ([]*entity.StateLeaf, error) {
+	var leaves []*entity.StateLeaf
+	if err := r.Db.Order(clause.OrderByColumn{Column: clause.Column{Name: "key"}}).Find(&leaves).Error; err != nil {
+		return nil, fmt.Errorf("failed to find state leaves: %w", err)
+	}
+	return leaves, nil
+}
@@ -0,0 +1,57 @@
+package gormrepo
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrTransactionInProgress = errors.New("transaction already in progress")
+	ErrNoTransaction         = errors.New("no transaction in progress")
+)
+
+// BeginTransaction points Db to a new transaction, so every repository call made until it
+// is committed or rolled back runs inside it. Inputs are processed one at a time, so a
+// single transaction is open at most.
+func (r *Repository) BeginTransaction() error {
+	if r.root != nil {
+		return ErrTransactionInProgress
+	}
+	tx := r.Db.Begin()
+	if tx.Error != nil {
+		return fmt.Errorf("failed to begin transaction: %w", tx.Error)
+	}
+	r.root, r.Db = r.Db, tx
+	r.touched = make(map[uint]bool)
+	return nil
+}
+
+func (r *Repository) CommitTransaction() error {
+	if r.root == nil {
+		return ErrNoTransaction
+	}
+	tx := r.Db
+	r.Db, r.root = r.root, nil
+	r.taken, r.touched = nil, nil
+	if err := tx.Commit().Error; err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+func (r *Repository) RollbackTransaction() error {
+	if r.root == nil {
+		return ErrNoTransaction
+	}
+	tx := r.Db
+	r.Db, r.root = r.root, nil
+	// the state leaves written in the transaction are rolled back with it
+	for table := range r.taken {
+		r.changed[table] = true
+	}
+	r.taken, r.touched = nil, nil
+	if err := tx.Rollback().Error; err != nil {
+		return fmt.Errorf("failed to roll back transaction: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package gormrepo
+
+import (
+	"fmt"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
+	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+	"gorm.io/gorm"
+)
+
+func (r *Repository) CreateUser(input *entity.User) (*entity.User, error) {
+	if err := r.Db.Create(input).Error; err != nil {
+		return nil, fmt.Errorf("failed to create user: %w", err)
+	}
+	return input, nil
+}
+
+func (r *Repository) FindUserByAddress(address Address) (*entity.User, error) {
+	var user entity.User
+	if err := r.Db.Preload("Roles").Preload("SocialAccounts").Preload("Reputation").Where("address = ?", address).First(&user).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, entity.ErrUserNotFound
+		}
+		return nil, fmt.Errorf("failed to find user by address: %w", err)
+	}
+	return &user, nil
+}
+
+// FindUsersByRole returns the active users holding role. Deactivated users are skipped.
+func (r *Repository) FindUsersByRole(role string) ([]*entity.User, error) {
+	var users []*entity.User
+	if err := r.Db.Preload("Roles").Preload("SocialAccounts").Preload("Reputation").
+		Joins("JOIN user_roles ON user_roles.user_id = users.id").
+		Where("user_roles.role = ? AND users.status = ?", role, entity.UserStatusActive).
+		Order("users.id").
+		Find(&users).Error; err != nil {
+		return nil, fmt.Errorf("failed to find users by role: %w", err)
+	}
+	return users, nil
+}
+
+func (r *Repository) FindAllUsers() ([]*entity.User, error) {
+	var users []*entity.User
+	if err := r.Db.Preload("Roles").Preload("SocialAccounts").Preload("Reputation").Order("id").Find(&users).Error; err != nil {
+		return nil, fmt.Errorf("failed to find all users: %w", err)
+	}
+	return users, nil
+}
+
+func (r *Repository) UpdateUser(input *entity.User) (*entity.User, error) {
+	if err := r.Db.Omit("Roles", "SocialAccounts", "Reputation").Save(input).Error; err != nil {
+		return nil, fmt.Errorf("failed to update user: %w", err)
+	}
+	user, err := r.FindUserByAddress(input.Address)
+	if err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+func (r *Repository) CreateUserRole(role *entity.UserRoleAssignment) error {
+	if err := r.Db.Create(role).Error; err != nil {
+		return fmt.Errorf("failed to create user role: %w", err)
+	}
+	return nil
+}
+
+func (r *Repository) DeleteUserRole(userId uint, role entity.UserRole) error {
+	res := r.Db.Where("user_id = ? AND role = ?", userId, role).Delete(&entity.UserRoleAssignment{})
+	if res.Error != nil {
+		return fmt.Errorf("failed to delete user role: %w", res.Error)
+	}
+	if res.RowsAffected == 0 {
+		return entity.ErrRoleNotGranted
+	}
+	return nil
+}
+
+// RotateUserAddress moves the user and every order and issuance referencing its old
+// address to the new one. Social accounts follow the user id and are only touched.
+func (r *Repository) RotateUserAddress(userId uint, oldAddress Address, newAddress Address, rotatedAt int64) error {
+	return r.Db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&entity.User{}).Where("id = ?", userId).
+			UpdateColumns(map[string]any{"address": newAddress, "updated_at": rotatedAt}).Error; err != nil {
+			return fmt.Errorf("failed to rotate user address: %w", err)
+		}
+		if err := tx.Model(&entity.Order{}).Where("investor_address = ?", oldAddress).
+			UpdateColumn("investor_address", newAddress).Error; err != nil {
+			return fmt.Errorf("failed to rotate order investor address: %w", err)
+		}
+		if err := tx.Model(&entity.Issuance{}).Where("creator_address = ?", oldAddress).
+			UpdateColumn("creator_address", newAddress).Error; err != nil {
+			return fmt.Errorf("failed to rotate issuance creator address: %w", err)
+		}
+		if err := tx.Model(&entity.SocialAccount{}).Where("user_id = ?", userId).
+			UpdateColumn("updated_at", rotatedAt).Error; err != nil {
+			return fmt.Errorf("failed to update social accounts: %w", err)
+		}
+		return nil
+	})
+}
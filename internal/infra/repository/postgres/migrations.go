@@ -0,0 +1,174 @@
+package postgres
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/configs"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository/gormrepo"
+	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+)
+
+// migrations are the PostgreSQL schema. Every migration has a counterpart of the same version
+// in the sqlite package. Text sorts bytewise, as in SQLite, and uint256 amounts are stored as
+// NUMERIC(78,0), which holds every 78 digit uint256.
+var migrations = []gormrepo.Migration{
+	{Version: 1, Name: "create_schema", Up: createSchema},
+	{Version: 2, Name: "create_user_roles", Up: createUserRoles},
+	{Version: 3, Name: "create_proposals", Up: createProposals},
+	{Version: 4, Name: "create_emergency_withdrawals", Up: createEmergencyWithdrawals},
+	{Version: 5, Name: "add_user_status", Up: addUserStatus},
+	{Version: 6, Name: "create_address_rotations", Up: createAddressRotations},
+	{Version: 7, Name: "create_attestations", Up: createAttestations},
+	{Version: 8, Name: "create_social_account_challenges", Up: createSocialAccountChallenges},
+	{Version: 9, Name: "create_platform_settings", Up: createPlatformSettings},
+	{Version: 10, Name: "create_creator_reputations", Up: createCreatorReputations},
+	{Version: 11, Name: "create_ledger_entries", Up: createLedgerEntries},
+	{Version: 12, Name: "create_state_leaves", Up: createStateLeaves},
+	{Version: 13, Name: "create_genesis", Up: createGenesis},
+	{Version: 14, Name: "backfill_collateral_deposits", Up: backfillCollateralDeposits},
+}
+
+const migrationsTable = `CREATE TABLE IF NOT EXISTS "schema_migrations" ("version" bigint PRIMARY KEY,"name" text NOT NULL,"applied_at" bigint NOT NULL)`
+
+// createSchema creates the schema of the SQLite create_schema migration, leaving the tables that
+// already exist as they are, as SQLite does.
+func createSchema(tx *gorm.DB) error {
+	return gormrepo.Exec(tx,
+		`CREATE TABLE IF NOT EXISTS "issuances" ("id" bigserial PRIMARY KEY,"title" text COLLATE "C" NOT NULL,"description" text COLLATE "C" NOT NULL,"promotion" text COLLATE "C" NOT NULL,"token" text COLLATE "C" NOT NULL,"creator_address" text COLLATE "C" NOT NULL,"collateral_address" text COLLATE "C" NOT NULL,"collateral_amount" numeric(78,0) NOT NULL,"badge_address" text COLLATE "C" NOT NULL,"debt_issued" numeric(78,0) NOT NULL,"max_interest_rate" numeric(78,0) NOT NULL,"total_obligation" numeric(78,0) NOT NULL DEFAULT 0,"total_raised" numeric(78,0) NOT NULL DEFAULT 0,"state" text COLLATE "C" NOT NULL,"closes_at" bigint NOT NULL,"maturity_at" bigint NOT NULL,"created_at" bigint NOT NULL,"updated_at" bigint DEFAULT 0)`,
+		`CREATE TABLE IF NOT EXISTS "orders" ("id" bigserial PRIMARY KEY,"issuance_id" bigint NOT NULL,"investor_address" text COLLATE "C" NOT NULL,"amount" numeric(78,0) NOT NULL,"interest_rate" numeric(78,0) NOT NULL,"state" text COLLATE "C" NOT NULL,"created_at" bigint NOT NULL,"updated_at" bigint DEFAULT 0,CONSTRAINT "fk_issuances_orders" FOREIGN KEY ("issuance_id") REFERENCES "issuances"("id") ON DELETE CASCADE)`,
+		`CREATE INDEX IF NOT EXISTS "idx_orders_issuance_id" ON "orders"("issuance_id")`,
+		`CREATE TABLE IF NOT EXISTS "users" ("id" bigserial PRIMARY KEY,"role" text COLLATE "C" NOT NULL,"address" text COLLATE "C" NOT NULL,"created_at" bigint NOT NULL,"updated_at" bigint DEFAULT 0)`,
+		`CREATE UNIQUE INDEX IF NOT EXISTS "idx_users_address" ON "users"("address")`,
+		`CREATE TABLE IF NOT EXISTS "social_accounts" ("id" bigserial PRIMARY KEY,"user_id" bigint NOT NULL,"username" text COLLATE "C" NOT NULL,"platform" text COLLATE "C" NOT NULL,"created_at" bigint NOT NULL,"updated_at" bigint DEFAULT 0,CONSTRAINT "fk_users_social_accounts" FOREIGN KEY ("user_id") REFERENCES "users"("id") ON DELETE CASCADE)`,
+		`CREATE UNIQUE INDEX IF NOT EXISTS "idx_username_platform" ON "social_accounts"("username","platform")`,
+	)
+}
+
+// createUserRoles replaces the single role of the users with a set of roles, starting with the
+// role each user had.
+func createUserRoles(tx *gorm.DB) error {
+	return gormrepo.Exec(tx,
+		`CREATE TABLE "user_roles" ("user_id" bigint,"role" text COLLATE "C","created_at" bigint NOT NULL,PRIMARY KEY ("user_id","role"),CONSTRAINT "fk_users_roles" FOREIGN KEY ("user_id") REFERENCES "users"("id") ON DELETE CASCADE)`,
+		`INSERT INTO "user_roles" ("user_id","role","created_at") SELECT "id","role","created_at" FROM "users"`,
+		`ALTER TABLE "users" DROP COLUMN "role"`,
+	)
+}
+
+// createProposals creates the proposals of the governed routes and their approvals.
+func createProposals(tx *gorm.DB) error {
+	return gormrepo.Exec(tx,
+		`CREATE TABLE "proposals" ("id" bigserial PRIMARY KEY,"path" text COLLATE "C" NOT NULL,"payload" text COLLATE "C","proposer" text COLLATE "C" NOT NULL,"threshold" bigint NOT NULL,"state" text COLLATE "C" NOT NULL,"expires_at" bigint NOT NULL,"created_at" bigint NOT NULL,"updated_at" bigint DEFAULT 0)`,
+		`CREATE TABLE "proposal_approvals" ("proposal_id" bigint,"approver" text COLLATE "C","created_at" bigint NOT NULL,PRIMARY KEY ("proposal_id","approver"),CONSTRAINT "fk_proposals_approvals" FOREIGN KEY ("proposal_id") REFERENCES "proposals"("id") ON DELETE CASCADE)`,
+	)
+}
+
+// createEmergencyWithdrawals creates the queue of emergency withdrawals.
+func createEmergencyWithdrawals(tx *gorm.DB) error {
+	return gormrepo.Exec(tx,
+		`CREATE TABLE "emergency_withdrawals" ("id" bigserial PRIMARY KEY,"kind" text COLLATE "C" NOT NULL,"token" text COLLATE "C","to" text COLLATE "C" NOT NULL,"state" text COLLATE "C" NOT NULL,"requested_by" text COLLATE "C" NOT NULL,"canceled_by" text COLLATE "C","executed_by" text COLLATE "C","executable_at" bigint NOT NULL,"canceled_at" bigint DEFAULT 0,"executed_at" bigint DEFAULT 0,"created_at" bigint NOT NULL,"updated_at" bigint DEFAULT 0)`,
+	)
+}
+
+// addUserStatus lets users be deactivated instead of deleted. Existing users are active.
+func addUserStatus(tx *gorm.DB) error {
+	return gormrepo.Exec(tx,
+		`ALTER TABLE "users" ADD COLUMN "status" text COLLATE "C" NOT NULL DEFAULT 'active'`,
+		`ALTER TABLE "users" ADD COLUMN "deactivated_at" bigint DEFAULT 0`,
+	)
+}
+
+// createAddressRotations creates the rotations of user addresses.
+func createAddressRotations(tx *gorm.DB) error {
+	return gormrepo.Exec(tx,
+		`CREATE TABLE "address_rotations" ("id" bigserial PRIMARY KEY,"user_id" bigint NOT NULL,"old_address" text COLLATE "C" NOT NULL,"new_address" text COLLATE "C" NOT NULL,"state" text COLLATE "C" NOT NULL,"requires_approval" boolean NOT NULL,"approved_by" text COLLATE "C","accepted_at" bigint DEFAULT 0,"completed_at" bigint DEFAULT 0,"canceled_at" bigint DEFAULT 0,"created_at" bigint NOT NULL,"updated_at" bigint DEFAULT 0)`,
+		`CREATE INDEX "idx_address_rotations_user_id" ON "address_rotations"("user_id")`,
+	)
+}
+
+// createAttestations creates the attestations and the attestations issuances require.
+func createAttestations(tx *gorm.DB) error {
+	return gormrepo.Exec(tx,
+		`CREATE TABLE "attestations" ("id" bigserial PRIMARY KEY,"subject_id" bigint NOT NULL,"type" text COLLATE "C" NOT NULL,"jurisdiction" text COLLATE "C","issuer" text COLLATE "C" NOT NULL,"evidence_hash" text COLLATE "C" NOT NULL,"expires_at" bigint NOT NULL,"revoked_by" text COLLATE "C","revoked_at" bigint DEFAULT 0,"created_at" bigint NOT NULL,"updated_at" bigint DEFAULT 0)`,
+		`CREATE INDEX "idx_attestations_subject_id" ON "attestations"("subject_id")`,
+		`ALTER TABLE "issuances" ADD COLUMN "required_creator_attestations" text COLLATE "C"`,
+		`ALTER TABLE "issuances" ADD COLUMN "required_investor_attestations" text COLLATE "C"`,
+	)
+}
+
+// createSocialAccountChallenges creates the wallet challenges social accounts are verified with,
+// and the evidence of the verification. Accounts verified before have no evidence.
+func createSocialAccountChallenges(tx *gorm.DB) error {
+	return gormrepo.Exec(tx,
+		`CREATE TABLE "social_account_challenges" ("id" bigserial PRIMARY KEY,"user_id" bigint NOT NULL,"address" text COLLATE "C" NOT NULL,"username" text COLLATE "C" NOT NULL,"platform" text COLLATE "C" NOT NULL,"nonce" text COLLATE "C" NOT NULL,"expires_at" bigint NOT NULL,"used_at" bigint DEFAULT 0,"created_at" bigint NOT NULL,"updated_at" bigint DEFAULT 0)`,
+		`CREATE UNIQUE INDEX "idx_social_account_challenges_nonce" ON "social_account_challenges"("nonce")`,
+		`CREATE INDEX "idx_social_account_challenges_user_id" ON "social_account_challenges"("user_id")`,
+		`ALTER TABLE "social_accounts" ADD COLUMN "evidence_challenge_id" bigint NOT NULL DEFAULT 0`,
+		`ALTER TABLE "social_accounts" ADD COLUMN "evidence_post_id" text COLLATE "C"`,
+		`ALTER TABLE "social_accounts" ADD COLUMN "evidence_content_hash" text COLLATE "C"`,
+		`ALTER TABLE "social_accounts" ADD COLUMN "evidence_signature" text COLLATE "C"`,
+		`ALTER TABLE "social_accounts" ADD COLUMN "evidence_verified_by" text COLLATE "C"`,
+	)
+}
+
+// createPlatformSettings creates the settings of the social platforms.
+func createPlatformSettings(tx *gorm.DB) error {
+	return gormrepo.Exec(tx,
+		`CREATE TABLE "platform_settings" ("platform" text COLLATE "C","enabled" boolean NOT NULL,"updated_by" text COLLATE "C","updated_at" bigint DEFAULT 0,PRIMARY KEY ("platform"))`,
+	)
+}
+
+// createCreatorReputations creates the reputations of the creators.
+func createCreatorReputations(tx *gorm.DB) error {
+	return gormrepo.Exec(tx,
+		`CREATE TABLE "creator_reputations" ("user_id" bigint PRIMARY KEY,"score" bigint NOT NULL,"issuances" bigint NOT NULL DEFAULT 0,"settled_on_time" bigint NOT NULL DEFAULT 0,"settled_late" bigint NOT NULL DEFAULT 0,"collateral_executions" bigint NOT NULL DEFAULT 0,"cancellations" bigint NOT NULL DEFAULT 0,"total_volume" numeric(78,0) NOT NULL DEFAULT 0,"updated_at" bigint DEFAULT 0,CONSTRAINT "fk_users_reputation" FOREIGN KEY ("user_id") REFERENCES "users"("id") ON DELETE CASCADE)`,
+	)
+}
+
+// createLedgerEntries creates the ledger of the transfers between application wallets.
+func createLedgerEntries(tx *gorm.DB) error {
+	return gormrepo.Exec(tx,
+		`CREATE TABLE "ledger_entries" ("id" bigserial PRIMARY KEY,"debit_account" text COLLATE "C" NOT NULL,"credit_account" text COLLATE "C" NOT NULL,"token" text COLLATE "C" NOT NULL,"amount" numeric(78,0) NOT NULL,"reason" text COLLATE "C" NOT NULL,"issuance_id" bigint DEFAULT 0,"order_id" bigint DEFAULT 0,"input_index" bigint NOT NULL,"created_at" bigint NOT NULL)`,
+		`CREATE INDEX "idx_ledger_entries_issuance_id" ON "ledger_entries"("issuance_id")`,
+		`CREATE INDEX "idx_ledger_entries_credit_account" ON "ledger_entries"("credit_account")`,
+		`CREATE INDEX "idx_ledger_entries_debit_account" ON "ledger_entries"("debit_account")`,
+	)
+}
+
+// createStateLeaves creates the leaves of the state commitment.
+func createStateLeaves(tx *gorm.DB) error {
+	return gormrepo.Exec(tx,
+		`CREATE TABLE "state_leaves" ("key" text COLLATE "C","table" text COLLATE "C" NOT NULL,"hash" text COLLATE "C" NOT NULL,PRIMARY KEY ("key"))`,
+		`CREATE INDEX "idx_state_leaves_table" ON "state_leaves"("table")`,
+	)
+}
+
+// createGenesis creates the tables the genesis file is applied to, besides the users.
+func createGenesis(tx *gorm.DB) error {
+	return gormrepo.Exec(tx,
+		`CREATE TABLE "genesis" ("hash" text COLLATE "C","input_index" bigint NOT NULL,"applied_at" bigint NOT NULL,PRIMARY KEY ("hash"))`,
+		`CREATE TABLE "allowed_tokens" ("token" text COLLATE "C","created_at" bigint NOT NULL,PRIMARY KEY ("token"))`,
+	)
+}
+
+// openIssuancesWithoutDeposit selects the issuances of the SQLite openIssuancesWithoutDeposit.
+const openIssuancesWithoutDeposit = `FROM "issuances" WHERE "state" IN ('ongoing','closed') AND NOT EXISTS (SELECT 1 FROM "ledger_entries" WHERE "reason" = 'collateral_deposit' AND "issuance_id" = "issuances"."id")`
+
+// backfillCollateralDeposits inserts the entries of the SQLite backfill_collateral_deposits
+// migration.
+func backfillCollateralDeposits(tx *gorm.DB) error {
+	var missing int64
+	if err := tx.Raw(`SELECT COUNT(*) ` + openIssuancesWithoutDeposit).Scan(&missing).Error; err != nil {
+		return err
+	}
+	if missing == 0 {
+		return nil
+	}
+	application, err := configs.GetApplicationAddress()
+	if err != nil {
+		return fmt.Errorf("failed to record the collateral deposits of %d issuances: %w", missing, err)
+	}
+	return tx.Exec(`INSERT INTO "ledger_entries" ("debit_account","credit_account","token","amount","reason","issuance_id","order_id","input_index","created_at") SELECT ?,"creator_address","collateral_address","collateral_amount",'collateral_deposit',"id",0,0,"created_at" `+openIssuancesWithoutDeposit+` ORDER BY "id"`,
+		Address(application)).Error
+}
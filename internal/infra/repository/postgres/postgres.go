@@ -0,0 +1,93 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/logger"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository/gormrepo"
+)
+
+// PostgresRepository stores the state of an off-chain replica. Reading the rollup state from
+// PostgreSQL lets other services query and aggregate it in SQL.
+type PostgresRepository struct {
+	*gormrepo.Repository
+}
+
+// dialect sums amounts in SQL, exactly, since they are stored as NUMERIC.
+var dialect = gormrepo.Dialect{
+	Migrations:      migrations,
+	MigrationsTable: migrationsTable,
+	Amounts: func(column string) string {
+		return "CAST(SUM(" + column + ") AS text)"
+	},
+	AfterImport: resetSequence,
+}
+
+// resetSequence moves the sequence of a table past the identifiers of its imported rows, so the
+// rows created next do not reuse them. Tables without a sequence are left untouched.
+func resetSequence(tx *gorm.DB, table string, primaryKeys []string) error {
+	if len(primaryKeys) != 1 {
+		return nil
+	}
+	return tx.Exec(
+		"SELECT setval(seq::regclass, (SELECT MAX(?) FROM ?)) FROM pg_get_serial_sequence(?, ?) AS seq WHERE seq IS NOT NULL",
+		clause.Column{Name: primaryKeys[0]}, clause.Table{Name: table}, table, primaryKeys[0],
+	).Error
+}
+
+// ConnectPostgresRepository opens the database as is, without migrating it. conn is a
+// postgres:// or postgresql:// connection URL.
+func ConnectPostgresRepository(ctx context.Context, conn string) (*PostgresRepository, error) {
+	newLogger := logger.New(
+		log.New(os.Stdout, "\r\n", log.LstdFlags),
+		logger.Config{
+			SlowThreshold:             time.Second,
+			LogLevel:                  logger.Info,
+			IgnoreRecordNotFoundError: true,
+			Colorful:                  true,
+		},
+	)
+
+	db, err := gorm.Open(postgres.Open(conn), &gorm.Config{
+		Logger: newLogger,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+
+	db = db.WithContext(ctx)
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sql.DB: %w", err)
+	}
+	if err := sqlDB.PingContext(ctx); err != nil {
+		return nil, fmt.Errorf("failed to ping PostgreSQL: %w", err)
+	}
+
+	repo, err := gormrepo.New(db, dialect)
+	if err != nil {
+		return nil, err
+	}
+	return &PostgresRepository{Repository: repo}, nil
+}
+
+// OpenPostgresRepository opens the database and applies the pending migrations.
+func OpenPostgresRepository(ctx context.Context, conn string) (*PostgresRepository, error) {
+	repo, err := ConnectPostgresRepository(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := repo.MigrateUp(); err != nil {
+		return nil, err
+	}
+	return repo, nil
+}
@@ -12,6 +12,7 @@ type IssuanceRepository interface {
 	FindIssuancesByInvestorAddress(investor Address) ([]*entity.Issuance, error)
 	FindIssuanceById(id uint) (*entity.Issuance, error)
 	FindAllIssuances() ([]*entity.Issuance, error)
+	FindCollateralAmountsByToken(states ...entity.IssuanceState) ([]*entity.TokenAmounts, error)
 	UpdateIssuance(Issuance *entity.Issuance) (*entity.Issuance, error)
 }
 
@@ -22,6 +23,7 @@ type OrderRepository interface {
 	FindOrdersByState(issuanceId uint, state string) ([]*entity.Order, error)
 	FindOrdersByInvestorAddress(investor Address) ([]*entity.Order, error)
 	FindAllOrders() ([]*entity.Order, error)
+	FindOrderAmountsByToken(states ...entity.OrderState) ([]*entity.TokenAmounts, error)
 	UpdateOrder(order *entity.Order) (*entity.Order, error)
 	DeleteOrder(id uint) error
 }
@@ -31,6 +33,11 @@ type SocialAccountRepository interface {
 	FindSocialAccountById(id uint) (*entity.SocialAccount, error)
 	FindSocialAccountsByUserId(userID uint) ([]*entity.SocialAccount, error)
 	DeleteSocialAccount(id uint) error
+	CreateSocialAccountChallenge(challenge *entity.SocialAccountChallenge) (*entity.SocialAccountChallenge, error)
+	FindSocialAccountChallengeById(id uint) (*entity.SocialAccountChallenge, error)
+	UpdateSocialAccountChallenge(challenge *entity.SocialAccountChallenge) (*entity.SocialAccountChallenge, error)
+	FindAllPlatformSettings() ([]*entity.PlatformSetting, error)
+	SavePlatformSetting(setting *entity.PlatformSetting) (*entity.PlatformSetting, error)
 }
 
 type UserRepository interface {
@@ -38,7 +45,97 @@ type UserRepository interface {
 	FindUsersByRole(role string) ([]*entity.User, error)
 	FindUserByAddress(address Address) (*entity.User, error)
 	FindAllUsers() ([]*entity.User, error)
-	DeleteUser(address Address) error
+	UpdateUser(user *entity.User) (*entity.User, error)
+	CreateUserRole(role *entity.UserRoleAssignment) error
+	DeleteUserRole(userId uint, role entity.UserRole) error
+	RotateUserAddress(userId uint, oldAddress Address, newAddress Address, rotatedAt int64) error
+}
+
+type AddressRotationRepository interface {
+	CreateAddressRotation(rotation *entity.AddressRotation) (*entity.AddressRotation, error)
+	FindAddressRotationById(id uint) (*entity.AddressRotation, error)
+	FindPendingAddressRotationByUserId(userId uint) (*entity.AddressRotation, error)
+	UpdateAddressRotation(rotation *entity.AddressRotation) (*entity.AddressRotation, error)
+}
+
+type AttestationRepository interface {
+	CreateAttestation(attestation *entity.Attestation) (*entity.Attestation, error)
+	FindAttestationById(id uint) (*entity.Attestation, error)
+	FindAttestationsBySubjectId(subjectId uint) ([]*entity.Attestation, error)
+	UpdateAttestation(attestation *entity.Attestation) (*entity.Attestation, error)
+}
+
+type ReputationRepository interface {
+	SaveCreatorReputation(reputation *entity.CreatorReputation) (*entity.CreatorReputation, error)
+}
+
+type AnalyticsRepository interface {
+	FindRaisedAmountsByToken() ([]*entity.TokenAmounts, error)
+	FindAverageClearingRate() (float64, error)
+	CountIssuancesByState() (map[entity.IssuanceState]uint64, error)
+	CountInvestorsByOrderState(states ...entity.OrderState) (uint64, error)
+	FindActivitySeries(interval int64, from int64, to int64) ([]*entity.ActivityBucket, error)
+}
+
+type LedgerRepository interface {
+	CreateLedgerEntry(entry *entity.LedgerEntry) (*entity.LedgerEntry, error)
+	FindAllLedgerEntries() ([]*entity.LedgerEntry, error)
+	FindLedgerEntriesByIssuanceId(issuanceId uint) ([]*entity.LedgerEntry, error)
+	FindLedgerEntriesByAccount(account Address) ([]*entity.LedgerEntry, error)
+}
+
+type ProposalRepository interface {
+	CreateProposal(proposal *entity.Proposal) (*entity.Proposal, error)
+	FindProposalById(id uint) (*entity.Proposal, error)
+	FindAllProposals() ([]*entity.Proposal, error)
+	CreateProposalApproval(approval *entity.ProposalApproval) error
+	UpdateProposal(proposal *entity.Proposal) (*entity.Proposal, error)
+}
+
+type EmergencyWithdrawalRepository interface {
+	CreateEmergencyWithdrawal(withdrawal *entity.EmergencyWithdrawal) (*entity.EmergencyWithdrawal, error)
+	FindEmergencyWithdrawalById(id uint) (*entity.EmergencyWithdrawal, error)
+	FindAllEmergencyWithdrawals() ([]*entity.EmergencyWithdrawal, error)
+	UpdateEmergencyWithdrawal(withdrawal *entity.EmergencyWithdrawal) (*entity.EmergencyWithdrawal, error)
+}
+
+// GenesisRepository records the genesis applied to the database and the tokens it allows.
+type GenesisRepository interface {
+	CreateGenesis(genesis *entity.Genesis) (*entity.Genesis, error)
+	FindGenesis() (*entity.Genesis, error)
+	CreateAllowedToken(token *entity.AllowedToken) (*entity.AllowedToken, error)
+	FindAllAllowedTokens() ([]*entity.AllowedToken, error)
+}
+
+// TransactionRepository runs the repository calls made between beginning a transaction and
+// committing or rolling it back atomically.
+type TransactionRepository interface {
+	BeginTransaction() error
+	CommitTransaction() error
+	RollbackTransaction() error
+}
+
+// SnapshotRepository reads and writes the raw rows of every table, so the whole state can be
+// exported and restored.
+type SnapshotRepository interface {
+	ExportTables() (map[string][]entity.SnapshotRow, error)
+	ImportTables(tables map[string][]entity.SnapshotRow) error
+}
+
+// StateRepository tracks the tables and issuances written to and stores the leaves of the
+// state commitment.
+type StateRepository interface {
+	TakeChangedStateRows() (map[string][]*entity.StateRow, error)
+	FindTouchedIssuanceIds() []uint
+	FindStateRow(key string) (*entity.StateRow, error)
+	ReplaceStateLeaves(table string, leaves []*entity.StateLeaf) error
+	FindAllStateLeaves() ([]*entity.StateLeaf, error)
+}
+
+// MigrationRepository applies the schema migrations of the database.
+type MigrationRepository interface {
+	MigrationStatus() ([]*entity.Migration, error)
+	MigrateUp() ([]*entity.Migration, error)
 }
 
 type Repository interface {
@@ -46,5 +143,17 @@ type Repository interface {
 	OrderRepository
 	SocialAccountRepository
 	UserRepository
+	AddressRotationRepository
+	AttestationRepository
+	ReputationRepository
+	AnalyticsRepository
+	LedgerRepository
+	ProposalRepository
+	EmergencyWithdrawalRepository
+	GenesisRepository
+	TransactionRepository
+	SnapshotRepository
+	StateRepository
+	MigrationRepository
 	Close() error
 }
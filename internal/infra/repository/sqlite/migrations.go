@@ -0,0 +1,176 @@
+package sqlite
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/configs"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository/gormrepo"
+	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+)
+
+// migrations are the SQLite schema. Every migration has a counterpart of the same version in
+// the postgres package.
+var migrations = []gormrepo.Migration{
+	{Version: 1, Name: "create_schema", Up: createSchema},
+	{Version: 2, Name: "create_user_roles", Up: createUserRoles},
+	{Version: 3, Name: "create_proposals", Up: createProposals},
+	{Version: 4, Name: "create_emergency_withdrawals", Up: createEmergencyWithdrawals},
+	{Version: 5, Name: "add_user_status", Up: addUserStatus},
+	{Version: 6, Name: "create_address_rotations", Up: createAddressRotations},
+	{Version: 7, Name: "create_attestations", Up: createAttestations},
+	{Version: 8, Name: "create_social_account_challenges", Up: createSocialAccountChallenges},
+	{Version: 9, Name: "create_platform_settings", Up: createPlatformSettings},
+	{Version: 10, Name: "create_creator_reputations", Up: createCreatorReputations},
+	{Version: 11, Name: "create_ledger_entries", Up: createLedgerEntries},
+	{Version: 12, Name: "create_state_leaves", Up: createStateLeaves},
+	{Version: 13, Name: "create_genesis", Up: createGenesis},
+	{Version: 14, Name: "backfill_collateral_deposits", Up: backfillCollateralDeposits},
+}
+
+const migrationsTable = "CREATE TABLE IF NOT EXISTS `schema_migrations` (`version` integer PRIMARY KEY,`name` text NOT NULL,`applied_at` integer NOT NULL)"
+
+// createSchema creates the schema AutoMigrate created before migrations existed, so a database
+// created by AutoMigrate is left as it is and upgraded by the migrations that follow.
+func createSchema(tx *gorm.DB) error {
+	return gormrepo.Exec(tx,
+		"CREATE TABLE IF NOT EXISTS `issuances` (`id` integer PRIMARY KEY AUTOINCREMENT,`title` text NOT NULL,`description` text NOT NULL,`promotion` text NOT NULL,`token` text NOT NULL,`creator_address` text NOT NULL,`collateral_address` text NOT NULL,`collateral_amount` text NOT NULL,`badge_address` text NOT NULL,`debt_issued` text NOT NULL,`max_interest_rate` text NOT NULL,`total_obligation` text NOT NULL DEFAULT \"0\",`total_raised` text NOT NULL DEFAULT \"0\",`state` text NOT NULL,`closes_at` integer NOT NULL,`maturity_at` integer NOT NULL,`created_at` integer NOT NULL,`updated_at` integer DEFAULT 0)",
+		"CREATE TABLE IF NOT EXISTS `orders` (`id` integer PRIMARY KEY AUTOINCREMENT,`issuance_id` integer NOT NULL,`investor_address` text NOT NULL,`amount` text NOT NULL,`interest_rate` text NOT NULL,`state` text NOT NULL,`created_at` integer NOT NULL,`updated_at` integer DEFAULT 0,CONSTRAINT `fk_issuances_orders` FOREIGN KEY (`issuance_id`) REFERENCES `issuances`(`id`) ON DELETE CASCADE)",
+		"CREATE INDEX IF NOT EXISTS `idx_orders_issuance_id` ON `orders`(`issuance_id`)",
+		"CREATE TABLE IF NOT EXISTS `users` (`id` integer PRIMARY KEY AUTOINCREMENT,`role` text NOT NULL,`address` text NOT NULL,`created_at` integer NOT NULL,`updated_at` integer DEFAULT 0)",
+		"CREATE UNIQUE INDEX IF NOT EXISTS `idx_users_address` ON `users`(`address`)",
+		"CREATE TABLE IF NOT EXISTS `social_accounts` (`id` integer PRIMARY KEY AUTOINCREMENT,`user_id` integer NOT NULL,`username` text NOT NULL,`platform` text NOT NULL,`created_at` integer NOT NULL,`updated_at` integer DEFAULT 0,CONSTRAINT `fk_users_social_accounts` FOREIGN KEY (`user_id`) REFERENCES `users`(`id`) ON DELETE CASCADE)",
+		"CREATE UNIQUE INDEX IF NOT EXISTS `idx_username_platform` ON `social_accounts`(`username`,`platform`)",
+	)
+}
+
+// createUserRoles replaces the single role of the users with a set of roles, starting with the
+// role each user had, dated by the creation of the user.
+func createUserRoles(tx *gorm.DB) error {
+	return gormrepo.Exec(tx,
+		"CREATE TABLE `user_roles` (`user_id` integer,`role` text,`created_at` integer NOT NULL,PRIMARY KEY (`user_id`,`role`),CONSTRAINT `fk_users_roles` FOREIGN KEY (`user_id`) REFERENCES `users`(`id`) ON DELETE CASCADE)",
+		"INSERT INTO `user_roles` (`user_id`,`role`,`created_at`) SELECT `id`,`role`,`created_at` FROM `users`",
+		"ALTER TABLE `users` DROP COLUMN `role`",
+	)
+}
+
+// createProposals creates the proposals of the governed routes and their approvals.
+func createProposals(tx *gorm.DB) error {
+	return gormrepo.Exec(tx,
+		"CREATE TABLE `proposals` (`id` integer PRIMARY KEY AUTOINCREMENT,`path` text NOT NULL,`payload` text,`proposer` text NOT NULL,`threshold` integer NOT NULL,`state` text NOT NULL,`expires_at` integer NOT NULL,`created_at` integer NOT NULL,`updated_at` integer DEFAULT 0)",
+		"CREATE TABLE `proposal_approvals` (`proposal_id` integer,`approver` text,`created_at` integer NOT NULL,PRIMARY KEY (`proposal_id`,`approver`),CONSTRAINT `fk_proposals_approvals` FOREIGN KEY (`proposal_id`) REFERENCES `proposals`(`id`) ON DELETE CASCADE)",
+	)
+}
+
+// createEmergencyWithdrawals creates the queue of emergency withdrawals.
+func createEmergencyWithdrawals(tx *gorm.DB) error {
+	return gormrepo.Exec(tx,
+		"CREATE TABLE `emergency_withdrawals` (`id` integer PRIMARY KEY AUTOINCREMENT,`kind` text NOT NULL,`token` text,`to` text NOT NULL,`state` text NOT NULL,`requested_by` text NOT NULL,`canceled_by` text,`executed_by` text,`executable_at` integer NOT NULL,`canceled_at` integer DEFAULT 0,`executed_at` integer DEFAULT 0,`created_at` integer NOT NULL,`updated_at` integer DEFAULT 0)",
+	)
+}
+
+// addUserStatus lets users be deactivated instead of deleted. Existing users are active.
+func addUserStatus(tx *gorm.DB) error {
+	return gormrepo.Exec(tx,
+		"ALTER TABLE `users` ADD COLUMN `status` text NOT NULL DEFAULT \"active\"",
+		"ALTER TABLE `users` ADD COLUMN `deactivated_at` integer DEFAULT 0",
+	)
+}
+
+// createAddressRotations creates the rotations of user addresses.
+func createAddressRotations(tx *gorm.DB) error {
+	return gormrepo.Exec(tx,
+		"CREATE TABLE `address_rotations` (`id` integer PRIMARY KEY AUTOINCREMENT,`user_id` integer NOT NULL,`old_address` text NOT NULL,`new_address` text NOT NULL,`state` text NOT NULL,`requires_approval` numeric NOT NULL,`approved_by` text,`accepted_at` integer DEFAULT 0,`completed_at` integer DEFAULT 0,`canceled_at` integer DEFAULT 0,`created_at` integer NOT NULL,`updated_at` integer DEFAULT 0)",
+		"CREATE INDEX `idx_address_rotations_user_id` ON `address_rotations`(`user_id`)",
+	)
+}
+
+// createAttestations creates the attestations and the attestations issuances require.
+func createAttestations(tx *gorm.DB) error {
+	return gormrepo.Exec(tx,
+		"CREATE TABLE `attestations` (`id` integer PRIMARY KEY AUTOINCREMENT,`subject_id` integer NOT NULL,`type` text NOT NULL,`jurisdiction` text,`issuer` text NOT NULL,`evidence_hash` text NOT NULL,`expires_at` integer NOT NULL,`revoked_by` text,`revoked_at` integer DEFAULT 0,`created_at` integer NOT NULL,`updated_at` integer DEFAULT 0)",
+		"CREATE INDEX `idx_attestations_subject_id` ON `attestations`(`subject_id`)",
+		"ALTER TABLE `issuances` ADD COLUMN `required_creator_attestations` text",
+		"ALTER TABLE `issuances` ADD COLUMN `required_investor_attestations` text",
+	)
+}
+
+// createSocialAccountChallenges creates the wallet challenges social accounts are verified with,
+// and the evidence of the verification. Accounts verified before have no evidence.
+func createSocialAccountChallenges(tx *gorm.DB) error {
+	return gormrepo.Exec(tx,
+		"CREATE TABLE `social_account_challenges` (`id` integer PRIMARY KEY AUTOINCREMENT,`user_id` integer NOT NULL,`address` text NOT NULL,`username` text NOT NULL,`platform` text NOT NULL,`nonce` text NOT NULL,`expires_at` integer NOT NULL,`used_at` integer DEFAULT 0,`created_at` integer NOT NULL,`updated_at` integer DEFAULT 0)",
+		"CREATE UNIQUE INDEX `idx_social_account_challenges_nonce` ON `social_account_challenges`(`nonce`)",
+		"CREATE INDEX `idx_social_account_challenges_user_id` ON `social_account_challenges`(`user_id`)",
+		"ALTER TABLE `social_accounts` ADD COLUMN `evidence_challenge_id` integer NOT NULL DEFAULT 0",
+		"ALTER TABLE `social_accounts` ADD COLUMN `evidence_post_id` text",
+		"ALTER TABLE `social_accounts` ADD COLUMN `evidence_content_hash` text",
+		"ALTER TABLE `social_accounts` ADD COLUMN `evidence_signature` text",
+		"ALTER TABLE `social_accounts` ADD COLUMN `evidence_verified_by` text",
+	)
+}
+
+// createPlatformSettings creates the settings of the social platforms.
+func createPlatformSettings(tx *gorm.DB) error {
+	return gormrepo.Exec(tx,
+		"CREATE TABLE `platform_settings` (`platform` text,`enabled` numeric NOT NULL,`updated_by` text,`updated_at` integer DEFAULT 0,PRIMARY KEY (`platform`))",
+	)
+}
+
+// createCreatorReputations creates the reputations of the creators.
+func createCreatorReputations(tx *gorm.DB) error {
+	return gormrepo.Exec(tx,
+		"CREATE TABLE `creator_reputations` (`user_id` integer PRIMARY KEY AUTOINCREMENT,`score` integer NOT NULL,`issuances` integer NOT NULL DEFAULT 0,`settled_on_time` integer NOT NULL DEFAULT 0,`settled_late` integer NOT NULL DEFAULT 0,`collateral_executions` integer NOT NULL DEFAULT 0,`cancellations` integer NOT NULL DEFAULT 0,`total_volume` text NOT NULL DEFAULT \"0\",`updated_at` integer DEFAULT 0,CONSTRAINT `fk_users_reputation` FOREIGN KEY (`user_id`) REFERENCES `users`(`id`) ON DELETE CASCADE)",
+	)
+}
+
+// createLedgerEntries creates the ledger of the transfers between application wallets.
+func createLedgerEntries(tx *gorm.DB) error {
+	return gormrepo.Exec(tx,
+		"CREATE TABLE `ledger_entries` (`id` integer PRIMARY KEY AUTOINCREMENT,`debit_account` text NOT NULL,`credit_account` text NOT NULL,`token` text NOT NULL,`amount` text NOT NULL,`reason` text NOT NULL,`issuance_id` integer DEFAULT 0,`order_id` integer DEFAULT 0,`input_index` integer NOT NULL,`created_at` integer NOT NULL)",
+		"CREATE INDEX `idx_ledger_entries_issuance_id` ON `ledger_entries`(`issuance_id`)",
+		"CREATE INDEX `idx_ledger_entries_credit_account` ON `ledger_entries`(`credit_account`)",
+		"CREATE INDEX `idx_ledger_entries_debit_account` ON `ledger_entries`(`debit_account`)",
+	)
+}
+
+// createStateLeaves creates the leaves of the state commitment.
+func createStateLeaves(tx *gorm.DB) error {
+	return gormrepo.Exec(tx,
+		"CREATE TABLE `state_leaves` (`key` text,`table` text NOT NULL,`hash` text NOT NULL,PRIMARY KEY (`key`))",
+		"CREATE INDEX `idx_state_leaves_table` ON `state_leaves`(`table`)",
+	)
+}
+
+// createGenesis creates the tables the genesis file is applied to, besides the users.
+func createGenesis(tx *gorm.DB) error {
+	return gormrepo.Exec(tx,
+		"CREATE TABLE `genesis` (`hash` text,`input_index` integer NOT NULL,`applied_at` integer NOT NULL,PRIMARY KEY (`hash`))",
+		"CREATE TABLE `allowed_tokens` (`token` text,`created_at` integer NOT NULL,PRIMARY KEY (`token`))",
+	)
+}
+
+// openIssuancesWithoutDeposit are the issuances holding collateral that have no collateral
+// deposit in the ledger, because they were created before it.
+const openIssuancesWithoutDeposit = "FROM `issuances` WHERE `state` IN ('ongoing','closed') AND NOT EXISTS (SELECT 1 FROM `ledger_entries` WHERE `reason` = 'collateral_deposit' AND `issuance_id` = `issuances`.`id`)"
+
+// backfillCollateralDeposits records the collateral deposit of the open issuances created before
+// the ledger, so the collateral they hold is accounted for. The deposits are received by the
+// application, whose address is only known from APPLICATION_ADDRESS. Issuances that gave their
+// collateral back or away are left out, as the ledger has no record of that either.
+func backfillCollateralDeposits(tx *gorm.DB) error {
+	var missing int64
+	if err := tx.Raw("SELECT COUNT(*) " + openIssuancesWithoutDeposit).Scan(&missing).Error; err != nil {
+		return err
+	}
+	if missing == 0 {
+		return nil
+	}
+	application, err := configs.GetApplicationAddress()
+	if err != nil {
+		return fmt.Errorf("failed to record the collateral deposits of %d issuances: %w", missing, err)
+	}
+	return tx.Exec("INSERT INTO `ledger_entries` (`debit_account`,`credit_account`,`token`,`amount`,`reason`,`issuance_id`,`order_id`,`input_index`,`created_at`) SELECT ?,`creator_address`,`collateral_address`,`collateral_amount`,'collateral_deposit',`id`,0,0,`created_at` "+openIssuancesWithoutDeposit+" ORDER BY `id`",
+		Address(application)).Error
+}
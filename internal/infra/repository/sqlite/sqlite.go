@@ -12,26 +12,26 @@ import (
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 
-	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/configs"
-	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
-	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository/gormrepo"
 )
 
 type SQLiteRepository struct {
-	Db *gorm.DB
+	*gormrepo.Repository
 }
 
-func (r *SQLiteRepository) Close() error {
-	sqlDB, err := r.Db.DB()
-	if err != nil {
-		return fmt.Errorf("failed to get database instance: %w", err)
-	}
-	return sqlDB.Close()
+// dialect stores amounts as decimal text, which SQLite can only sum as 64-bit integers or
+// floats, so they are concatenated per group and summed exactly by the repository.
+var dialect = gormrepo.Dialect{
+	Migrations:      migrations,
+	MigrationsTable: migrationsTable,
+	Amounts: func(column string) string {
+		return "GROUP_CONCAT(" + column + ")"
+	},
 }
 
-func NewSQLiteRepository(ctx context.Context, conn string) (*SQLiteRepository, error) {
+// ConnectSQLiteRepository opens the database as is, without migrating it.
+func ConnectSQLiteRepository(ctx context.Context, conn string) (*SQLiteRepository, error) {
 	dbPath := strings.TrimPrefix(conn, "sqlite://")
-	isMemory := dbPath == ":memory:"
 
 	newLogger := logger.New(
 		log.New(os.Stdout, "\r\n", log.LstdFlags),
@@ -62,72 +62,22 @@ func NewSQLiteRepository(ctx context.Context, conn string) (*SQLiteRepository, e
 		return nil, fmt.Errorf("failed to ping SQLite: %w", err)
 	}
 
-	if err := db.AutoMigrate(
-		&entity.Issuance{},
-		&entity.Order{},
-		&entity.User{},
-		&entity.SocialAccount{},
-	); err != nil {
-		return nil, fmt.Errorf("failed to auto-migrate tables: %w", err)
-	}
-
-	configs.SetDefaults()
-
-	var adminAddr, verifierAddr Address
-
-	if isMemory {
-		a, err := configs.GetAdminAddressTest()
-		if err != nil && err != configs.ErrNotDefined {
-			return nil, fmt.Errorf("failed to get rollup_ADMIN_ADDRESS_TEST: %w", err)
-		} else if err == configs.ErrNotDefined {
-			return nil, fmt.Errorf("rollup_ADMIN_ADDRESS_TEST is required for the rollup service: %w", err)
-		}
-		adminAddr = HexToAddress(a.Hex())
-
-		v, err := configs.GetVerifierAddressTest()
-		if err != nil && err != configs.ErrNotDefined {
-			return nil, fmt.Errorf("failed to get rollup_VERIFIER_ADDRESS_TEST: %w", err)
-		} else if err == configs.ErrNotDefined {
-			return nil, fmt.Errorf("rollup_VERIFIER_ADDRESS_TEST is required for the rollup service: %w", err)
-		}
-		verifierAddr = HexToAddress(v.Hex())
-	} else {
-		a, err := configs.GetAdminAddress()
-		if err != nil && err != configs.ErrNotDefined {
-			return nil, fmt.Errorf("failed to get rollup_ADMIN_ADDRESS: %w", err)
-		} else if err == configs.ErrNotDefined {
-			return nil, fmt.Errorf("rollup_ADMIN_ADDRESS is required for the rollup service: %w", err)
-		}
-		adminAddr = HexToAddress(a.Hex())
-
-		v, err := configs.GetVerifierAddress()
-		if err != nil && err != configs.ErrNotDefined {
-			return nil, fmt.Errorf("failed to get rollup_VERIFIER_ADDRESS: %w", err)
-		} else if err == configs.ErrNotDefined {
-			return nil, fmt.Errorf("rollup_VERIFIER_ADDRESS is required for the rollup service: %w", err)
-		}
-		verifierAddr = HexToAddress(v.Hex())
+	repo, err := gormrepo.New(db, dialect)
+	if err != nil {
+		return nil, err
 	}
+	return &SQLiteRepository{Repository: repo}, nil
+}
 
-	baseTime := time.Now().Unix()
-	users := []entity.User{
-		{
-			Role:      entity.UserRoleAdmin,
-			Address:   adminAddr,
-			CreatedAt: baseTime,
-		},
-		{
-			Role:      entity.UserRoleVerifier,
-			Address:   verifierAddr,
-			CreatedAt: baseTime,
-		},
+// OpenSQLiteRepository opens the database and applies the pending migrations. The platform
+// users come from the genesis file, applied by the first advance input.
+func OpenSQLiteRepository(ctx context.Context, conn string) (*SQLiteRepository, error) {
+	repo, err := ConnectSQLiteRepository(ctx, conn)
+	if err != nil {
+		return nil, err
 	}
-
-	for _, user := range users {
-		if err := db.WithContext(ctx).Create(&user).Error; err != nil {
-			return nil, fmt.Errorf("failed to create user %v: %w", user.Role, err)
-		}
+	if _, err := repo.MigrateUp(); err != nil {
+		return nil, err
 	}
-
-	return &SQLiteRepository{Db: db}, nil
+	return repo, nil
 }
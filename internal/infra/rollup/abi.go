@@ -0,0 +1,52 @@
+package rollup
+
+import (
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/attestation"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/emergency_withdrawal"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/issuance"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/order"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/proposal"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/social_account"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/user"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/router"
+)
+
+// ABIRoute is an advance route that also accepts ABI-encoded inputs.
+type ABIRoute struct {
+	Path  string
+	Input router.ABIInput
+	// Deposit is set when the input must be sent as the exec layer data of an ERC20 deposit.
+	Deposit bool
+}
+
+// ABIRoutes is the source of both the router ABI codecs and the generated Solidity helpers.
+var ABIRoutes = []ABIRoute{
+	{Path: "order/create", Input: order.CreateOrderInputDTO{}, Deposit: true},
+	{Path: "order/cancel", Input: order.CancelOrderInputDTO{}},
+	{Path: "issuance/creator/create", Input: issuance.CreateIssuanceInputDTO{}, Deposit: true},
+	{Path: "issuance/creator/settle", Input: issuance.SettleIssuanceInputDTO{}, Deposit: true},
+	{Path: "issuance/close", Input: issuance.CloseIssuanceInputDTO{}},
+	{Path: "issuance/execute-collateral", Input: issuance.ExecuteIssuanceCollateralInputDTO{}},
+	{Path: "user/admin/create", Input: user.CreateUserInputDTO{}},
+	{Path: "user/admin/delete", Input: user.DeleteUserInputDTO{}},
+	{Path: "user/admin/reactivate", Input: user.ReactivateUserInputDTO{}},
+	{Path: "user/admin/update-role", Input: user.UpdateUserRoleInputDTO{}},
+	{Path: "user/admin/approve-rotation", Input: user.ApproveAddressRotationInputDTO{}},
+	{Path: "user/rotation/propose", Input: user.ProposeAddressRotationInputDTO{}},
+	{Path: "user/rotation/accept", Input: user.AcceptAddressRotationInputDTO{}},
+	{Path: "user/rotation/cancel", Input: user.CancelAddressRotationInputDTO{}},
+	{Path: "user/admin/grant-role", Input: user.GrantUserRoleInputDTO{}},
+	{Path: "user/admin/revoke-role", Input: user.RevokeUserRoleInputDTO{}},
+	{Path: "user/admin/emergency-erc20-withdraw", Input: user.EmergencyERC20WithdrawInputDTO{}},
+	{Path: "user/admin/emergency-ether-withdraw", Input: user.EmergencyEtherWithdrawInputDTO{}},
+	{Path: "user/withdraw", Input: user.WithdrawInputDTO{}},
+	{Path: "social/challenge", Input: social_account.RequestSocialAccountChallengeInputDTO{}},
+	{Path: "social/verifier/create", Input: social_account.CreateSocialAccountInputDTO{}},
+	{Path: "social/admin/delete", Input: social_account.DeleteSocialAccountInputDTO{}},
+	{Path: "social/admin/platform", Input: social_account.UpdatePlatformSettingInputDTO{}},
+	{Path: "attestation/verifier/issue", Input: attestation.IssueAttestationInputDTO{}},
+	{Path: "attestation/verifier/revoke", Input: attestation.RevokeAttestationInputDTO{}},
+	{Path: "emergency/admin/cancel", Input: emergency_withdrawal.CancelEmergencyWithdrawalInputDTO{}},
+	{Path: "emergency/execute", Input: emergency_withdrawal.ExecuteEmergencyWithdrawalInputDTO{}},
+	{Path: "governance/admin/approve", Input: proposal.ApproveProposalInputDTO{}},
+}
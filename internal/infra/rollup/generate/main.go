@@ -0,0 +1,19 @@
+//go:generate go run .
+
+// This script will read the ABI routes of the rollup and create, for each route group,
+// a Solidity library in contracts/src/library with:
+// - the 4-byte selector of every route;
+// - an encode function returning the ABI-encoded input;
+// - a send function adding the input through the InputBox, or the ERC20 portal for deposit routes.
+package main
+
+import (
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/rollup"
+)
+
+func main() {
+	libraries := groupRoutes(rollup.ABIRoutes)
+	for _, library := range libraries {
+		generateLibraryFile("../../../../contracts/src/library", library)
+	}
+}
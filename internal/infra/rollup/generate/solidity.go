@@ -0,0 +1,182 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/rollup"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/router"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+)
+
+type Param struct {
+	Name string
+	Type string
+}
+
+type Function struct {
+	Path      string
+	Name      string
+	Signature string
+	Selector  string
+	Deposit   bool
+	Params    []Param
+}
+
+type Library struct {
+	Name      string
+	Group     string
+	Functions []Function
+}
+
+// solidityKeywords are the DTO field names that can't be used as Solidity parameter names.
+var solidityKeywords = map[string]bool{
+	"address": true,
+	"bool":    true,
+	"bytes":   true,
+	"int":     true,
+	"string":  true,
+	"uint":    true,
+}
+
+func toCamelCase(name string) string {
+	caser := cases.Title(language.English)
+	words := strings.Split(name, "_")
+	for i := 1; i < len(words); i++ {
+		words[i] = caser.String(words[i])
+	}
+	return strings.Join(words, "")
+}
+
+func toConstName(method string) string {
+	var b strings.Builder
+	for i, r := range method {
+		if i > 0 && r >= 'A' && r <= 'Z' && !(method[i-1] >= 'A' && method[i-1] <= 'Z') {
+			b.WriteByte('_')
+		}
+		b.WriteRune(r)
+	}
+	return strings.ToUpper(b.String())
+}
+
+func groupRoutes(routes []rollup.ABIRoute) []*Library {
+	caser := cases.Title(language.English)
+	var libraries []*Library
+	byGroup := make(map[string]*Library)
+	for _, route := range routes {
+		codec, err := router.NewABICodec(route.Path, route.Input)
+		if err != nil {
+			log.Fatalf("failed to build abi codec for %s: %v", route.Path, err)
+		}
+		group, _, _ := strings.Cut(codec.Path, "/")
+		library, exists := byGroup[group]
+		if !exists {
+			library = &Library{Name: caser.String(group) + "Inputs", Group: group}
+			byGroup[group] = library
+			libraries = append(libraries, library)
+		}
+		function := Function{
+			Path:      codec.Path,
+			Name:      codec.Method,
+			Signature: codec.Signature,
+			Selector:  fmt.Sprintf("0x%x", codec.Selector),
+			Deposit:   route.Deposit,
+		}
+		for _, field := range codec.Fields {
+			name := toCamelCase(field.Name)
+			if solidityKeywords[name] {
+				name += "_"
+			}
+			function.Params = append(function.Params, Param{Name: name, Type: field.Type})
+		}
+		library.Functions = append(library.Functions, function)
+	}
+	return libraries
+}
+
+var funcMap = template.FuncMap{
+	"toConstName": toConstName,
+	"toTitle": func(s string) string {
+		return strings.ToUpper(s[:1]) + s[1:]
+	},
+	"declare": func(params []Param) string {
+		declarations := make([]string, len(params))
+		for i, param := range params {
+			if param.Type == "string" || strings.HasSuffix(param.Type, "[]") {
+				declarations[i] = param.Type + " memory " + param.Name
+			} else {
+				declarations[i] = param.Type + " " + param.Name
+			}
+		}
+		return strings.Join(declarations, ", ")
+	},
+	"names": func(params []Param) string {
+		names := make([]string, len(params))
+		for i, param := range params {
+			names[i] = param.Name
+		}
+		return strings.Join(names, ", ")
+	},
+	"prefix": func(prefix string, params []Param) string {
+		if len(params) == 0 {
+			return prefix
+		}
+		return prefix + ", "
+	},
+}
+
+const libraryTemplate = `// SPDX-License-Identifier: MIT
+// Code generated by internal/infra/rollup/generate. DO NOT EDIT.
+
+pragma solidity ^0.8.27;
+
+import {IERC20} from "openzeppelin-contracts/token/ERC20/IERC20.sol";
+import {IInputBox} from "cartesi-rollups-contracts-2.0.0/src/inputs/IInputBox.sol";
+import {IERC20Portal} from "cartesi-rollups-contracts-2.0.0/src/portals/IERC20Portal.sol";
+
+/// @title {{.Name}}
+/// @notice ABI-encoded inputs of the {{.Group}} routes of the application.
+/// @dev Every input is a 4-byte selector followed by the ABI-encoded fields of the route DTO.
+library {{.Name}} {
+{{- range .Functions}}
+    /// @dev Selector of {{.Signature}}, routed to {{.Path}}.
+    bytes4 internal constant {{toConstName .Name}} = {{.Selector}};
+{{- end}}
+{{range .Functions}}
+    function encode{{toTitle .Name}}({{declare .Params}}) internal pure returns (bytes memory) {
+        return abi.encodeWithSelector({{toConstName .Name}}{{if .Params}}, {{names .Params}}{{end}});
+    }
+{{if .Deposit}}
+    /// @notice Sends {{.Name}} to appContract, depositing depositAmount of depositToken through the ERC20 portal.
+    function {{.Name}}({{prefix "IERC20Portal portal, address appContract, IERC20 depositToken, uint256 depositAmount" .Params}}{{declare .Params}}) internal {
+        portal.depositERC20Tokens(depositToken, appContract, depositAmount, encode{{toTitle .Name}}({{names .Params}}));
+    }
+{{else}}
+    /// @notice Sends {{.Name}} to appContract through the InputBox.
+    function {{.Name}}({{prefix "IInputBox inputBox, address appContract" .Params}}{{declare .Params}}) internal returns (bytes32) {
+        return inputBox.addInput(appContract, encode{{toTitle .Name}}({{names .Params}}));
+    }
+{{end}}
+{{- end}}}
+`
+
+func generateLibraryFile(dir string, library *Library) {
+	tmpl := template.Must(template.New("library").Funcs(funcMap).Parse(libraryTemplate))
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, library); err != nil {
+		log.Fatalf("failed to execute template for %s: %v", library.Name, err)
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		log.Fatalf("failed to create %s: %v", dir, err)
+	}
+	path := filepath.Join(dir, library.Name+".sol")
+	if err := os.WriteFile(path, buf.Bytes(), 0o644); err != nil {
+		log.Fatalf("failed to write %s: %v", path, err)
+	}
+}
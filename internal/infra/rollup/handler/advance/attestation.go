@@ -0,0 +1,67 @@
+package advance
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/attestation"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/router"
+	"github.com/go-playground/validator/v10"
+	"github.com/rollmelette/rollmelette"
+)
+
+type AttestationAdvanceHandlers struct {
+	UserRepository        repository.UserRepository
+	AttestationRepository repository.AttestationRepository
+}
+
+func NewAttestationAdvanceHandlers(
+	userRepo repository.UserRepository,
+	attestationRepo repository.AttestationRepository,
+) *AttestationAdvanceHandlers {
+	return &AttestationAdvanceHandlers{
+		UserRepository:        userRepo,
+		AttestationRepository: attestationRepo,
+	}
+}
+
+func (h *AttestationAdvanceHandlers) IssueAttestation(env rollmelette.Env, metadata rollmelette.Metadata, deposit rollmelette.Deposit, payload []byte) error {
+	var input attestation.IssueAttestationInputDTO
+	if err := json.Unmarshal(payload, &input); err != nil {
+		return fmt.Errorf("failed to unmarshal input: %w", err)
+	}
+
+	validator := validator.New()
+	if err := validator.Struct(input); err != nil {
+		return fmt.Errorf("failed to validate input: %w", err)
+	}
+
+	issueAttestation := attestation.NewIssueAttestationUseCase(h.UserRepository, h.AttestationRepository)
+	res, err := issueAttestation.Execute(&input, metadata)
+	if err != nil {
+		return fmt.Errorf("failed to issue attestation: %w", err)
+	}
+
+	return router.Notice(env, metadata, "attestation.issued", res)
+}
+
+func (h *AttestationAdvanceHandlers) RevokeAttestation(env rollmelette.Env, metadata rollmelette.Metadata, deposit rollmelette.Deposit, payload []byte) error {
+	var input attestation.RevokeAttestationInputDTO
+	if err := json.Unmarshal(payload, &input); err != nil {
+		return fmt.Errorf("failed to unmarshal input: %w", err)
+	}
+
+	validator := validator.New()
+	if err := validator.Struct(input); err != nil {
+		return fmt.Errorf("failed to validate input: %w", err)
+	}
+
+	revokeAttestation := attestation.NewRevokeAttestationUseCase(h.AttestationRepository)
+	res, err := revokeAttestation.Execute(&input, metadata)
+	if err != nil {
+		return fmt.Errorf("failed to revoke attestation: %w", err)
+	}
+
+	return router.Notice(env, metadata, "attestation.revoked", res)
+}
@@ -6,22 +6,33 @@ import (
 	"strings"
 
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/configs"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/emergency_withdrawal"
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/user"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/router"
 	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/go-playground/validator/v10"
 	"github.com/rollmelette/rollmelette"
 )
 
 type EmergencyAdvanceHandlers struct {
-	Config *configs.RollupConfig
+	Config                        *configs.RollupConfig
+	EmergencyWithdrawalRepository repository.EmergencyWithdrawalRepository
 }
 
-func NewEmergencyAdvanceHandlers(cfg *configs.RollupConfig) *EmergencyAdvanceHandlers {
+func NewEmergencyAdvanceHandlers(
+	cfg *configs.RollupConfig,
+	emergencyWithdrawalRepo repository.EmergencyWithdrawalRepository,
+) *EmergencyAdvanceHandlers {
 	return &EmergencyAdvanceHandlers{
-		Config: cfg,
+		Config:                        cfg,
+		EmergencyWithdrawalRepository: emergencyWithdrawalRepo,
 	}
 }
 
+// EmergencyERC20Withdraw queues the withdrawal of the whole application balance of a token.
 func (h *EmergencyAdvanceHandlers) EmergencyERC20Withdraw(env rollmelette.Env, metadata rollmelette.Metadata, deposit rollmelette.Deposit, payload []byte) error {
 	var input user.EmergencyERC20WithdrawInputDTO
 	if err := json.Unmarshal(payload, &input); err != nil {
@@ -33,37 +44,67 @@ func (h *EmergencyAdvanceHandlers) EmergencyERC20Withdraw(env rollmelette.Env, m
 		return fmt.Errorf("failed to validate input: %w", err)
 	}
 
-	abiJSON := `[{
-		"type":"function",
-		"name":"emergencyERC20Withdraw",
-		"inputs":[
-			{"type":"address"},
-			{"type":"address"}
-		]
-	}]`
-	abiInterface, err := abi.JSON(strings.NewReader(abiJSON))
+	return h.queue(env, metadata, &emergency_withdrawal.QueueEmergencyWithdrawalInputDTO{
+		Kind:  entity.EmergencyWithdrawalKindERC20,
+		Token: input.Token,
+		To:    input.To,
+	})
+}
+
+// EmergencyEtherWithdraw queues the withdrawal of the whole application Ether balance.
+func (h *EmergencyAdvanceHandlers) EmergencyEtherWithdraw(env rollmelette.Env, metadata rollmelette.Metadata, deposit rollmelette.Deposit, payload []byte) error {
+	var input user.EmergencyEtherWithdrawInputDTO
+	if err := json.Unmarshal(payload, &input); err != nil {
+		return fmt.Errorf("failed to unmarshal input: %w", err)
+	}
+
+	validator := validator.New()
+	if err := validator.Struct(input); err != nil {
+		return fmt.Errorf("failed to validate input: %w", err)
+	}
+
+	return h.queue(env, metadata, &emergency_withdrawal.QueueEmergencyWithdrawalInputDTO{
+		Kind: entity.EmergencyWithdrawalKindEther,
+		To:   input.To,
+	})
+}
+
+func (h *EmergencyAdvanceHandlers) queue(env rollmelette.Env, metadata rollmelette.Metadata, input *emergency_withdrawal.QueueEmergencyWithdrawalInputDTO) error {
+	input.Delay = int64(h.Config.EmergencyWithdrawDelay.Seconds())
+
+	queueEmergencyWithdrawal := emergency_withdrawal.NewQueueEmergencyWithdrawalUseCase(h.EmergencyWithdrawalRepository)
+	res, err := queueEmergencyWithdrawal.Execute(input, metadata)
 	if err != nil {
-		return fmt.Errorf("failed to parse ABI: %w", err)
+		return fmt.Errorf("failed to queue emergency withdrawal: %w", err)
 	}
 
-	delegatecallPayload, err := abiInterface.Pack(
-		"emergencyERC20Withdraw",
-		input.Token,
-		input.To,
-	)
+	return router.Notice(env, metadata, "emergency_withdrawal.pending", res)
+}
+
+func (h *EmergencyAdvanceHandlers) CancelEmergencyWithdrawal(env rollmelette.Env, metadata rollmelette.Metadata, deposit rollmelette.Deposit, payload []byte) error {
+	var input emergency_withdrawal.CancelEmergencyWithdrawalInputDTO
+	if err := json.Unmarshal(payload, &input); err != nil {
+		return fmt.Errorf("failed to unmarshal input: %w", err)
+	}
+
+	validator := validator.New()
+	if err := validator.Struct(input); err != nil {
+		return fmt.Errorf("failed to validate input: %w", err)
+	}
+
+	cancelEmergencyWithdrawal := emergency_withdrawal.NewCancelEmergencyWithdrawalUseCase(h.EmergencyWithdrawalRepository)
+	res, err := cancelEmergencyWithdrawal.Execute(&input, metadata)
 	if err != nil {
-		return fmt.Errorf("failed to pack ABI: %w", err)
+		return fmt.Errorf("failed to cancel emergency withdrawal: %w", err)
 	}
 
-	env.DelegateCallVoucher(
-		h.Config.EmergencyWithdrawAddress,
-		delegatecallPayload,
-	)
-	return nil
+	return router.Notice(env, metadata, "emergency_withdrawal.canceled", res)
 }
 
-func (h *EmergencyAdvanceHandlers) EmergencyEtherWithdraw(env rollmelette.Env, metadata rollmelette.Metadata, deposit rollmelette.Deposit, payload []byte) error {
-	var input user.EmergencyEtherWithdrawInputDTO
+// ExecuteEmergencyWithdrawal emits the delegate call voucher of a queued withdrawal whose
+// delay has passed.
+func (h *EmergencyAdvanceHandlers) ExecuteEmergencyWithdrawal(env rollmelette.Env, metadata rollmelette.Metadata, deposit rollmelette.Deposit, payload []byte) error {
+	var input emergency_withdrawal.ExecuteEmergencyWithdrawalInputDTO
 	if err := json.Unmarshal(payload, &input); err != nil {
 		return fmt.Errorf("failed to unmarshal input: %w", err)
 	}
@@ -73,7 +114,33 @@ func (h *EmergencyAdvanceHandlers) EmergencyEtherWithdraw(env rollmelette.Env, m
 		return fmt.Errorf("failed to validate input: %w", err)
 	}
 
+	executeEmergencyWithdrawal := emergency_withdrawal.NewExecuteEmergencyWithdrawalUseCase(h.EmergencyWithdrawalRepository)
+	res, err := executeEmergencyWithdrawal.Execute(&input, metadata)
+	if err != nil {
+		return fmt.Errorf("failed to execute emergency withdrawal: %w", err)
+	}
+
+	delegatecallPayload, err := emergencyWithdrawPayload(res)
+	if err != nil {
+		return err
+	}
+	env.DelegateCallVoucher(
+		h.Config.EmergencyWithdrawAddress,
+		delegatecallPayload,
+	)
+
+	return router.Notice(env, metadata, "emergency_withdrawal.executed", res)
+}
+
+func emergencyWithdrawPayload(withdrawal *emergency_withdrawal.EmergencyWithdrawalOutputDTO) ([]byte, error) {
 	abiJSON := `[{
+		"type":"function",
+		"name":"emergencyERC20Withdraw",
+		"inputs":[
+			{"type":"address"},
+			{"type":"address"}
+		]
+	},{
 		"type":"function",
 		"name":"emergencyETHWithdraw",
 		"inputs":[
@@ -82,20 +149,25 @@ func (h *EmergencyAdvanceHandlers) EmergencyEtherWithdraw(env rollmelette.Env, m
 	}]`
 	abiInterface, err := abi.JSON(strings.NewReader(abiJSON))
 	if err != nil {
-		return fmt.Errorf("failed to parse ABI: %w", err)
+		return nil, fmt.Errorf("failed to parse ABI: %w", err)
 	}
 
-	delegatecallPayload, err := abiInterface.Pack(
-		"emergencyETHWithdraw",
-		input.To,
-	)
+	var delegatecallPayload []byte
+	switch entity.EmergencyWithdrawalKind(withdrawal.Kind) {
+	case entity.EmergencyWithdrawalKindERC20:
+		delegatecallPayload, err = abiInterface.Pack(
+			"emergencyERC20Withdraw",
+			common.Address(withdrawal.Token),
+			common.Address(withdrawal.To),
+		)
+	default:
+		delegatecallPayload, err = abiInterface.Pack(
+			"emergencyETHWithdraw",
+			common.Address(withdrawal.To),
+		)
+	}
 	if err != nil {
-		return fmt.Errorf("failed to pack ABI: %w", err)
+		return nil, fmt.Errorf("failed to pack ABI: %w", err)
 	}
-
-	env.DelegateCallVoucher(
-		h.Config.EmergencyWithdrawAddress,
-		delegatecallPayload,
-	)
-	return nil
+	return delegatecallPayload, nil
 }
@@ -11,7 +11,11 @@ import (
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/issuance"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/ledger"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/reputation"
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/user"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/router"
+	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/go-playground/validator/v10"
@@ -27,10 +31,14 @@ var (
 )
 
 type IssuanceAdvanceHandlers struct {
-	Config             *configs.RollupConfig
-	OrderRepository    repository.OrderRepository
-	UserRepository     repository.UserRepository
-	IssuanceRepository repository.IssuanceRepository
+	Config                *configs.RollupConfig
+	OrderRepository       repository.OrderRepository
+	UserRepository        repository.UserRepository
+	IssuanceRepository    repository.IssuanceRepository
+	AttestationRepository repository.AttestationRepository
+	ReputationRepository  repository.ReputationRepository
+	LedgerRepository      repository.LedgerRepository
+	GenesisRepository     repository.GenesisRepository
 }
 
 func NewIssuanceAdvanceHandlers(
@@ -38,12 +46,20 @@ func NewIssuanceAdvanceHandlers(
 	orderRepo repository.OrderRepository,
 	userRepo repository.UserRepository,
 	issuanceRepo repository.IssuanceRepository,
+	attestationRepo repository.AttestationRepository,
+	reputationRepo repository.ReputationRepository,
+	ledgerRepo repository.LedgerRepository,
+	genesisRepo repository.GenesisRepository,
 ) *IssuanceAdvanceHandlers {
 	return &IssuanceAdvanceHandlers{
-		Config:             cfg,
-		OrderRepository:    orderRepo,
-		UserRepository:     userRepo,
-		IssuanceRepository: issuanceRepo,
+		Config:                cfg,
+		OrderRepository:       orderRepo,
+		UserRepository:        userRepo,
+		IssuanceRepository:    issuanceRepo,
+		AttestationRepository: attestationRepo,
+		ReputationRepository:  reputationRepo,
+		LedgerRepository:      ledgerRepo,
+		GenesisRepository:     genesisRepo,
 	}
 }
 
@@ -58,10 +74,19 @@ func (h *IssuanceAdvanceHandlers) CreateIssuance(env rollmelette.Env, metadata r
 		return fmt.Errorf("failed to validate input: %w", err)
 	}
 
+	reputationGates, err := reputation.ParseGates(h.Config.ReputationGates)
+	if err != nil {
+		return fmt.Errorf("failed to parse reputation gates: %w", err)
+	}
+
 	createIssuance := issuance.NewCreateIssuanceUseCase(
 		h.Config.BadgeFactoryAddress,
 		h.IssuanceRepository,
 		h.UserRepository,
+		h.AttestationRepository,
+		h.ReputationRepository,
+		h.GenesisRepository,
+		reputationGates,
 	)
 
 	res, err := createIssuance.Execute(&input, deposit, metadata)
@@ -97,22 +122,18 @@ func (h *IssuanceAdvanceHandlers) CreateIssuance(env rollmelette.Env, metadata r
 	)
 
 	erc20Deposit := deposit.(*rollmelette.ERC20Deposit)
-	if err := env.ERC20Transfer(
-		erc20Deposit.Token,
-		erc20Deposit.Sender,
-		env.AppAddress(),
-		erc20Deposit.Value,
-	); err != nil {
+	if err := transferERC20(env, metadata, h.LedgerRepository, &ledger.RecordTransferInputDTO{
+		Token:      Address(erc20Deposit.Token),
+		From:       Address(erc20Deposit.Sender),
+		To:         Address(env.AppAddress()),
+		Amount:     uint256.MustFromBig(erc20Deposit.Value),
+		Reason:     entity.LedgerReasonCollateralDeposit,
+		IssuanceId: res.Id,
+	}); err != nil {
 		return fmt.Errorf("failed to transfer ERC20: %w", err)
 	}
 
-	issuance, err := json.Marshal(res)
-	if err != nil {
-		return fmt.Errorf("failed to marshal response: %w", err)
-	}
-
-	env.Notice(append([]byte("issuance created - "), issuance...))
-	return nil
+	return router.Notice(env, metadata, "issuance.created", res)
 }
 
 func (h *IssuanceAdvanceHandlers) CloseIssuance(env rollmelette.Env, metadata rollmelette.Metadata, deposit rollmelette.Deposit, payload []byte) error {
@@ -126,23 +147,24 @@ func (h *IssuanceAdvanceHandlers) CloseIssuance(env rollmelette.Env, metadata ro
 		return fmt.Errorf("failed to validate input: %w", err)
 	}
 
-	closeIssuance := issuance.NewCloseIssuanceUseCase(h.UserRepository, h.IssuanceRepository, h.OrderRepository)
+	closeIssuance := issuance.NewCloseIssuanceUseCase(h.UserRepository, h.IssuanceRepository, h.OrderRepository, h.ReputationRepository)
 	res, err := closeIssuance.Execute(&input, metadata)
 	if err != nil && res == nil {
 		return fmt.Errorf("failed to close issuance: %w", err)
 	}
 
-	token := common.Address(res.Token)
-
 	// Process orders
 	for _, order := range res.Orders {
 		if order.State == string(entity.OrderStateRejected) {
-			if err = env.ERC20Transfer(
-				token,
-				env.AppAddress(),
-				common.Address(order.Investor.Address),
-				order.Amount.ToBig(),
-			); err != nil {
+			if err = transferERC20(env, metadata, h.LedgerRepository, &ledger.RecordTransferInputDTO{
+				Token:      res.Token,
+				From:       Address(env.AppAddress()),
+				To:         order.Investor.Address,
+				Amount:     order.Amount,
+				Reason:     entity.LedgerReasonOrderRejectRefund,
+				IssuanceId: res.Id,
+				OrderId:    order.Id,
+			}); err != nil {
 				return fmt.Errorf("failed to transfer rejected order: %w", err)
 			}
 		}
@@ -172,13 +194,27 @@ func (h *IssuanceAdvanceHandlers) CloseIssuance(env rollmelette.Env, metadata ro
 
 	// Transfer fee to admin
 	if adminFee.Sign() > 0 {
-		if err := env.ERC20Transfer(token, env.AppAddress(), common.Address(adminUser.Address), adminFee.ToBig()); err != nil {
+		if err := transferERC20(env, metadata, h.LedgerRepository, &ledger.RecordTransferInputDTO{
+			Token:      res.Token,
+			From:       Address(env.AppAddress()),
+			To:         adminUser.Address,
+			Amount:     adminFee,
+			Reason:     entity.LedgerReasonIssuanceFee,
+			IssuanceId: res.Id,
+		}); err != nil {
 			return fmt.Errorf("failed to transfer fee to admin: %w", err)
 		}
 	}
 
 	// Transfer remaining amount to creator
-	if err := env.ERC20Transfer(token, env.AppAddress(), common.Address(res.Creator.Address), creatorAmount.ToBig()); err != nil {
+	if err := transferERC20(env, metadata, h.LedgerRepository, &ledger.RecordTransferInputDTO{
+		Token:      res.Token,
+		From:       Address(env.AppAddress()),
+		To:         res.Creator.Address,
+		Amount:     creatorAmount,
+		Reason:     entity.LedgerReasonIssuanceProceeds,
+		IssuanceId: res.Id,
+	}); err != nil {
 		return fmt.Errorf("failed to transfer amount to creator: %w", err)
 	}
 
@@ -216,13 +252,7 @@ func (h *IssuanceAdvanceHandlers) CloseIssuance(env rollmelette.Env, metadata ro
 		}
 	}
 
-	issuance, err := json.Marshal(res)
-	if err != nil {
-		return fmt.Errorf("failed to marshal response: %w", err)
-	}
-
-	env.Notice(append([]byte(fmt.Sprintf("issuance %v - ", res.State)), issuance...))
-	return nil
+	return router.Notice(env, metadata, "issuance."+res.State, res)
 }
 
 func (h *IssuanceAdvanceHandlers) SettleIssuance(env rollmelette.Env, metadata rollmelette.Metadata, deposit rollmelette.Deposit, payload []byte) error {
@@ -240,6 +270,7 @@ func (h *IssuanceAdvanceHandlers) SettleIssuance(env rollmelette.Env, metadata r
 		h.UserRepository,
 		h.IssuanceRepository,
 		h.OrderRepository,
+		h.ReputationRepository,
 	)
 
 	res, err := settleIssuance.Execute(&input, deposit, metadata)
@@ -247,9 +278,6 @@ func (h *IssuanceAdvanceHandlers) SettleIssuance(env rollmelette.Env, metadata r
 		return fmt.Errorf("failed to settle issuance: %w", err)
 	}
 
-	contractAddr := common.Address(res.Token)
-	creatorAddr := common.Address(res.Creator.Address)
-
 	abiJSON := `[{
 		"type":"function",
 		"name":"safeMint",
@@ -276,12 +304,15 @@ func (h *IssuanceAdvanceHandlers) SettleIssuance(env rollmelette.Env, metadata r
 			// Calculate total payment
 			totalPayment := new(uint256.Int).Add(order.Amount, interest)
 
-			if err := env.ERC20Transfer(
-				contractAddr,
-				creatorAddr,
-				common.Address(order.Investor.Address),
-				totalPayment.ToBig(),
-			); err != nil {
+			if err := transferERC20(env, metadata, h.LedgerRepository, &ledger.RecordTransferInputDTO{
+				Token:      res.Token,
+				From:       res.Creator.Address,
+				To:         order.Investor.Address,
+				Amount:     totalPayment,
+				Reason:     entity.LedgerReasonSettlement,
+				IssuanceId: res.Id,
+				OrderId:    order.Id,
+			}); err != nil {
 				return fmt.Errorf("failed to transfer settled order: %w", err)
 			}
 
@@ -301,13 +332,7 @@ func (h *IssuanceAdvanceHandlers) SettleIssuance(env rollmelette.Env, metadata r
 		}
 	}
 
-	issuance, err := json.Marshal(res)
-	if err != nil {
-		return fmt.Errorf("failed to marshal response: %w", err)
-	}
-
-	env.Notice(append([]byte("issuance settled - "), issuance...))
-	return nil
+	return router.Notice(env, metadata, "issuance.settled", res)
 }
 
 func (h *IssuanceAdvanceHandlers) ExecuteIssuanceCollateral(env rollmelette.Env, metadata rollmelette.Metadata, deposit rollmelette.Deposit, payload []byte) error {
@@ -321,7 +346,7 @@ func (h *IssuanceAdvanceHandlers) ExecuteIssuanceCollateral(env rollmelette.Env,
 		return fmt.Errorf("failed to validate input: %w", err)
 	}
 
-	executeIssuanceCollateral := issuance.NewExecuteIssuanceCollateralUseCase(h.UserRepository, h.IssuanceRepository, h.OrderRepository)
+	executeIssuanceCollateral := issuance.NewExecuteIssuanceCollateralUseCase(h.UserRepository, h.IssuanceRepository, h.OrderRepository, h.ReputationRepository)
 	res, err := executeIssuanceCollateral.Execute(&input, metadata)
 	if err != nil {
 		return fmt.Errorf("failed to execute issuance collateral: %w", err)
@@ -346,22 +371,19 @@ func (h *IssuanceAdvanceHandlers) ExecuteIssuanceCollateral(env rollmelette.Env,
 			orderShare := new(uint256.Int).Mul(finalValue, res.CollateralAmount)
 			orderShare.Div(orderShare, totalFinalValue)
 
-			if err = env.ERC20Transfer(
-				common.Address(res.CollateralAddress),
-				env.AppAddress(),
-				common.Address(order.Investor.Address),
-				orderShare.ToBig(),
-			); err != nil {
+			if err = transferERC20(env, metadata, h.LedgerRepository, &ledger.RecordTransferInputDTO{
+				Token:      res.CollateralAddress,
+				From:       Address(env.AppAddress()),
+				To:         order.Investor.Address,
+				Amount:     orderShare,
+				Reason:     entity.LedgerReasonCollateralExecution,
+				IssuanceId: res.Id,
+				OrderId:    order.Id,
+			}); err != nil {
 				return fmt.Errorf("failed to transfer collateral to investor: %w", err)
 			}
 		}
 	}
 
-	issuance, err := json.Marshal(res)
-	if err != nil {
-		return fmt.Errorf("failed to marshal response: %w", err)
-	}
-
-	env.Notice(append([]byte("issuance collateral executed - "), issuance...))
-	return nil
+	return router.Notice(env, metadata, "issuance.collateral_executed", res)
 }
@@ -0,0 +1,33 @@
+package advance
+
+import (
+	"fmt"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/ledger"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rollmelette/rollmelette"
+)
+
+// transferERC20 transfers tokens between application wallets and records the transfer in the
+// ledger. Every ERC20 transfer made by the advance handlers must go through it so the ledger
+// can be reconciled with the wallet.
+func transferERC20(env rollmelette.Env, metadata rollmelette.Metadata, ledgerRepo repository.LedgerRepository, input *ledger.RecordTransferInputDTO) error {
+	if err := env.ERC20Transfer(
+		common.Address(input.Token),
+		common.Address(input.From),
+		common.Address(input.To),
+		input.Amount.ToBig(),
+	); err != nil {
+		return err
+	}
+	if input.Amount.IsZero() {
+		return nil
+	}
+
+	recordTransfer := ledger.NewRecordTransferUseCase(ledgerRepo)
+	if _, err := recordTransfer.Execute(input, metadata); err != nil {
+		return fmt.Errorf("failed to record ledger entry: %w", err)
+	}
+	return nil
+}
@@ -4,28 +4,39 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/ledger"
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/order"
-	"github.com/ethereum/go-ethereum/common"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/router"
+	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
 	"github.com/go-playground/validator/v10"
+	"github.com/holiman/uint256"
 	"github.com/rollmelette/rollmelette"
 )
 
 type OrderAdvanceHandlers struct {
-	OrderRepository    repository.OrderRepository
-	UserRepository     repository.UserRepository
-	IssuanceRepository repository.IssuanceRepository
+	OrderRepository       repository.OrderRepository
+	UserRepository        repository.UserRepository
+	IssuanceRepository    repository.IssuanceRepository
+	AttestationRepository repository.AttestationRepository
+	LedgerRepository      repository.LedgerRepository
 }
 
 func NewOrderAdvanceHandlers(
 	orderRepo repository.OrderRepository,
 	userRepo repository.UserRepository,
 	issuanceRepo repository.IssuanceRepository,
+	attestationRepo repository.AttestationRepository,
+	ledgerRepo repository.LedgerRepository,
 ) *OrderAdvanceHandlers {
 	return &OrderAdvanceHandlers{
-		OrderRepository:    orderRepo,
-		UserRepository:     userRepo,
-		IssuanceRepository: issuanceRepo,
+		OrderRepository:       orderRepo,
+		UserRepository:        userRepo,
+		IssuanceRepository:    issuanceRepo,
+		AttestationRepository: attestationRepo,
+		LedgerRepository:      ledgerRepo,
 	}
 }
 
@@ -44,6 +55,7 @@ func (h *OrderAdvanceHandlers) CreateOrder(env rollmelette.Env, metadata rollmel
 		h.UserRepository,
 		h.OrderRepository,
 		h.IssuanceRepository,
+		h.AttestationRepository,
 	)
 
 	res, err := createOrder.Execute(&input, deposit, metadata)
@@ -53,25 +65,22 @@ func (h *OrderAdvanceHandlers) CreateOrder(env rollmelette.Env, metadata rollmel
 
 	erc20Deposit, ok := deposit.(*rollmelette.ERC20Deposit)
 	if !ok {
-		return fmt.Errorf("invalid deposit types, expected ERC20Deposit")
+		return domain.ErrInvalidDeposit.New(fmt.Sprintf("%T", deposit))
 	}
 
-	if err := env.ERC20Transfer(
-		erc20Deposit.Token,
-		erc20Deposit.Sender,
-		env.AppAddress(),
-		erc20Deposit.Value,
-	); err != nil {
+	if err := transferERC20(env, metadata, h.LedgerRepository, &ledger.RecordTransferInputDTO{
+		Token:      Address(erc20Deposit.Token),
+		From:       Address(erc20Deposit.Sender),
+		To:         Address(env.AppAddress()),
+		Amount:     uint256.MustFromBig(erc20Deposit.Value),
+		Reason:     entity.LedgerReasonOrderEscrow,
+		IssuanceId: res.IssuanceId,
+		OrderId:    res.Id,
+	}); err != nil {
 		return fmt.Errorf("failed to transfer ERC20: %w", err)
 	}
 
-	order, err := json.Marshal(res)
-	if err != nil {
-		return fmt.Errorf("failed to marshal response: %w", err)
-	}
-
-	env.Notice(append([]byte("order created - "), order...))
-	return nil
+	return router.Notice(env, metadata, "order.created", res)
 }
 
 func (h *OrderAdvanceHandlers) CancelOrder(env rollmelette.Env, metadata rollmelette.Metadata, deposit rollmelette.Deposit, payload []byte) error {
@@ -96,20 +105,17 @@ func (h *OrderAdvanceHandlers) CancelOrder(env rollmelette.Env, metadata rollmel
 		return fmt.Errorf("failed to cancel order: %w", err)
 	}
 
-	if err := env.ERC20Transfer(
-		common.Address(res.Token),
-		env.AppAddress(),
-		metadata.MsgSender,
-		res.Amount.ToBig(),
-	); err != nil {
+	if err := transferERC20(env, metadata, h.LedgerRepository, &ledger.RecordTransferInputDTO{
+		Token:      res.Token,
+		From:       Address(env.AppAddress()),
+		To:         Address(metadata.MsgSender),
+		Amount:     res.Amount,
+		Reason:     entity.LedgerReasonOrderCancelRefund,
+		IssuanceId: res.IssuanceId,
+		OrderId:    res.Id,
+	}); err != nil {
 		return fmt.Errorf("failed to transfer ERC20: %w", err)
 	}
 
-	order, err := json.Marshal(res)
-	if err != nil {
-		return fmt.Errorf("failed to marshal response: %w", err)
-	}
-
-	env.Notice(append([]byte("order canceled - "), order...))
-	return nil
+	return router.Notice(env, metadata, "order.canceled", res)
 }
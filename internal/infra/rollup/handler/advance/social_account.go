@@ -4,27 +4,55 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/configs"
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/social_account"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/router"
 	"github.com/go-playground/validator/v10"
 	"github.com/rollmelette/rollmelette"
 )
 
 type SocialAccountAdvanceHandlers struct {
+	Config                  *configs.RollupConfig
 	UserRepository          repository.UserRepository
 	SocialAccountRepository repository.SocialAccountRepository
 }
 
 func NewSocialAccountAdvanceHandlers(
+	cfg *configs.RollupConfig,
 	userRepo repository.UserRepository,
 	socialAccountRepo repository.SocialAccountRepository,
 ) *SocialAccountAdvanceHandlers {
 	return &SocialAccountAdvanceHandlers{
+		Config:                  cfg,
 		UserRepository:          userRepo,
 		SocialAccountRepository: socialAccountRepo,
 	}
 }
 
+func (s *SocialAccountAdvanceHandlers) RequestSocialAccountChallenge(env rollmelette.Env, metadata rollmelette.Metadata, deposit rollmelette.Deposit, payload []byte) error {
+	var input social_account.RequestSocialAccountChallengeInputDTO
+	if err := json.Unmarshal(payload, &input); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", err)
+	}
+
+	validator := validator.New()
+	if err := validator.Struct(input); err != nil {
+		return fmt.Errorf("failed to validate input: %w", err)
+	}
+
+	requestChallenge := social_account.NewRequestSocialAccountChallengeUseCase(
+		s.UserRepository,
+		s.SocialAccountRepository,
+		int64(s.Config.SocialChallengeTtl.Seconds()),
+	)
+	res, err := requestChallenge.Execute(&input, metadata)
+	if err != nil {
+		return fmt.Errorf("failed to request social account challenge: %w", err)
+	}
+	return router.Notice(env, metadata, "social_account.challenge_requested", res)
+}
+
 func (s *SocialAccountAdvanceHandlers) CreateSocialAccount(env rollmelette.Env, metadata rollmelette.Metadata, deposit rollmelette.Deposit, payload []byte) error {
 	var input social_account.CreateSocialAccountInputDTO
 	err := json.Unmarshal(payload, &input)
@@ -37,17 +65,12 @@ func (s *SocialAccountAdvanceHandlers) CreateSocialAccount(env rollmelette.Env,
 		return fmt.Errorf("failed to validate input: %w", err)
 	}
 
-	createSocialAccount := social_account.NewCreateSocialAccountUseCase(s.UserRepository, s.SocialAccountRepository)
+	createSocialAccount := social_account.NewCreateSocialAccountUseCase(s.SocialAccountRepository)
 	res, err := createSocialAccount.Execute(&input, &metadata)
 	if err != nil {
 		return err
 	}
-	socialAccount, err := json.Marshal(res)
-	if err != nil {
-		return err
-	}
-	env.Notice(append([]byte("social account created - "), socialAccount...))
-	return nil
+	return router.Notice(env, metadata, "social_account.created", res)
 }
 
 func (s *SocialAccountAdvanceHandlers) DeleteSocialAccount(env rollmelette.Env, metadata rollmelette.Metadata, deposit rollmelette.Deposit, payload []byte) error {
@@ -67,10 +90,24 @@ func (s *SocialAccountAdvanceHandlers) DeleteSocialAccount(env rollmelette.Env,
 	if err != nil {
 		return err
 	}
-	socialAccount, err := json.Marshal(input)
+	return router.Notice(env, metadata, "social_account.deleted", input)
+}
+
+func (s *SocialAccountAdvanceHandlers) UpdatePlatformSetting(env rollmelette.Env, metadata rollmelette.Metadata, deposit rollmelette.Deposit, payload []byte) error {
+	var input social_account.UpdatePlatformSettingInputDTO
+	if err := json.Unmarshal(payload, &input); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", err)
+	}
+
+	validator := validator.New()
+	if err := validator.Struct(input); err != nil {
+		return fmt.Errorf("failed to validate input: %w", err)
+	}
+
+	updatePlatformSetting := social_account.NewUpdatePlatformSettingUseCase(s.SocialAccountRepository)
+	res, err := updatePlatformSetting.Execute(&input, metadata)
 	if err != nil {
-		return err
+		return fmt.Errorf("failed to update platform setting: %w", err)
 	}
-	env.Notice(append([]byte("social account deleted - "), socialAccount...))
-	return nil
+	return router.Notice(env, metadata, "social_platform.updated", res)
 }
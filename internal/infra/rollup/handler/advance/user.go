@@ -3,29 +3,45 @@ package advance
 import (
 	"encoding/json"
 	"fmt"
+	"slices"
 
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/configs"
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/ledger"
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/user"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/router"
 	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/go-playground/validator/v10"
+	"github.com/holiman/uint256"
 	"github.com/rollmelette/rollmelette"
 )
 
 type UserAdvanceHandlers struct {
-	Config         *configs.RollupConfig
-	UserRepository repository.UserRepository
+	Config                    *configs.RollupConfig
+	UserRepository            repository.UserRepository
+	IssuanceRepository        repository.IssuanceRepository
+	OrderRepository           repository.OrderRepository
+	AddressRotationRepository repository.AddressRotationRepository
+	LedgerRepository          repository.LedgerRepository
 }
 
 func NewUserAdvanceHandlers(
 	cfg *configs.RollupConfig,
 	userRepo repository.UserRepository,
+	issuanceRepo repository.IssuanceRepository,
+	orderRepo repository.OrderRepository,
+	addressRotationRepo repository.AddressRotationRepository,
+	ledgerRepo repository.LedgerRepository,
 ) *UserAdvanceHandlers {
 	return &UserAdvanceHandlers{
-		Config:         cfg,
-		UserRepository: userRepo,
+		Config:                    cfg,
+		UserRepository:            userRepo,
+		IssuanceRepository:        issuanceRepo,
+		OrderRepository:           orderRepo,
+		AddressRotationRepository: addressRotationRepo,
+		LedgerRepository:          ledgerRepo,
 	}
 }
 
@@ -46,13 +62,7 @@ func (h *UserAdvanceHandlers) CreateUser(env rollmelette.Env, metadata rollmelet
 		return fmt.Errorf("failed to create user: %w", err)
 	}
 
-	user, err := json.Marshal(res)
-	if err != nil {
-		return fmt.Errorf("failed to marshal response: %w", err)
-	}
-
-	env.Notice(append([]byte("user created - "), user...))
-	return nil
+	return router.Notice(env, metadata, "user.created", res)
 }
 
 func (h *UserAdvanceHandlers) DeleteUser(env rollmelette.Env, metadata rollmelette.Metadata, deposit rollmelette.Deposit, payload []byte) error {
@@ -66,17 +76,209 @@ func (h *UserAdvanceHandlers) DeleteUser(env rollmelette.Env, metadata rollmelet
 		return fmt.Errorf("failed to validate input: %w", err)
 	}
 
-	deleteUserByAddress := user.NewDeleteUserUseCase(h.UserRepository)
-	if err := deleteUserByAddress.Execute(&input); err != nil {
+	deleteUserByAddress := user.NewDeleteUserUseCase(h.UserRepository, h.IssuanceRepository, h.OrderRepository)
+	res, err := deleteUserByAddress.Execute(&input, metadata)
+	if err != nil {
 		return fmt.Errorf("failed to delete user: %w", err)
 	}
 
-	user, err := json.Marshal(input)
+	return router.Notice(env, metadata, "user.deactivated", res)
+}
+
+func (h *UserAdvanceHandlers) ReactivateUser(env rollmelette.Env, metadata rollmelette.Metadata, deposit rollmelette.Deposit, payload []byte) error {
+	var input user.ReactivateUserInputDTO
+	if err := json.Unmarshal(payload, &input); err != nil {
+		return fmt.Errorf("failed to unmarshal input: %w", err)
+	}
+
+	validator := validator.New()
+	if err := validator.Struct(input); err != nil {
+		return fmt.Errorf("failed to validate input: %w", err)
+	}
+
+	reactivateUser := user.NewReactivateUserUseCase(h.UserRepository)
+	res, err := reactivateUser.Execute(&input, metadata)
+	if err != nil {
+		return fmt.Errorf("failed to reactivate user: %w", err)
+	}
+
+	return router.Notice(env, metadata, "user.reactivated", res)
+}
+
+func (h *UserAdvanceHandlers) GrantUserRole(env rollmelette.Env, metadata rollmelette.Metadata, deposit rollmelette.Deposit, payload []byte) error {
+	var input user.GrantUserRoleInputDTO
+	if err := json.Unmarshal(payload, &input); err != nil {
+		return fmt.Errorf("failed to unmarshal input: %w", err)
+	}
+
+	validator := validator.New()
+	if err := validator.Struct(input); err != nil {
+		return fmt.Errorf("failed to validate input: %w", err)
+	}
+
+	grantUserRole := user.NewGrantUserRoleUseCase(h.UserRepository)
+	res, err := grantUserRole.Execute(&input, metadata)
+	if err != nil {
+		return fmt.Errorf("failed to grant user role: %w", err)
+	}
+
+	return router.Notice(env, metadata, "user.role_granted", res)
+}
+
+func (h *UserAdvanceHandlers) RevokeUserRole(env rollmelette.Env, metadata rollmelette.Metadata, deposit rollmelette.Deposit, payload []byte) error {
+	var input user.RevokeUserRoleInputDTO
+	if err := json.Unmarshal(payload, &input); err != nil {
+		return fmt.Errorf("failed to unmarshal input: %w", err)
+	}
+
+	validator := validator.New()
+	if err := validator.Struct(input); err != nil {
+		return fmt.Errorf("failed to validate input: %w", err)
+	}
+
+	revokeUserRole := user.NewRevokeUserRoleUseCase(h.UserRepository)
+	res, err := revokeUserRole.Execute(&input, metadata)
+	if err != nil {
+		return fmt.Errorf("failed to revoke user role: %w", err)
+	}
+
+	return router.Notice(env, metadata, "user.role_revoked", res)
+}
+
+func (h *UserAdvanceHandlers) UpdateUserRole(env rollmelette.Env, metadata rollmelette.Metadata, deposit rollmelette.Deposit, payload []byte) error {
+	var input user.UpdateUserRoleInputDTO
+	if err := json.Unmarshal(payload, &input); err != nil {
+		return fmt.Errorf("failed to unmarshal input: %w", err)
+	}
+
+	validator := validator.New()
+	if err := validator.Struct(input); err != nil {
+		return fmt.Errorf("failed to validate input: %w", err)
+	}
+
+	updateUserRole := user.NewUpdateUserRoleUseCase(h.UserRepository)
+	res, err := updateUserRole.Execute(&input, metadata)
+	if err != nil {
+		return fmt.Errorf("failed to update user role: %w", err)
+	}
+
+	return router.Notice(env, metadata, "user.role_updated", res)
+}
+
+func (h *UserAdvanceHandlers) ProposeAddressRotation(env rollmelette.Env, metadata rollmelette.Metadata, deposit rollmelette.Deposit, payload []byte) error {
+	var input user.ProposeAddressRotationInputDTO
+	if err := json.Unmarshal(payload, &input); err != nil {
+		return fmt.Errorf("failed to unmarshal input: %w", err)
+	}
+
+	validator := validator.New()
+	if err := validator.Struct(input); err != nil {
+		return fmt.Errorf("failed to validate input: %w", err)
+	}
+
+	proposeAddressRotation := user.NewProposeAddressRotationUseCase(h.UserRepository, h.AddressRotationRepository, h.Config.AddressRotationApproval)
+	res, err := proposeAddressRotation.Execute(&input, metadata)
+	if err != nil {
+		return fmt.Errorf("failed to propose address rotation: %w", err)
+	}
+
+	return router.Notice(env, metadata, "address_rotation.proposed", res)
+}
+
+func (h *UserAdvanceHandlers) AcceptAddressRotation(env rollmelette.Env, metadata rollmelette.Metadata, deposit rollmelette.Deposit, payload []byte) error {
+	var input user.AcceptAddressRotationInputDTO
+	if err := json.Unmarshal(payload, &input); err != nil {
+		return fmt.Errorf("failed to unmarshal input: %w", err)
+	}
+
+	validator := validator.New()
+	if err := validator.Struct(input); err != nil {
+		return fmt.Errorf("failed to validate input: %w", err)
+	}
+
+	acceptAddressRotation := user.NewAcceptAddressRotationUseCase(h.UserRepository, h.AddressRotationRepository)
+	res, err := acceptAddressRotation.Execute(&input, metadata)
 	if err != nil {
-		return fmt.Errorf("failed to marshal input: %w", err)
+		return fmt.Errorf("failed to accept address rotation: %w", err)
+	}
+	if err := h.moveWalletBalances(env, metadata, res); err != nil {
+		return err
 	}
 
-	env.Notice(append([]byte("user deleted - "), user...))
+	return router.Notice(env, metadata, "address_rotation.accepted", res)
+}
+
+func (h *UserAdvanceHandlers) ApproveAddressRotation(env rollmelette.Env, metadata rollmelette.Metadata, deposit rollmelette.Deposit, payload []byte) error {
+	var input user.ApproveAddressRotationInputDTO
+	if err := json.Unmarshal(payload, &input); err != nil {
+		return fmt.Errorf("failed to unmarshal input: %w", err)
+	}
+
+	validator := validator.New()
+	if err := validator.Struct(input); err != nil {
+		return fmt.Errorf("failed to validate input: %w", err)
+	}
+
+	approveAddressRotation := user.NewApproveAddressRotationUseCase(h.UserRepository, h.AddressRotationRepository)
+	res, err := approveAddressRotation.Execute(&input, metadata)
+	if err != nil {
+		return fmt.Errorf("failed to approve address rotation: %w", err)
+	}
+	if err := h.moveWalletBalances(env, metadata, res); err != nil {
+		return err
+	}
+
+	return router.Notice(env, metadata, "address_rotation.approved", res)
+}
+
+func (h *UserAdvanceHandlers) CancelAddressRotation(env rollmelette.Env, metadata rollmelette.Metadata, deposit rollmelette.Deposit, payload []byte) error {
+	var input user.CancelAddressRotationInputDTO
+	if err := json.Unmarshal(payload, &input); err != nil {
+		return fmt.Errorf("failed to unmarshal input: %w", err)
+	}
+
+	validator := validator.New()
+	if err := validator.Struct(input); err != nil {
+		return fmt.Errorf("failed to validate input: %w", err)
+	}
+
+	cancelAddressRotation := user.NewCancelAddressRotationUseCase(h.AddressRotationRepository)
+	res, err := cancelAddressRotation.Execute(&input, metadata)
+	if err != nil {
+		return fmt.Errorf("failed to cancel address rotation: %w", err)
+	}
+
+	return router.Notice(env, metadata, "address_rotation.canceled", res)
+}
+
+// moveWalletBalances transfers the application wallet balances of a rotated user to its
+// new address.
+func (h *UserAdvanceHandlers) moveWalletBalances(env rollmelette.Env, metadata rollmelette.Metadata, rotation *user.AddressRotationOutputDTO) error {
+	if rotation.State != string(entity.AddressRotationStateCompleted) {
+		return nil
+	}
+	from := common.Address(rotation.OldAddress)
+	to := common.Address(rotation.NewAddress)
+	for _, token := range env.ERC20Tokens() {
+		balance := env.ERC20BalanceOf(token, from)
+		if balance.Sign() == 0 {
+			continue
+		}
+		if err := transferERC20(env, metadata, h.LedgerRepository, &ledger.RecordTransferInputDTO{
+			Token:  Address(token),
+			From:   rotation.OldAddress,
+			To:     rotation.NewAddress,
+			Amount: uint256.MustFromBig(balance),
+			Reason: entity.LedgerReasonAddressRotation,
+		}); err != nil {
+			return fmt.Errorf("failed to move ERC20 balance: %w", err)
+		}
+	}
+	if balance := env.EtherBalanceOf(from); balance.Sign() > 0 {
+		if err := env.EtherTransfer(from, to, balance); err != nil {
+			return fmt.Errorf("failed to move Ether balance: %w", err)
+		}
+	}
 	return nil
 }
 
@@ -100,13 +302,14 @@ func (h *UserAdvanceHandlers) ERC20Withdraw(env rollmelette.Env, metadata rollme
 	}
 
 	// For admin, transfer from app address to admin first, then withdraw
-	if entity.UserRole(res.Role) == entity.UserRoleAdmin {
-		if err := env.ERC20Transfer(
-			common.Address(input.Token),
-			env.AppAddress(),
-			metadata.MsgSender,
-			input.Amount.ToBig(),
-		); err != nil {
+	if slices.Contains(res.Roles, string(entity.UserRoleAdmin)) {
+		if err := transferERC20(env, metadata, h.LedgerRepository, &ledger.RecordTransferInputDTO{
+			Token:  input.Token,
+			From:   Address(env.AppAddress()),
+			To:     Address(metadata.MsgSender),
+			Amount: input.Amount,
+			Reason: entity.LedgerReasonFeeWithdrawal,
+		}); err != nil {
 			return fmt.Errorf("failed to transfer ERC20 from app to admin: %w", err)
 		}
 	}
@@ -120,10 +323,9 @@ func (h *UserAdvanceHandlers) ERC20Withdraw(env rollmelette.Env, metadata rollme
 		return fmt.Errorf("failed to withdraw ERC20: %w", err)
 	}
 
-	env.Notice([]byte(
-		fmt.Sprintf(
-			"ERC20 withdrawn - token: %s, amount: %s, user: %s", common.Address(input.Token), input.Amount.ToBig(), metadata.MsgSender,
-		),
-	))
-	return nil
+	return router.Notice(env, metadata, "user.erc20_withdrawn", &user.WithdrawOutputDTO{
+		Token:  input.Token,
+		Amount: input.Amount,
+		User:   Address(metadata.MsgSender),
+	})
 }
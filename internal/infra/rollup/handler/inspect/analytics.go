@@ -0,0 +1,56 @@
+package inspect
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/configs"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/analytics"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/router"
+	"github.com/go-playground/validator/v10"
+	"github.com/rollmelette/rollmelette"
+)
+
+type AnalyticsInspectHandlers struct {
+	Config              *configs.RollupConfig
+	AnalyticsRepository repository.AnalyticsRepository
+}
+
+func NewAnalyticsInspectHandlers(
+	cfg *configs.RollupConfig,
+	analyticsRepo repository.AnalyticsRepository,
+) *AnalyticsInspectHandlers {
+	return &AnalyticsInspectHandlers{
+		Config:              cfg,
+		AnalyticsRepository: analyticsRepo,
+	}
+}
+
+func (h *AnalyticsInspectHandlers) FindAnalyticsSummary(env rollmelette.EnvInspector, payload []byte) error {
+	findAnalyticsSummary := analytics.NewFindAnalyticsSummaryUseCase(h.AnalyticsRepository, h.Config.IssuanceFee)
+	res, err := findAnalyticsSummary.Execute()
+	if err != nil {
+		return fmt.Errorf("failed to find analytics summary: %w", err)
+	}
+	return router.Report(env, "analytics.summary", res)
+}
+
+func (h *AnalyticsInspectHandlers) FindAnalyticsSeries(env rollmelette.EnvInspector, payload []byte) error {
+	var input analytics.FindAnalyticsSeriesInputDTO
+	if err := json.Unmarshal(payload, &input); err != nil {
+		return fmt.Errorf("failed to unmarshal input: %w", err)
+	}
+
+	validator := validator.New()
+	if err := validator.Struct(input); err != nil {
+		return fmt.Errorf("failed to validate input: %w", err)
+	}
+
+	findAnalyticsSeries := analytics.NewFindAnalyticsSeriesUseCase(h.AnalyticsRepository)
+	res, err := findAnalyticsSeries.Execute(&input)
+	if err != nil {
+		return fmt.Errorf("failed to find analytics series: %w", err)
+	}
+	return router.Report(env, "analytics.series", res)
+}
@@ -0,0 +1,65 @@
+package inspect
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/attestation"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/router"
+	"github.com/go-playground/validator/v10"
+	"github.com/rollmelette/rollmelette"
+)
+
+type AttestationInspectHandlers struct {
+	UserRepository        repository.UserRepository
+	AttestationRepository repository.AttestationRepository
+}
+
+func NewAttestationInspectHandlers(
+	userRepo repository.UserRepository,
+	attestationRepo repository.AttestationRepository,
+) *AttestationInspectHandlers {
+	return &AttestationInspectHandlers{
+		UserRepository:        userRepo,
+		AttestationRepository: attestationRepo,
+	}
+}
+
+func (h *AttestationInspectHandlers) FindAttestationById(env rollmelette.EnvInspector, payload []byte) error {
+	var input attestation.FindAttestationByIdInputDTO
+	if err := json.Unmarshal(payload, &input); err != nil {
+		return fmt.Errorf("failed to unmarshal input: %w", err)
+	}
+
+	validator := validator.New()
+	if err := validator.Struct(input); err != nil {
+		return fmt.Errorf("failed to validate input: %w", err)
+	}
+
+	findAttestationById := attestation.NewFindAttestationByIdUseCase(h.AttestationRepository)
+	res, err := findAttestationById.Execute(&input)
+	if err != nil {
+		return fmt.Errorf("failed to find attestation: %w", err)
+	}
+	return router.Report(env, "attestation", res)
+}
+
+func (h *AttestationInspectHandlers) FindAttestationsByUserAddress(env rollmelette.EnvInspector, payload []byte) error {
+	var input attestation.FindAttestationsByUserAddressInputDTO
+	if err := json.Unmarshal(payload, &input); err != nil {
+		return fmt.Errorf("failed to unmarshal input: %w", err)
+	}
+
+	validator := validator.New()
+	if err := validator.Struct(input); err != nil {
+		return fmt.Errorf("failed to validate input: %w", err)
+	}
+
+	findAttestationsByUserAddress := attestation.NewFindAttestationsByUserAddressUseCase(h.UserRepository, h.AttestationRepository)
+	res, err := findAttestationsByUserAddress.Execute(&input)
+	if err != nil {
+		return fmt.Errorf("failed to find attestations by user address: %w", err)
+	}
+	return router.Report(env, "attestation.list", res)
+}
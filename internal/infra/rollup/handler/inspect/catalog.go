@@ -0,0 +1,24 @@
+package inspect
+
+import (
+	"fmt"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/catalog"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/router"
+	"github.com/rollmelette/rollmelette"
+)
+
+type CatalogInspectHandlers struct{}
+
+func NewCatalogInspectHandlers() *CatalogInspectHandlers {
+	return &CatalogInspectHandlers{}
+}
+
+func (h *CatalogInspectHandlers) FindErrorCatalog(env rollmelette.EnvInspector, payload []byte) error {
+	findErrorCatalog := catalog.NewFindErrorCatalogUseCase()
+	res, err := findErrorCatalog.Execute()
+	if err != nil {
+		return fmt.Errorf("failed to find error catalog: %w", err)
+	}
+	return router.Report(env, "error.catalog", res)
+}
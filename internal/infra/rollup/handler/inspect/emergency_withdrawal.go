@@ -0,0 +1,52 @@
+package inspect
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/emergency_withdrawal"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/router"
+	"github.com/go-playground/validator/v10"
+	"github.com/rollmelette/rollmelette"
+)
+
+type EmergencyWithdrawalInspectHandlers struct {
+	EmergencyWithdrawalRepository repository.EmergencyWithdrawalRepository
+}
+
+func NewEmergencyWithdrawalInspectHandlers(
+	emergencyWithdrawalRepo repository.EmergencyWithdrawalRepository,
+) *EmergencyWithdrawalInspectHandlers {
+	return &EmergencyWithdrawalInspectHandlers{
+		EmergencyWithdrawalRepository: emergencyWithdrawalRepo,
+	}
+}
+
+func (h *EmergencyWithdrawalInspectHandlers) FindEmergencyWithdrawalById(env rollmelette.EnvInspector, payload []byte) error {
+	var input emergency_withdrawal.FindEmergencyWithdrawalByIdInputDTO
+	if err := json.Unmarshal(payload, &input); err != nil {
+		return fmt.Errorf("failed to unmarshal input: %w", err)
+	}
+
+	validator := validator.New()
+	if err := validator.Struct(input); err != nil {
+		return fmt.Errorf("failed to validate input: %w", err)
+	}
+
+	findEmergencyWithdrawalById := emergency_withdrawal.NewFindEmergencyWithdrawalByIdUseCase(h.EmergencyWithdrawalRepository)
+	res, err := findEmergencyWithdrawalById.Execute(&input)
+	if err != nil {
+		return fmt.Errorf("failed to find emergency withdrawal: %w", err)
+	}
+	return router.Report(env, "emergency_withdrawal", res)
+}
+
+func (h *EmergencyWithdrawalInspectHandlers) FindAllEmergencyWithdrawals(env rollmelette.EnvInspector, payload []byte) error {
+	findAllEmergencyWithdrawals := emergency_withdrawal.NewFindAllEmergencyWithdrawalsUseCase(h.EmergencyWithdrawalRepository)
+	res, err := findAllEmergencyWithdrawals.Execute()
+	if err != nil {
+		return fmt.Errorf("failed to find all emergency withdrawals: %w", err)
+	}
+	return router.Report(env, "emergency_withdrawal.list", res)
+}
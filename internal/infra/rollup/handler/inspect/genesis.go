@@ -0,0 +1,29 @@
+package inspect
+
+import (
+	"fmt"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/genesis"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/router"
+	"github.com/rollmelette/rollmelette"
+)
+
+type GenesisInspectHandlers struct {
+	GenesisRepository repository.GenesisRepository
+}
+
+func NewGenesisInspectHandlers(genesisRepo repository.GenesisRepository) *GenesisInspectHandlers {
+	return &GenesisInspectHandlers{
+		GenesisRepository: genesisRepo,
+	}
+}
+
+func (h *GenesisInspectHandlers) FindGenesis(env rollmelette.EnvInspector, payload []byte) error {
+	findGenesis := genesis.NewFindGenesisUseCase(h.GenesisRepository)
+	res, err := findGenesis.Execute()
+	if err != nil {
+		return fmt.Errorf("failed to find genesis: %w", err)
+	}
+	return router.Report(env, "genesis", res)
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/issuance"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/router"
 	"github.com/go-playground/validator/v10"
 	"github.com/rollmelette/rollmelette"
 )
@@ -41,12 +42,7 @@ func (h *IssuanceInspectHandlers) FindIssuanceById(env rollmelette.EnvInspector,
 	if err != nil {
 		return fmt.Errorf("failed to find issuance: %w", err)
 	}
-	issuance, err := json.Marshal(res)
-	if err != nil {
-		return fmt.Errorf("failed to marshal issuance: %w", err)
-	}
-	env.Report(issuance)
-	return nil
+	return router.Report(env, "issuance", res)
 }
 
 func (h *IssuanceInspectHandlers) FindAllIssuances(env rollmelette.EnvInspector, payload []byte) error {
@@ -56,12 +52,7 @@ func (h *IssuanceInspectHandlers) FindAllIssuances(env rollmelette.EnvInspector,
 	if err != nil {
 		return fmt.Errorf("failed to find all issuances: %w", err)
 	}
-	allIssuances, err := json.Marshal(res)
-	if err != nil {
-		return fmt.Errorf("failed to marshal all issuances: %w", err)
-	}
-	env.Report(allIssuances)
-	return nil
+	return router.Report(env, "issuance.list", res)
 }
 
 func (h *IssuanceInspectHandlers) FindIssuancesByInvestorAddress(env rollmelette.EnvInspector, payload []byte) error {
@@ -80,12 +71,7 @@ func (h *IssuanceInspectHandlers) FindIssuancesByInvestorAddress(env rollmelette
 	if err != nil {
 		return fmt.Errorf("failed to find issuances by investor: %w", err)
 	}
-	issuances, err := json.Marshal(res)
-	if err != nil {
-		return fmt.Errorf("failed to marshal issuances: %w", err)
-	}
-	env.Report(issuances)
-	return nil
+	return router.Report(env, "issuance.list", res)
 }
 
 func (h *IssuanceInspectHandlers) FindIssuancesByCreatorAddress(env rollmelette.EnvInspector, payload []byte) error {
@@ -104,10 +90,5 @@ func (h *IssuanceInspectHandlers) FindIssuancesByCreatorAddress(env rollmelette.
 	if err != nil {
 		return fmt.Errorf("failed to find issuances by creator: %w", err)
 	}
-	issuances, err := json.Marshal(res)
-	if err != nil {
-		return fmt.Errorf("failed to marshal issuances: %w", err)
-	}
-	env.Report(issuances)
-	return nil
+	return router.Report(env, "issuance.list", res)
 }
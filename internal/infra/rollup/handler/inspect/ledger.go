@@ -0,0 +1,64 @@
+package inspect
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/ledger"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/router"
+	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+	"github.com/go-playground/validator/v10"
+	"github.com/holiman/uint256"
+	"github.com/rollmelette/rollmelette"
+)
+
+type LedgerInspectHandlers struct {
+	LedgerRepository repository.LedgerRepository
+}
+
+func NewLedgerInspectHandlers(ledgerRepo repository.LedgerRepository) *LedgerInspectHandlers {
+	return &LedgerInspectHandlers{
+		LedgerRepository: ledgerRepo,
+	}
+}
+
+func (h *LedgerInspectHandlers) FindLedgerEntries(env rollmelette.EnvInspector, payload []byte) error {
+	var input ledger.FindLedgerEntriesInputDTO
+	if len(payload) > 0 {
+		if err := json.Unmarshal(payload, &input); err != nil {
+			return fmt.Errorf("failed to unmarshal input: %w", err)
+		}
+	}
+
+	validator := validator.New()
+	if err := validator.Struct(input); err != nil {
+		return fmt.Errorf("failed to validate input: %w", err)
+	}
+
+	findLedgerEntries := ledger.NewFindLedgerEntriesUseCase(h.LedgerRepository)
+	res, err := findLedgerEntries.Execute(&input)
+	if err != nil {
+		return fmt.Errorf("failed to find ledger entries: %w", err)
+	}
+	return router.Report(env, "ledger.entries", res)
+}
+
+// ReconcileLedger checks the ledger of the application address against its wallet balances.
+func (h *LedgerInspectHandlers) ReconcileLedger(env rollmelette.EnvInspector, payload []byte) error {
+	appAddress := env.AppAddress()
+	balances := make(map[Address]*uint256.Int)
+	for _, token := range env.ERC20Tokens() {
+		balances[Address(token)] = uint256.MustFromBig(env.ERC20BalanceOf(token, appAddress))
+	}
+
+	reconcileLedger := ledger.NewReconcileLedgerUseCase(h.LedgerRepository)
+	res, err := reconcileLedger.Execute(&ledger.ReconcileLedgerInputDTO{
+		Account:  Address(appAddress),
+		Balances: balances,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to reconcile ledger: %w", err)
+	}
+	return router.Report(env, "ledger.reconciliation", res)
+}
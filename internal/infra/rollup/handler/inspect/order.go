@@ -6,6 +6,7 @@ import (
 
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/order"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/router"
 	"github.com/rollmelette/rollmelette"
 )
 
@@ -35,12 +36,7 @@ func (h *OrderInspectHandlers) FindOrderById(env rollmelette.EnvInspector, paylo
 	if err != nil {
 		return fmt.Errorf("failed to find order: %w", err)
 	}
-	order, err := json.Marshal(res)
-	if err != nil {
-		return fmt.Errorf("failed to marshal order: %w", err)
-	}
-	env.Report(order)
-	return nil
+	return router.Report(env, "order", res)
 }
 
 func (h *OrderInspectHandlers) FindBidsByIssuanceId(env rollmelette.EnvInspector, payload []byte) error {
@@ -52,14 +48,9 @@ func (h *OrderInspectHandlers) FindBidsByIssuanceId(env rollmelette.EnvInspector
 	findOrdersByIssuanceId := order.NewFindOrdersByIssuanceIdUseCase(h.UserRepository, h.OrderRepository)
 	res, err := findOrdersByIssuanceId.Execute(&input)
 	if err != nil {
-		return fmt.Errorf("failed to find orders by issuance id: %v", err)
-	}
-	orders, err := json.Marshal(res)
-	if err != nil {
-		return fmt.Errorf("failed to marshal orders: %w", err)
+		return fmt.Errorf("failed to find orders by issuance id: %w", err)
 	}
-	env.Report(orders)
-	return nil
+	return router.Report(env, "order.list", res)
 }
 
 func (h *OrderInspectHandlers) FindAllOrders(env rollmelette.EnvInspector, payload []byte) error {
@@ -69,12 +60,7 @@ func (h *OrderInspectHandlers) FindAllOrders(env rollmelette.EnvInspector, paylo
 	if err != nil {
 		return fmt.Errorf("failed to find all orders: %w", err)
 	}
-	allOrders, err := json.Marshal(res)
-	if err != nil {
-		return fmt.Errorf("failed to marshal all orders: %w", err)
-	}
-	env.Report(allOrders)
-	return nil
+	return router.Report(env, "order.list", res)
 }
 
 func (h *OrderInspectHandlers) FindOrdersByInvestorAddress(env rollmelette.EnvInspector, payload []byte) error {
@@ -88,10 +74,5 @@ func (h *OrderInspectHandlers) FindOrdersByInvestorAddress(env rollmelette.EnvIn
 	if err != nil {
 		return fmt.Errorf("failed to find orders by investor: %w", err)
 	}
-	orders, err := json.Marshal(res)
-	if err != nil {
-		return fmt.Errorf("failed to marshal orders: %w", err)
-	}
-	env.Report(orders)
-	return nil
+	return router.Report(env, "order.list", res)
 }
@@ -0,0 +1,69 @@
+package inspect
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/configs"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/portfolio"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/router"
+	"github.com/go-playground/validator/v10"
+	"github.com/rollmelette/rollmelette"
+)
+
+type PortfolioInspectHandlers struct {
+	Config             *configs.RollupConfig
+	UserRepository     repository.UserRepository
+	IssuanceRepository repository.IssuanceRepository
+}
+
+func NewPortfolioInspectHandlers(
+	cfg *configs.RollupConfig,
+	userRepo repository.UserRepository,
+	issuanceRepo repository.IssuanceRepository,
+) *PortfolioInspectHandlers {
+	return &PortfolioInspectHandlers{
+		Config:             cfg,
+		UserRepository:     userRepo,
+		IssuanceRepository: issuanceRepo,
+	}
+}
+
+func (h *PortfolioInspectHandlers) FindInvestorPortfolio(env rollmelette.EnvInspector, payload []byte) error {
+	var input portfolio.FindInvestorPortfolioInputDTO
+	if err := json.Unmarshal(payload, &input); err != nil {
+		return fmt.Errorf("failed to unmarshal input: %w", err)
+	}
+
+	validator := validator.New()
+	if err := validator.Struct(input); err != nil {
+		return fmt.Errorf("failed to validate input: %w", err)
+	}
+
+	findInvestorPortfolio := portfolio.NewFindInvestorPortfolioUseCase(h.UserRepository, h.IssuanceRepository)
+	res, err := findInvestorPortfolio.Execute(&input)
+	if err != nil {
+		return fmt.Errorf("failed to find investor portfolio: %w", err)
+	}
+	return router.Report(env, "portfolio.investor", res)
+}
+
+func (h *PortfolioInspectHandlers) FindCreatorDashboard(env rollmelette.EnvInspector, payload []byte) error {
+	var input portfolio.FindCreatorDashboardInputDTO
+	if err := json.Unmarshal(payload, &input); err != nil {
+		return fmt.Errorf("failed to unmarshal input: %w", err)
+	}
+
+	validator := validator.New()
+	if err := validator.Struct(input); err != nil {
+		return fmt.Errorf("failed to validate input: %w", err)
+	}
+
+	findCreatorDashboard := portfolio.NewFindCreatorDashboardUseCase(h.UserRepository, h.IssuanceRepository, h.Config.IssuanceFee)
+	res, err := findCreatorDashboard.Execute(&input)
+	if err != nil {
+		return fmt.Errorf("failed to find creator dashboard: %w", err)
+	}
+	return router.Report(env, "portfolio.creator", res)
+}
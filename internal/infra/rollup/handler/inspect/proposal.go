@@ -0,0 +1,52 @@
+package inspect
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/proposal"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/router"
+	"github.com/go-playground/validator/v10"
+	"github.com/rollmelette/rollmelette"
+)
+
+type ProposalInspectHandlers struct {
+	ProposalRepository repository.ProposalRepository
+}
+
+func NewProposalInspectHandlers(
+	proposalRepo repository.ProposalRepository,
+) *ProposalInspectHandlers {
+	return &ProposalInspectHandlers{
+		ProposalRepository: proposalRepo,
+	}
+}
+
+func (h *ProposalInspectHandlers) FindProposalById(env rollmelette.EnvInspector, payload []byte) error {
+	var input proposal.FindProposalByIdInputDTO
+	if err := json.Unmarshal(payload, &input); err != nil {
+		return fmt.Errorf("failed to unmarshal input: %w", err)
+	}
+
+	validator := validator.New()
+	if err := validator.Struct(input); err != nil {
+		return fmt.Errorf("failed to validate input: %w", err)
+	}
+
+	findProposalById := proposal.NewFindProposalByIdUseCase(h.ProposalRepository)
+	res, err := findProposalById.Execute(&input)
+	if err != nil {
+		return fmt.Errorf("failed to find proposal: %w", err)
+	}
+	return router.Report(env, "proposal", res)
+}
+
+func (h *ProposalInspectHandlers) FindAllProposals(env rollmelette.EnvInspector, payload []byte) error {
+	findAllProposals := proposal.NewFindAllProposalsUseCase(h.ProposalRepository)
+	res, err := findAllProposals.Execute()
+	if err != nil {
+		return fmt.Errorf("failed to find all proposals: %w", err)
+	}
+	return router.Report(env, "proposal.list", res)
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/social_account"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/router"
 	"github.com/go-playground/validator/v10"
 	"github.com/rollmelette/rollmelette"
 )
@@ -38,12 +39,7 @@ func (h *SocialAccountInspectHandlers) FindSocialAccountById(env rollmelette.Env
 	if err != nil {
 		return fmt.Errorf("failed to find social account: %w", err)
 	}
-	socialAccount, err := json.Marshal(res)
-	if err != nil {
-		return fmt.Errorf("")
-	}
-	env.Report(socialAccount)
-	return nil
+	return router.Report(env, "social_account", res)
 }
 
 func (h *SocialAccountInspectHandlers) FindSocialAccountsByUserId(env rollmelette.EnvInspector, payload []byte) error {
@@ -62,10 +58,33 @@ func (h *SocialAccountInspectHandlers) FindSocialAccountsByUserId(env rollmelett
 	if err != nil {
 		return fmt.Errorf("failed to find social accounts: %w", err)
 	}
-	socialAccounts, err := json.Marshal(res)
+	return router.Report(env, "social_account.list", res)
+}
+
+func (h *SocialAccountInspectHandlers) FindSocialAccountChallengeById(env rollmelette.EnvInspector, payload []byte) error {
+	var input social_account.FindSocialAccountChallengeByIdInputDTO
+	if err := json.Unmarshal(payload, &input); err != nil {
+		return fmt.Errorf("failed to unmarshal input: %w", err)
+	}
+
+	validator := validator.New()
+	if err := validator.Struct(input); err != nil {
+		return fmt.Errorf("failed to validate input: %w", err)
+	}
+
+	findSocialAccountChallengeById := social_account.NewFindSocialAccountChallengeByIdUseCase(h.SocialAccountRepository)
+	res, err := findSocialAccountChallengeById.Execute(&input)
+	if err != nil {
+		return fmt.Errorf("failed to find social account challenge: %w", err)
+	}
+	return router.Report(env, "social_account.challenge", res)
+}
+
+func (h *SocialAccountInspectHandlers) FindAllPlatforms(env rollmelette.EnvInspector, payload []byte) error {
+	findAllPlatforms := social_account.NewFindAllPlatformsUseCase(h.SocialAccountRepository)
+	res, err := findAllPlatforms.Execute()
 	if err != nil {
-		return fmt.Errorf("failed to marshal social accounts: %w", err)
+		return fmt.Errorf("failed to find platforms: %w", err)
 	}
-	env.Report(socialAccounts)
-	return nil
+	return router.Report(env, "social_platform.list", res)
 }
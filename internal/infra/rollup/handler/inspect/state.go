@@ -0,0 +1,50 @@
+package inspect
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/state"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/router"
+	"github.com/go-playground/validator/v10"
+	"github.com/rollmelette/rollmelette"
+)
+
+type StateInspectHandlers struct {
+	StateRepository repository.StateRepository
+}
+
+func NewStateInspectHandlers(stateRepo repository.StateRepository) *StateInspectHandlers {
+	return &StateInspectHandlers{
+		StateRepository: stateRepo,
+	}
+}
+
+func (h *StateInspectHandlers) FindStateRoot(env rollmelette.EnvInspector, payload []byte) error {
+	findStateRoot := state.NewFindStateRootUseCase(h.StateRepository)
+	res, err := findStateRoot.Execute()
+	if err != nil {
+		return fmt.Errorf("failed to find state root: %w", err)
+	}
+	return router.Report(env, "state.root", res)
+}
+
+func (h *StateInspectHandlers) FindStateProof(env rollmelette.EnvInspector, payload []byte) error {
+	var input state.FindStateProofInputDTO
+	if err := json.Unmarshal(payload, &input); err != nil {
+		return fmt.Errorf("failed to unmarshal input: %w", err)
+	}
+
+	validator := validator.New()
+	if err := validator.Struct(input); err != nil {
+		return fmt.Errorf("failed to validate input: %w", err)
+	}
+
+	findStateProof := state.NewFindStateProofUseCase(h.StateRepository)
+	res, err := findStateProof.Execute(&input)
+	if err != nil {
+		return fmt.Errorf("failed to find state proof: %w", err)
+	}
+	return router.Report(env, "state.proof", res)
+}
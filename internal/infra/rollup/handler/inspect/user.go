@@ -6,20 +6,24 @@ import (
 
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/user"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/router"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/go-playground/validator/v10"
 	"github.com/rollmelette/rollmelette"
 )
 
 type UserInspectHandlers struct {
-	UserRepository repository.UserRepository
+	UserRepository            repository.UserRepository
+	AddressRotationRepository repository.AddressRotationRepository
 }
 
 func NewUserInspectHandlers(
 	userRepo repository.UserRepository,
+	addressRotationRepo repository.AddressRotationRepository,
 ) *UserInspectHandlers {
 	return &UserInspectHandlers{
-		UserRepository: userRepo,
+		UserRepository:            userRepo,
+		AddressRotationRepository: addressRotationRepo,
 	}
 }
 
@@ -39,12 +43,7 @@ func (h *UserInspectHandlers) FindUserByAddress(env rollmelette.EnvInspector, pa
 	if err != nil {
 		return fmt.Errorf("failed to find User: %w", err)
 	}
-	User, err := json.Marshal(res)
-	if err != nil {
-		return fmt.Errorf("failed to marshal User: %w", err)
-	}
-	env.Report(User)
-	return nil
+	return router.Report(env, "user", res)
 }
 
 func (h *UserInspectHandlers) FindAllUsers(env rollmelette.EnvInspector, payload []byte) error {
@@ -54,12 +53,7 @@ func (h *UserInspectHandlers) FindAllUsers(env rollmelette.EnvInspector, payload
 	if err != nil {
 		return fmt.Errorf("failed to find all Users: %w", err)
 	}
-	allUsers, err := json.Marshal(res)
-	if err != nil {
-		return fmt.Errorf("failed to marshal all Users: %w", err)
-	}
-	env.Report(allUsers)
-	return nil
+	return router.Report(env, "user.list", res)
 }
 
 func (h *UserInspectHandlers) ERC20BalanceOf(env rollmelette.EnvInspector, payload []byte) error {
@@ -85,12 +79,24 @@ func (h *UserInspectHandlers) ERC20BalanceOf(env rollmelette.EnvInspector, paylo
 		common.Address(input.Token),
 		common.Address(res.Address),
 	).String()
+	return router.Report(env, "user.balance", balance)
+}
 
-	balanceBytes, err := json.Marshal(balance)
-	if err != nil {
-		return fmt.Errorf("failed to marshal balance: %w", err)
+func (h *UserInspectHandlers) FindAddressRotationById(env rollmelette.EnvInspector, payload []byte) error {
+	var input user.FindAddressRotationByIdInputDTO
+	if err := json.Unmarshal(payload, &input); err != nil {
+		return fmt.Errorf("failed to unmarshal input: %w", err)
 	}
 
-	env.Report(balanceBytes)
-	return nil
+	validator := validator.New()
+	if err := validator.Struct(input); err != nil {
+		return fmt.Errorf("failed to validate input: %w", err)
+	}
+
+	findAddressRotationById := user.NewFindAddressRotationByIdUseCase(h.AddressRotationRepository)
+	res, err := findAddressRotationById.Execute(&input)
+	if err != nil {
+		return fmt.Errorf("failed to find address rotation: %w", err)
+	}
+	return router.Report(env, "address_rotation", res)
 }
@@ -0,0 +1,79 @@
+package middleware
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/genesis"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/router"
+	"github.com/rollmelette/rollmelette"
+)
+
+// GenesisMiddleware applies the genesis file before the first advance input is parsed, with
+// the timestamp of that input, so every node derives the same initial state whatever the
+// input holds. The genesis is committed in its own transaction and is kept even if the input
+// is rejected.
+type GenesisMiddleware struct {
+	Genesis                 *genesis.File
+	TransactionRepository   repository.TransactionRepository
+	GenesisRepository       repository.GenesisRepository
+	UserRepository          repository.UserRepository
+	SocialAccountRepository repository.SocialAccountRepository
+}
+
+func NewGenesisMiddleware(
+	file *genesis.File,
+	transactionRepo repository.TransactionRepository,
+	genesisRepo repository.GenesisRepository,
+	userRepo repository.UserRepository,
+	socialAccountRepo repository.SocialAccountRepository,
+) *GenesisMiddleware {
+	return &GenesisMiddleware{
+		Genesis:                 file,
+		TransactionRepository:   transactionRepo,
+		GenesisRepository:       genesisRepo,
+		UserRepository:          userRepo,
+		SocialAccountRepository: socialAccountRepo,
+	}
+}
+
+// Apply is a router.Middleware for router.UseInput.
+func (m *GenesisMiddleware) Apply(handler any) any {
+	h, ok := handler.(router.AdvanceHandlerFunc)
+	if !ok {
+		return handler
+	}
+	return router.AdvanceHandlerFunc(func(env rollmelette.Env, metadata rollmelette.Metadata, deposit rollmelette.Deposit, payload []byte) error {
+		if err := m.apply(metadata); err != nil {
+			index := metadata.Index
+			router.ReportError(env, &index, nil, err)
+			return err
+		}
+		return h(env, metadata, deposit, payload)
+	})
+}
+
+func (m *GenesisMiddleware) apply(metadata rollmelette.Metadata) error {
+	if err := m.TransactionRepository.BeginTransaction(); err != nil {
+		return err
+	}
+	applyGenesis := genesis.NewApplyGenesisUseCase(m.GenesisRepository, m.UserRepository, m.SocialAccountRepository)
+	res, err := applyGenesis.Execute(m.Genesis, metadata)
+	switch {
+	case errors.Is(err, entity.ErrGenesisAlreadyApplied):
+		return m.TransactionRepository.RollbackTransaction()
+	case err != nil:
+		if rollbackErr := m.TransactionRepository.RollbackTransaction(); rollbackErr != nil {
+			return fmt.Errorf("failed to apply genesis: %w (%v)", err, rollbackErr)
+		}
+		return fmt.Errorf("failed to apply genesis: %w", err)
+	}
+	if err := m.TransactionRepository.CommitTransaction(); err != nil {
+		return err
+	}
+	slog.Info("Applied genesis", "hash", res.Hash, "users", res.Users, "input_index", res.InputIndex)
+	return nil
+}
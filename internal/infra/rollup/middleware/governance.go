@@ -0,0 +1,144 @@
+package middleware
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/configs"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/proposal"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/router"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-playground/validator/v10"
+	"github.com/rollmelette/rollmelette"
+)
+
+// GovernanceFactory turns the advance routes listed in GOVERNANCE_ROUTES into M-of-N admin
+// proposals. A governed input creates a proposal approved by its sender, and the input
+// runs once GOVERNANCE_THRESHOLD admins approved it, before it expires.
+type GovernanceFactory struct {
+	Config             *configs.RollupConfig
+	ProposalRepository repository.ProposalRepository
+	UserRepository     repository.UserRepository
+	handlers           map[string]governedHandler
+}
+
+// governedHandler is the handler of a governed route, run when a proposal is executed, and
+// the permissions the route requires from the proposer.
+type governedHandler struct {
+	handler     router.AdvanceHandlerFunc
+	permissions []entity.Permission
+}
+
+func NewGovernanceFactory(
+	cfg *configs.RollupConfig,
+	proposalRepo repository.ProposalRepository,
+	userRepo repository.UserRepository,
+) *GovernanceFactory {
+	return &GovernanceFactory{
+		Config:             cfg,
+		ProposalRepository: proposalRepo,
+		UserRepository:     userRepo,
+		handlers:           make(map[string]governedHandler),
+	}
+}
+
+// Create is a router.RouteMiddleware. It leaves the route untouched when the route is not
+// governed or when a single approval is enough.
+func (f *GovernanceFactory) Create(route *router.Route) router.Middleware {
+	if route.Kind != router.RouteKindAdvance || f.Config.GovernanceThreshold <= 1 {
+		return nil
+	}
+	if !slices.Contains(f.Config.GovernanceRoutes, route.Path) {
+		return nil
+	}
+	return func(handler any) any {
+		h, ok := handler.(router.AdvanceHandlerFunc)
+		if !ok {
+			return handler
+		}
+		permissions := make([]entity.Permission, len(route.Permissions))
+		for i, permission := range route.Permissions {
+			permissions[i] = entity.Permission(permission)
+		}
+		f.handlers[route.Path] = governedHandler{handler: h, permissions: permissions}
+		return router.AdvanceHandlerFunc(func(env rollmelette.Env, metadata rollmelette.Metadata, deposit rollmelette.Deposit, payload []byte) error {
+			if deposit != nil {
+				return fmt.Errorf("%w: governed route %s does not accept deposits", entity.ErrInvalidProposal, route.Path)
+			}
+
+			createProposal := proposal.NewCreateProposalUseCase(f.ProposalRepository)
+			res, err := createProposal.Execute(&proposal.CreateProposalInputDTO{
+				Path:      route.Path,
+				Data:      json.RawMessage(payload),
+				Threshold: uint(f.Config.GovernanceThreshold),
+				Ttl:       int64(f.Config.GovernanceProposalTtl.Seconds()),
+			}, metadata)
+			if err != nil {
+				return fmt.Errorf("failed to create proposal: %w", err)
+			}
+
+			return router.Notice(env, metadata, "proposal.created", res)
+		})
+	}
+}
+
+// ApproveProposal is the advance handler admins use to approve a proposal. The approval
+// reaching the threshold runs the proposed input as if sent by the proposer, who must still
+// hold the permissions of the route. The input is rejected if it leaves fewer active admins
+// than the threshold, since no later proposal could be approved.
+func (f *GovernanceFactory) ApproveProposal(env rollmelette.Env, metadata rollmelette.Metadata, deposit rollmelette.Deposit, payload []byte) error {
+	var input proposal.ApproveProposalInputDTO
+	if err := json.Unmarshal(payload, &input); err != nil {
+		return fmt.Errorf("failed to unmarshal input: %w", err)
+	}
+
+	validator := validator.New()
+	if err := validator.Struct(input); err != nil {
+		return fmt.Errorf("failed to validate input: %w", err)
+	}
+
+	approveProposal := proposal.NewApproveProposalUseCase(f.ProposalRepository, f.UserRepository)
+	res, err := approveProposal.Execute(&input, metadata, func(p *entity.Proposal) error {
+		governed, exists := f.handlers[p.Path]
+		if !exists {
+			return fmt.Errorf("%w for path: %s", router.ErrRouteNotFound, p.Path)
+		}
+		if err := checkPermissions(f.UserRepository, p.Proposer, governed.permissions); err != nil {
+			return err
+		}
+		proposerMetadata := metadata
+		proposerMetadata.MsgSender = common.Address(p.Proposer)
+		if err := governed.handler(env, proposerMetadata, nil, []byte(p.Payload)); err != nil {
+			return err
+		}
+		return f.ensureAdminsReachThreshold(p)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to approve proposal: %w", err)
+	}
+
+	if res.State == string(entity.ProposalStateExecuted) {
+		return router.Notice(env, metadata, "proposal.executed", res)
+	}
+	return router.Notice(env, metadata, "proposal.approved", res)
+}
+
+func (f *GovernanceFactory) ensureAdminsReachThreshold(p *entity.Proposal) error {
+	admins, err := f.UserRepository.FindUsersByRole(string(entity.UserRoleAdmin))
+	if err != nil {
+		return err
+	}
+	var active uint64
+	for _, admin := range admins {
+		if admin.Active() {
+			active++
+		}
+	}
+	if active < f.Config.GovernanceThreshold {
+		return entity.ErrAdminsBelowThreshold.New(p.Id, active, f.Config.GovernanceThreshold)
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package middleware
+
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/invariant"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/router"
+	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+	"github.com/holiman/uint256"
+	"github.com/rollmelette/rollmelette"
+)
+
+// InvariantsMiddleware checks the global invariants after every successful advance input, and
+// the invariants of every issuance the input wrote. An input that leaves them violated is
+// rejected, so the machine reverts it, after reporting every violation.
+type InvariantsMiddleware struct {
+	IssuanceRepository repository.IssuanceRepository
+	OrderRepository    repository.OrderRepository
+	LedgerRepository   repository.LedgerRepository
+	StateRepository    repository.StateRepository
+}
+
+func NewInvariantsMiddleware(
+	issuanceRepo repository.IssuanceRepository,
+	orderRepo repository.OrderRepository,
+	ledgerRepo repository.LedgerRepository,
+	stateRepo repository.StateRepository,
+) *InvariantsMiddleware {
+	return &InvariantsMiddleware{
+		IssuanceRepository: issuanceRepo,
+		OrderRepository:    orderRepo,
+		LedgerRepository:   ledgerRepo,
+		StateRepository:    stateRepo,
+	}
+}
+
+// Check is a router.Middleware. It leaves inspect handlers untouched.
+func (m *InvariantsMiddleware) Check(handler any) any {
+	h, ok := handler.(router.AdvanceHandlerFunc)
+	if !ok {
+		return handler
+	}
+	return router.AdvanceHandlerFunc(func(env rollmelette.Env, metadata rollmelette.Metadata, deposit rollmelette.Deposit, payload []byte) error {
+		if err := h(env, metadata, deposit, payload); err != nil {
+			return err
+		}
+
+		appAddress := env.AppAddress()
+		balances := make(map[Address]*uint256.Int)
+		for _, token := range env.ERC20Tokens() {
+			balances[Address(token)] = uint256.MustFromBig(env.ERC20BalanceOf(token, appAddress))
+		}
+
+		checkInvariants := invariant.NewCheckInvariantsUseCase(m.IssuanceRepository, m.OrderRepository, m.LedgerRepository)
+		violations, err := checkInvariants.Execute(&invariant.CheckInvariantsInputDTO{
+			Balances:    balances,
+			IssuanceIds: m.StateRepository.FindTouchedIssuanceIds(),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to check invariants: %w", err)
+		}
+		if len(violations) == 0 {
+			return nil
+		}
+
+		for _, violation := range violations {
+			slog.Error("invariant violated",
+				"input_index", metadata.Index,
+				"invariant", violation.Invariant,
+				"token", violation.Token,
+				"issuance_id", violation.IssuanceId,
+				"expected", violation.Expected,
+				"actual", violation.Actual,
+			)
+		}
+		if err := router.Report(env, "invariant.violations", violations); err != nil {
+			return err
+		}
+		first := violations[0]
+		return domain.ErrInvariantViolated.New(first.Invariant, first.Token, first.Expected, first.Actual)
+	})
+}
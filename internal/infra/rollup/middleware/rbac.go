@@ -1,16 +1,21 @@
 package middleware
 
 import (
-	"fmt"
+	"strings"
 
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
-	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/user"
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/router"
 	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
-	"github.com/ethereum/go-ethereum/common"
 	"github.com/rollmelette/rollmelette"
 )
 
+var (
+	ErrPermissionDenied = domain.ErrPermissionDenied
+	ErrUserDeactivated  = domain.ErrUserDeactivated
+)
+
 type RBACFactory struct {
 	UserRepository repository.UserRepository
 }
@@ -23,7 +28,20 @@ func NewRBACFactory(
 	}
 }
 
-func (f *RBACFactory) Create(roles []string) router.Middleware {
+// Require returns a route option recording the permissions in the route metadata and
+// rejecting advance inputs whose sender lacks any of them. Inspects are not checked.
+func (f *RBACFactory) Require(permissions ...entity.Permission) router.RouteOption {
+	names := make([]string, len(permissions))
+	for i, permission := range permissions {
+		names[i] = string(permission)
+	}
+	return func(route *router.Route) {
+		router.Permissions(names...)(route)
+		router.With(f.Create(permissions))(route)
+	}
+}
+
+func (f *RBACFactory) Create(permissions []entity.Permission) router.Middleware {
 	return func(handler any) any {
 		switch h := handler.(type) {
 		case router.AdvanceHandlerFunc:
@@ -38,27 +56,10 @@ func (f *RBACFactory) Create(roles []string) router.Middleware {
 					address = Address(metadata.MsgSender)
 				}
 
-				// Find user and check roles
-				findUserByAddress := user.NewFindUserByAddressUseCase(f.UserRepository)
-				user, err := findUserByAddress.Execute(&user.FindUserByAddressInputDTO{
-					Address: address,
-				})
-				if err != nil {
+				if err := checkPermissions(f.UserRepository, address, permissions); err != nil {
 					return err
 				}
 
-				// Check if user has any of the required roles
-				var hasRole bool
-				for _, role := range roles {
-					if user.Role == role {
-						hasRole = true
-						break
-					}
-				}
-				if !hasRole {
-					return fmt.Errorf("user %s lacks required permissions: %v", common.Address(user.Address), roles)
-				}
-
 				return h(env, metadata, deposit, payload)
 			})
 		case router.InspectHandlerFunc:
@@ -69,18 +70,24 @@ func (f *RBACFactory) Create(roles []string) router.Middleware {
 	}
 }
 
-func (f *RBACFactory) AdminOnly() router.Middleware {
-	return f.Create([]string{"admin"})
-}
-
-func (f *RBACFactory) VerifierOnly() router.Middleware {
-	return f.Create([]string{"verifier"})
-}
-
-func (f *RBACFactory) InvestorOnly() router.Middleware {
-	return f.Create([]string{"investor"})
-}
+// checkPermissions fails unless the user at address is active and has every permission.
+func checkPermissions(userRepo repository.UserRepository, address Address, permissions []entity.Permission) error {
+	user, err := userRepo.FindUserByAddress(address)
+	if err != nil {
+		return err
+	}
+	if !user.Active() {
+		return ErrUserDeactivated.New(user.Address)
+	}
 
-func (f *RBACFactory) CreatorOnly() router.Middleware {
-	return f.Create([]string{"creator"})
+	var missing []string
+	for _, permission := range permissions {
+		if !user.HasPermission(permission) {
+			missing = append(missing, string(permission))
+		}
+	}
+	if len(missing) > 0 {
+		return ErrPermissionDenied.New(user.Address, strings.Join(missing, ", "))
+	}
+	return nil
 }
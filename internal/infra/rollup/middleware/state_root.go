@@ -0,0 +1,43 @@
+package middleware
+
+import (
+	"fmt"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/state"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/router"
+	"github.com/rollmelette/rollmelette"
+)
+
+// StateRootMiddleware updates the state commitment after every successful advance input and
+// emits its root as the last notice of the input, so the root can be proven on L1 through the
+// output proof of the notice.
+type StateRootMiddleware struct {
+	StateRepository repository.StateRepository
+}
+
+func NewStateRootMiddleware(stateRepo repository.StateRepository) *StateRootMiddleware {
+	return &StateRootMiddleware{
+		StateRepository: stateRepo,
+	}
+}
+
+// Commit is a router.Middleware. It leaves inspect handlers untouched.
+func (m *StateRootMiddleware) Commit(handler any) any {
+	h, ok := handler.(router.AdvanceHandlerFunc)
+	if !ok {
+		return handler
+	}
+	return router.AdvanceHandlerFunc(func(env rollmelette.Env, metadata rollmelette.Metadata, deposit rollmelette.Deposit, payload []byte) error {
+		if err := h(env, metadata, deposit, payload); err != nil {
+			return err
+		}
+
+		updateStateRoot := state.NewUpdateStateRootUseCase(m.StateRepository)
+		res, err := updateStateRoot.Execute()
+		if err != nil {
+			return fmt.Errorf("failed to update state root: %w", err)
+		}
+		return router.Notice(env, metadata, "state.root", res)
+	})
+}
@@ -0,0 +1,49 @@
+package middleware
+
+import (
+	"fmt"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/router"
+	"github.com/rollmelette/rollmelette"
+)
+
+// TransactionMiddleware runs every advance input in a database transaction. It commits the
+// transaction when the input is accepted and rolls it back when it is rejected, so the
+// database is reverted together with the rollup state.
+type TransactionMiddleware struct {
+	TransactionRepository repository.TransactionRepository
+}
+
+func NewTransactionMiddleware(transactionRepo repository.TransactionRepository) *TransactionMiddleware {
+	return &TransactionMiddleware{
+		TransactionRepository: transactionRepo,
+	}
+}
+
+// Wrap is a router.Middleware. It leaves inspect handlers untouched.
+func (m *TransactionMiddleware) Wrap(handler any) any {
+	h, ok := handler.(router.AdvanceHandlerFunc)
+	if !ok {
+		return handler
+	}
+	return router.AdvanceHandlerFunc(func(env rollmelette.Env, metadata rollmelette.Metadata, deposit rollmelette.Deposit, payload []byte) error {
+		if err := m.TransactionRepository.BeginTransaction(); err != nil {
+			return err
+		}
+		defer func() {
+			if p := recover(); p != nil {
+				_ = m.TransactionRepository.RollbackTransaction()
+				panic(p)
+			}
+		}()
+
+		if err := h(env, metadata, deposit, payload); err != nil {
+			if rollbackErr := m.TransactionRepository.RollbackTransaction(); rollbackErr != nil {
+				return fmt.Errorf("%w (%v)", err, rollbackErr)
+			}
+			return err
+		}
+		return m.TransactionRepository.CommitTransaction()
+	})
+}
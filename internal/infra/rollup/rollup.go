@@ -5,17 +5,34 @@ import (
 	"os"
 
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/configs"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/rollup/middleware"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/analytics"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/attestation"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/emergency_withdrawal"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/genesis"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/issuance"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/ledger"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/order"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/portfolio"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/proposal"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/social_account"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/state"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/user"
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/router"
 )
 
 type CreateInfo struct {
-	Repo   repository.Repository
-	Config *configs.RollupConfig
+	Repo    repository.Repository
+	Config  *configs.RollupConfig
+	Genesis *genesis.File
 }
 
 func Create(c *CreateInfo) *router.Router {
+	if c.Genesis.IssuanceFee != nil {
+		c.Config.IssuanceFee = *c.Genesis.IssuanceFee
+	}
 	handlers, err := NewHandlers(c.Repo, c.Config)
 	if err != nil {
 		slog.Error("Failed to initialize handlers", "error", err)
@@ -23,74 +40,280 @@ func Create(c *CreateInfo) *router.Router {
 	}
 
 	r := router.NewRouter()
+	r.UseInput(middleware.NewGenesisMiddleware(c.Genesis, c.Repo, c.Repo, c.Repo, c.Repo).Apply)
 	r.Use(router.LoggingMiddleware)
-	r.Use(router.ErrorHandlingMiddleware)
+	r.Use(router.ErrorHandlingMiddleware())
+	r.Use(middleware.NewTransactionMiddleware(c.Repo).Wrap)
+	r.Use(middleware.NewStateRootMiddleware(c.Repo).Commit)
+	r.Use(middleware.NewInvariantsMiddleware(c.Repo, c.Repo, c.Repo, c.Repo).Check)
+
+	for _, route := range ABIRoutes {
+		r.HandleABI(route.Path, route.Input)
+	}
 
 	rbacFactory := middleware.NewRBACFactory(c.Repo)
+	governanceFactory := middleware.NewGovernanceFactory(c.Config, c.Repo, c.Repo)
+	r.UseRoute(governanceFactory.Create)
 
 	orderInvestorGroup := r.Group("order")
-	orderInvestorGroup.Use(rbacFactory.InvestorOnly())
 	{
 		// restricted operations
-		orderInvestorGroup.HandleAdvance("create", handlers.OrderAdvanceHandlers.CreateOrder)
-		orderInvestorGroup.HandleAdvance("cancel", handlers.OrderAdvanceHandlers.CancelOrder)
+		orderInvestorGroup.HandleAdvance("create", handlers.OrderAdvanceHandlers.CreateOrder,
+			router.Input(order.CreateOrderInputDTO{}), rbacFactory.Require(entity.PermissionOrderCreate),
+			router.Description("Places an order on an ongoing issuance, sent with an ERC20 deposit of the order amount"))
+		orderInvestorGroup.HandleAdvance("cancel", handlers.OrderAdvanceHandlers.CancelOrder,
+			router.Input(order.CancelOrderInputDTO{}), rbacFactory.Require(entity.PermissionOrderCancel),
+			router.Description("Cancels a pending order of the sender before its issuance closes"))
 
 		// Public operations
-		orderInvestorGroup.HandleInspect("", handlers.OrderInspectHandlers.FindAllOrders)
-		orderInvestorGroup.HandleInspect("id", handlers.OrderInspectHandlers.FindOrderById)
-		orderInvestorGroup.HandleInspect("issuance", handlers.OrderInspectHandlers.FindBidsByIssuanceId)
-		orderInvestorGroup.HandleInspect("investor", handlers.OrderInspectHandlers.FindOrdersByInvestorAddress)
+		orderInvestorGroup.HandleInspect("", handlers.OrderInspectHandlers.FindAllOrders,
+			router.Description("Lists all orders"))
+		orderInvestorGroup.HandleInspect("id", handlers.OrderInspectHandlers.FindOrderById,
+			router.Input(order.FindOrderByIdInputDTO{}),
+			router.Description("Finds an order by id"))
+		orderInvestorGroup.HandleInspect("issuance", handlers.OrderInspectHandlers.FindBidsByIssuanceId,
+			router.Input(order.FindOrdersByIssuanceIdInputDTO{}),
+			router.Description("Lists the orders of an issuance"))
+		orderInvestorGroup.HandleInspect("investor", handlers.OrderInspectHandlers.FindOrdersByInvestorAddress,
+			router.Input(order.FindOrdersByInvestorAddressInputDTO{}),
+			router.Description("Lists the orders of an investor"))
 	}
 
 	issuanceGroup := r.Group("issuance")
 	issuanceCreatorGroup := issuanceGroup.Group("creator")
-	issuanceCreatorGroup.Use(rbacFactory.CreatorOnly())
 	{
 		// restricted operations
-		issuanceCreatorGroup.HandleAdvance("create", handlers.IssuanceAdvanceHandlers.CreateIssuance)
-		issuanceCreatorGroup.HandleAdvance("settle", handlers.IssuanceAdvanceHandlers.SettleIssuance)
+		issuanceCreatorGroup.HandleAdvance("create", handlers.IssuanceAdvanceHandlers.CreateIssuance,
+			router.Input(issuance.CreateIssuanceInputDTO{}), rbacFactory.Require(entity.PermissionIssuanceCreate),
+			router.Description("Creates an issuance, sent with an ERC20 deposit of the collateral"))
+		issuanceCreatorGroup.HandleAdvance("settle", handlers.IssuanceAdvanceHandlers.SettleIssuance,
+			router.Input(issuance.SettleIssuanceInputDTO{}), rbacFactory.Require(entity.PermissionIssuanceSettle),
+			router.Description("Settles a closed issuance, sent with an ERC20 deposit of the total obligation"))
+
+		// Public operations
+		issuanceGroup.HandleInspect("", handlers.IssuanceInspectHandlers.FindAllIssuances,
+			router.Description("Lists all issuances"))
+		issuanceGroup.HandleInspect("id", handlers.IssuanceInspectHandlers.FindIssuanceById,
+			router.Input(issuance.FindIssuanceByIdInputDTO{}),
+			router.Description("Finds an issuance by id"))
+		issuanceGroup.HandleAdvance("close", handlers.IssuanceAdvanceHandlers.CloseIssuance,
+			router.Input(issuance.CloseIssuanceInputDTO{}),
+			router.Description("Closes the expired ongoing issuance of a creator, accepting or rejecting its orders"))
+		issuanceGroup.HandleInspect("creator", handlers.IssuanceInspectHandlers.FindIssuancesByCreatorAddress,
+			router.Input(issuance.FindIssuancesByCreatorAddressInputDTO{}),
+			router.Description("Lists the issuances of a creator"))
+		issuanceGroup.HandleInspect("investor", handlers.IssuanceInspectHandlers.FindIssuancesByInvestorAddress,
+			router.Input(issuance.FindIssuancesByInvestorAddressInputDTO{}),
+			router.Description("Lists the issuances an investor placed orders on"))
+		issuanceGroup.HandleAdvance("execute-collateral", handlers.IssuanceAdvanceHandlers.ExecuteIssuanceCollateral,
+			router.Input(issuance.ExecuteIssuanceCollateralInputDTO{}),
+			router.Description("Distributes the collateral of a matured issuance that was not settled"))
+	}
+
+	portfolioGroup := r.Group("portfolio")
+	{
+		// Public operations
+		portfolioGroup.HandleInspect("investor", handlers.PortfolioInspectHandlers.FindInvestorPortfolio,
+			router.Input(portfolio.FindInvestorPortfolioInputDTO{}),
+			router.Description("Summarizes the positions of an investor with expected payouts and totals per token"))
+		portfolioGroup.HandleInspect("creator", handlers.PortfolioInspectHandlers.FindCreatorDashboard,
+			router.Input(portfolio.FindCreatorDashboardInputDTO{}),
+			router.Description("Schedules the obligations of a creator by maturity with the deposit that settles each one"))
+	}
+
+	analyticsGroup := r.Group("analytics")
+	{
+		// Public operations
+		analyticsGroup.HandleInspect("summary", handlers.AnalyticsInspectHandlers.FindAnalyticsSummary,
+			router.Description("Summarizes the platform: volume raised and fees per token, average clearing rate, default rate and investors"))
+		analyticsGroup.HandleInspect("series", handlers.AnalyticsInspectHandlers.FindAnalyticsSeries,
+			router.Input(analytics.FindAnalyticsSeriesInputDTO{}),
+			router.Description("Buckets issuances, orders, investors and order volume daily or weekly by creation time"))
+	}
+
+	ledgerGroup := r.Group("ledger")
+	{
+		// Public operations
+		ledgerGroup.HandleInspect("entries", handlers.LedgerInspectHandlers.FindLedgerEntries,
+			router.Input(ledger.FindLedgerEntriesInputDTO{}),
+			router.Description("Lists the ledger entries of every ERC20 transfer, optionally by issuance or account"))
+		ledgerGroup.HandleInspect("reconciliation", handlers.LedgerInspectHandlers.ReconcileLedger,
+			router.Description("Checks the ledger balances of the application address against its wallet balances"))
+	}
 
+	genesisGroup := r.Group("genesis")
+	{
+		// Public operations
+		genesisGroup.HandleInspect("", handlers.GenesisInspectHandlers.FindGenesis,
+			router.Description("Returns the genesis applied by the first input and the tokens it allows, empty when every token is allowed"))
+	}
+
+	stateGroup := r.Group("state")
+	{
 		// Public operations
-		issuanceGroup.HandleInspect("", handlers.IssuanceInspectHandlers.FindAllIssuances)
-		issuanceGroup.HandleInspect("id", handlers.IssuanceInspectHandlers.FindIssuanceById)
-		issuanceGroup.HandleAdvance("close", handlers.IssuanceAdvanceHandlers.CloseIssuance)
-		issuanceGroup.HandleInspect("creator", handlers.IssuanceInspectHandlers.FindIssuancesByCreatorAddress)
-		issuanceGroup.HandleInspect("investor", handlers.IssuanceInspectHandlers.FindIssuancesByInvestorAddress)
-		issuanceGroup.HandleAdvance("execute-collateral", handlers.IssuanceAdvanceHandlers.ExecuteIssuanceCollateral)
+		stateGroup.HandleInspect("root", handlers.StateInspectHandlers.FindStateRoot,
+			router.Description("Returns the root of the Merkle tree committing to every row of the state"))
+		stateGroup.HandleInspect("proof", handlers.StateInspectHandlers.FindStateProof,
+			router.Input(state.FindStateProofInputDTO{}),
+			router.Description("Returns a row of the state with its inclusion proof against the state root"))
 	}
 
 	userGroup := r.Group("user")
 	adminUserGroup := userGroup.Group("admin")
-	adminUserGroup.Use(rbacFactory.AdminOnly())
 	{
 		// restricted operations
-		adminUserGroup.HandleAdvance("create", handlers.UserAdvanceHandlers.CreateUser)
-		adminUserGroup.HandleAdvance("delete", handlers.UserAdvanceHandlers.DeleteUser)
-		adminUserGroup.HandleAdvance("emergency-erc20-withdraw", handlers.EmergencyAdvanceHandlers.EmergencyERC20Withdraw)
-		adminUserGroup.HandleAdvance("emergency-ether-withdraw", handlers.EmergencyAdvanceHandlers.EmergencyEtherWithdraw)
+		adminUserGroup.HandleAdvance("create", handlers.UserAdvanceHandlers.CreateUser,
+			router.Input(user.CreateUserInputDTO{}), rbacFactory.Require(entity.PermissionUserManage),
+			router.Description("Creates a user with the given role"))
+		adminUserGroup.HandleAdvance("delete", handlers.UserAdvanceHandlers.DeleteUser,
+			router.Input(user.DeleteUserInputDTO{}), rbacFactory.Require(entity.PermissionUserManage),
+			router.Description("Deactivates a user without open positions, keeping its records"))
+		adminUserGroup.HandleAdvance("reactivate", handlers.UserAdvanceHandlers.ReactivateUser,
+			router.Input(user.ReactivateUserInputDTO{}), rbacFactory.Require(entity.PermissionUserManage),
+			router.Description("Reactivates a deactivated user"))
+		adminUserGroup.HandleAdvance("emergency-erc20-withdraw", handlers.EmergencyAdvanceHandlers.EmergencyERC20Withdraw,
+			router.Input(user.EmergencyERC20WithdrawInputDTO{}), rbacFactory.Require(entity.PermissionEmergencyWithdraw),
+			router.Description("Queues the withdrawal of the whole application balance of an ERC20 token"))
+		adminUserGroup.HandleAdvance("emergency-ether-withdraw", handlers.EmergencyAdvanceHandlers.EmergencyEtherWithdraw,
+			router.Input(user.EmergencyEtherWithdrawInputDTO{}), rbacFactory.Require(entity.PermissionEmergencyWithdraw),
+			router.Description("Queues the withdrawal of the whole application Ether balance"))
+		adminUserGroup.HandleAdvance("grant-role", handlers.UserAdvanceHandlers.GrantUserRole,
+			router.Input(user.GrantUserRoleInputDTO{}), rbacFactory.Require(entity.PermissionRoleManage),
+			router.Description("Grants a role to a user"))
+		adminUserGroup.HandleAdvance("revoke-role", handlers.UserAdvanceHandlers.RevokeUserRole,
+			router.Input(user.RevokeUserRoleInputDTO{}), rbacFactory.Require(entity.PermissionRoleManage),
+			router.Description("Revokes a role from a user, who must keep at least one role"))
+		adminUserGroup.HandleAdvance("update-role", handlers.UserAdvanceHandlers.UpdateUserRole,
+			router.Input(user.UpdateUserRoleInputDTO{}), rbacFactory.Require(entity.PermissionRoleManage),
+			router.Description("Replaces the roles of a user with the given role"))
+		adminUserGroup.HandleAdvance("approve-rotation", handlers.UserAdvanceHandlers.ApproveAddressRotation,
+			router.Input(user.ApproveAddressRotationInputDTO{}), rbacFactory.Require(entity.PermissionUserManage),
+			router.Description("Approves an accepted address rotation and moves the user to the new address"))
 
 		// Public operations
-		userGroup.HandleInspect("", handlers.UserInspectHandlers.FindAllUsers)
-		userGroup.HandleInspect("address", handlers.UserInspectHandlers.FindUserByAddress)
-		userGroup.HandleInspect("balance", handlers.UserInspectHandlers.ERC20BalanceOf)
-		userGroup.HandleAdvance("withdraw", handlers.UserAdvanceHandlers.ERC20Withdraw)
+		userGroup.HandleInspect("", handlers.UserInspectHandlers.FindAllUsers,
+			router.Description("Lists all users"))
+		userGroup.HandleInspect("address", handlers.UserInspectHandlers.FindUserByAddress,
+			router.Input(user.FindUserByAddressInputDTO{}),
+			router.Description("Finds a user by address"))
+		userGroup.HandleInspect("balance", handlers.UserInspectHandlers.ERC20BalanceOf,
+			router.Input(user.BalanceOfInputDTO{}),
+			router.Description("Returns the ERC20 balance of an address in the application wallet"))
+		userGroup.HandleAdvance("withdraw", handlers.UserAdvanceHandlers.ERC20Withdraw,
+			router.Input(user.WithdrawInputDTO{}),
+			router.Description("Withdraws ERC20 tokens from the application wallet of the sender"))
+	}
+
+	rotationGroup := userGroup.Group("rotation")
+	{
+		rotationGroup.HandleAdvance("propose", handlers.UserAdvanceHandlers.ProposeAddressRotation,
+			router.Input(user.ProposeAddressRotationInputDTO{}),
+			router.Description("Proposes moving the sender to a new address"))
+		rotationGroup.HandleAdvance("accept", handlers.UserAdvanceHandlers.AcceptAddressRotation,
+			router.Input(user.AcceptAddressRotationInputDTO{}),
+			router.Description("Accepts an address rotation from the new address"))
+		rotationGroup.HandleAdvance("cancel", handlers.UserAdvanceHandlers.CancelAddressRotation,
+			router.Input(user.CancelAddressRotationInputDTO{}),
+			router.Description("Cancels a pending address rotation from the old address"))
+		rotationGroup.HandleInspect("id", handlers.UserInspectHandlers.FindAddressRotationById,
+			router.Input(user.FindAddressRotationByIdInputDTO{}),
+			router.Description("Finds an address rotation by id"))
 	}
 
 	socialGroup := r.Group("social")
 
 	verifierGroup := socialGroup.Group("verifier")
-	verifierGroup.Use(rbacFactory.VerifierOnly())
 
 	socialAdminGroup := socialGroup.Group("admin")
-	socialAdminGroup.Use(rbacFactory.AdminOnly())
 	{
 		// restricted operations
-		verifierGroup.HandleAdvance("create", handlers.SocialAccountsHandlers.CreateSocialAccount)
-		socialAdminGroup.HandleAdvance("delete", handlers.SocialAccountsHandlers.DeleteSocialAccount)
+		verifierGroup.HandleAdvance("create", handlers.SocialAccountsHandlers.CreateSocialAccount,
+			router.Input(social_account.CreateSocialAccountInputDTO{}), rbacFactory.Require(entity.PermissionSocialVerify),
+			router.Description("Registers the social account of a challenge, given the post holding its nonce and the wallet signature of its message"))
+		socialAdminGroup.HandleAdvance("delete", handlers.SocialAccountsHandlers.DeleteSocialAccount,
+			router.Input(social_account.DeleteSocialAccountInputDTO{}), rbacFactory.Require(entity.PermissionSocialManage),
+			router.Description("Deletes a social account"))
+		socialAdminGroup.HandleAdvance("platform", handlers.SocialAccountsHandlers.UpdatePlatformSetting,
+			router.Input(social_account.UpdatePlatformSettingInputDTO{}), rbacFactory.Require(entity.PermissionSocialManage),
+			router.Description("Enables or disables a social platform for new challenges and registrations"))
+
+		// Public operations
+		socialGroup.HandleAdvance("challenge", handlers.SocialAccountsHandlers.RequestSocialAccountChallenge,
+			router.Input(social_account.RequestSocialAccountChallengeInputDTO{}),
+			router.Description("Requests a nonce to prove that the sender controls a social account"))
+		socialGroup.HandleInspect("challenge/id", handlers.SocialAccountHandlers.FindSocialAccountChallengeById,
+			router.Input(social_account.FindSocialAccountChallengeByIdInputDTO{}),
+			router.Description("Finds a social account challenge by id"))
+		socialGroup.HandleInspect("id", handlers.SocialAccountHandlers.FindSocialAccountById,
+			router.Input(social_account.FindSocialAccountByIdInputDTO{}),
+			router.Description("Finds a social account by id"))
+		socialGroup.HandleInspect("user/id", handlers.SocialAccountHandlers.FindSocialAccountsByUserId,
+			router.Input(social_account.FindSocialAccountsByUserIdInputDTO{}),
+			router.Description("Lists the social accounts of a user"))
+		socialGroup.HandleInspect("platforms", handlers.SocialAccountHandlers.FindAllPlatforms,
+			router.Description("Lists the supported social platforms, their username limits and whether they are enabled"))
+	}
+
+	attestationGroup := r.Group("attestation")
+	attestationVerifierGroup := attestationGroup.Group("verifier")
+	{
+		// restricted operations
+		attestationVerifierGroup.HandleAdvance("issue", handlers.AttestationAdvanceHandlers.IssueAttestation,
+			router.Input(attestation.IssueAttestationInputDTO{}), rbacFactory.Require(entity.PermissionAttestationIssue),
+			router.Description("Issues an attestation about a user, recording the hash of the off-chain evidence"))
+		attestationVerifierGroup.HandleAdvance("revoke", handlers.AttestationAdvanceHandlers.RevokeAttestation,
+			router.Input(attestation.RevokeAttestationInputDTO{}), rbacFactory.Require(entity.PermissionAttestationRevoke),
+			router.Description("Revokes an attestation"))
+
+		// Public operations
+		attestationGroup.HandleInspect("id", handlers.AttestationInspectHandlers.FindAttestationById,
+			router.Input(attestation.FindAttestationByIdInputDTO{}),
+			router.Description("Finds an attestation by id"))
+		attestationGroup.HandleInspect("user", handlers.AttestationInspectHandlers.FindAttestationsByUserAddress,
+			router.Input(attestation.FindAttestationsByUserAddressInputDTO{}),
+			router.Description("Lists the attestations of a user"))
+	}
+
+	emergencyGroup := r.Group("emergency")
+	emergencyAdminGroup := emergencyGroup.Group("admin")
+	{
+		// restricted operations
+		emergencyAdminGroup.HandleAdvance("cancel", handlers.EmergencyAdvanceHandlers.CancelEmergencyWithdrawal,
+			router.Input(emergency_withdrawal.CancelEmergencyWithdrawalInputDTO{}), rbacFactory.Require(entity.PermissionEmergencyCancel),
+			router.Description("Cancels a queued emergency withdrawal before it is executed"))
+
+		// Public operations
+		emergencyGroup.HandleAdvance("execute", handlers.EmergencyAdvanceHandlers.ExecuteEmergencyWithdrawal,
+			router.Input(emergency_withdrawal.ExecuteEmergencyWithdrawalInputDTO{}),
+			router.Description("Emits the voucher of a queued emergency withdrawal whose delay has passed"))
+		emergencyGroup.HandleInspect("", handlers.EmergencyWithdrawalInspectHandlers.FindAllEmergencyWithdrawals,
+			router.Description("Lists all emergency withdrawals"))
+		emergencyGroup.HandleInspect("id", handlers.EmergencyWithdrawalInspectHandlers.FindEmergencyWithdrawalById,
+			router.Input(emergency_withdrawal.FindEmergencyWithdrawalByIdInputDTO{}),
+			router.Description("Finds an emergency withdrawal by id"))
+	}
+
+	governanceGroup := r.Group("governance")
+	governanceAdminGroup := governanceGroup.Group("admin")
+	{
+		// restricted operations
+		governanceAdminGroup.HandleAdvance("approve", governanceFactory.ApproveProposal,
+			router.Input(proposal.ApproveProposalInputDTO{}), rbacFactory.Require(entity.PermissionProposalApprove),
+			router.Description("Approves a proposal, running its input once the approval threshold is reached"))
+
+		// Public operations
+		governanceGroup.HandleInspect("proposal", handlers.ProposalInspectHandlers.FindAllProposals,
+			router.Description("Lists all proposals"))
+		governanceGroup.HandleInspect("proposal/id", handlers.ProposalInspectHandlers.FindProposalById,
+			router.Input(proposal.FindProposalByIdInputDTO{}),
+			router.Description("Finds a proposal by id"))
+	}
 
+	errorGroup := r.Group("error")
+	{
 		// Public operations
-		socialGroup.HandleInspect("id", handlers.SocialAccountHandlers.FindSocialAccountById)
-		socialGroup.HandleInspect("user/id", handlers.SocialAccountHandlers.FindSocialAccountsByUserId)
+		errorGroup.HandleInspect("catalog", handlers.CatalogInspectHandlers.FindErrorCatalog,
+			router.Description("Lists every error code with its category, message template and parameters"))
 	}
 	return r
 }
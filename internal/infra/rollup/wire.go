@@ -18,6 +18,15 @@ func NewHandlers(repo repository.Repository, cfg *configs.RollupConfig) (*Handle
 		wire.Bind(new(repository.OrderRepository), new(repository.Repository)),
 		wire.Bind(new(repository.IssuanceRepository), new(repository.Repository)),
 		wire.Bind(new(repository.SocialAccountRepository), new(repository.Repository)),
+		wire.Bind(new(repository.AddressRotationRepository), new(repository.Repository)),
+		wire.Bind(new(repository.AttestationRepository), new(repository.Repository)),
+		wire.Bind(new(repository.ReputationRepository), new(repository.Repository)),
+		wire.Bind(new(repository.AnalyticsRepository), new(repository.Repository)),
+		wire.Bind(new(repository.LedgerRepository), new(repository.Repository)),
+		wire.Bind(new(repository.ProposalRepository), new(repository.Repository)),
+		wire.Bind(new(repository.EmergencyWithdrawalRepository), new(repository.Repository)),
+		wire.Bind(new(repository.StateRepository), new(repository.Repository)),
+		wire.Bind(new(repository.GenesisRepository), new(repository.Repository)),
 
 		// Advance handlers
 		advance.NewOrderAdvanceHandlers,
@@ -25,12 +34,22 @@ func NewHandlers(repo repository.Repository, cfg *configs.RollupConfig) (*Handle
 		advance.NewSocialAccountAdvanceHandlers,
 		advance.NewIssuanceAdvanceHandlers,
 		advance.NewEmergencyAdvanceHandlers,
+		advance.NewAttestationAdvanceHandlers,
 
 		// Inspect handlers
 		inspect.NewOrderInspectHandlers,
 		inspect.NewUserInspectHandlers,
 		inspect.NewSocialAccountInspectHandlers,
 		inspect.NewIssuanceInspectHandlers,
+		inspect.NewCatalogInspectHandlers,
+		inspect.NewProposalInspectHandlers,
+		inspect.NewEmergencyWithdrawalInspectHandlers,
+		inspect.NewAttestationInspectHandlers,
+		inspect.NewPortfolioInspectHandlers,
+		inspect.NewAnalyticsInspectHandlers,
+		inspect.NewLedgerInspectHandlers,
+		inspect.NewStateInspectHandlers,
+		inspect.NewGenesisInspectHandlers,
 		wire.Struct(new(Handlers), "*"),
 	)
 	return &Handlers{}, nil
@@ -39,15 +58,25 @@ func NewHandlers(repo repository.Repository, cfg *configs.RollupConfig) (*Handle
 // Handlers contains all handler dependencies
 type Handlers struct {
 	// Advance handlers
-	OrderAdvanceHandlers     *advance.OrderAdvanceHandlers
-	UserAdvanceHandlers      *advance.UserAdvanceHandlers
-	SocialAccountsHandlers   *advance.SocialAccountAdvanceHandlers
-	IssuanceAdvanceHandlers  *advance.IssuanceAdvanceHandlers
-	EmergencyAdvanceHandlers *advance.EmergencyAdvanceHandlers
+	OrderAdvanceHandlers       *advance.OrderAdvanceHandlers
+	UserAdvanceHandlers        *advance.UserAdvanceHandlers
+	SocialAccountsHandlers     *advance.SocialAccountAdvanceHandlers
+	IssuanceAdvanceHandlers    *advance.IssuanceAdvanceHandlers
+	EmergencyAdvanceHandlers   *advance.EmergencyAdvanceHandlers
+	AttestationAdvanceHandlers *advance.AttestationAdvanceHandlers
 
 	// Inspect handlers
-	OrderInspectHandlers    *inspect.OrderInspectHandlers
-	UserInspectHandlers     *inspect.UserInspectHandlers
-	SocialAccountHandlers   *inspect.SocialAccountInspectHandlers
-	IssuanceInspectHandlers *inspect.IssuanceInspectHandlers
+	OrderInspectHandlers               *inspect.OrderInspectHandlers
+	UserInspectHandlers                *inspect.UserInspectHandlers
+	SocialAccountHandlers              *inspect.SocialAccountInspectHandlers
+	IssuanceInspectHandlers            *inspect.IssuanceInspectHandlers
+	CatalogInspectHandlers             *inspect.CatalogInspectHandlers
+	ProposalInspectHandlers            *inspect.ProposalInspectHandlers
+	EmergencyWithdrawalInspectHandlers *inspect.EmergencyWithdrawalInspectHandlers
+	AttestationInspectHandlers         *inspect.AttestationInspectHandlers
+	PortfolioInspectHandlers           *inspect.PortfolioInspectHandlers
+	AnalyticsInspectHandlers           *inspect.AnalyticsInspectHandlers
+	LedgerInspectHandlers              *inspect.LedgerInspectHandlers
+	StateInspectHandlers               *inspect.StateInspectHandlers
+	GenesisInspectHandlers             *inspect.GenesisInspectHandlers
 }
@@ -16,25 +16,45 @@ import (
 // Injectors from wire.go:
 
 func NewHandlers(repo repository.Repository, cfg *configs.RollupConfig) (*Handlers, error) {
-	orderAdvanceHandlers := advance.NewOrderAdvanceHandlers(repo, repo, repo)
-	userAdvanceHandlers := advance.NewUserAdvanceHandlers(cfg, repo)
-	socialAccountAdvanceHandlers := advance.NewSocialAccountAdvanceHandlers(repo, repo)
-	issuanceAdvanceHandlers := advance.NewIssuanceAdvanceHandlers(cfg, repo, repo, repo)
-	emergencyAdvanceHandlers := advance.NewEmergencyAdvanceHandlers(cfg)
+	orderAdvanceHandlers := advance.NewOrderAdvanceHandlers(repo, repo, repo, repo, repo)
+	userAdvanceHandlers := advance.NewUserAdvanceHandlers(cfg, repo, repo, repo, repo, repo)
+	socialAccountAdvanceHandlers := advance.NewSocialAccountAdvanceHandlers(cfg, repo, repo)
+	issuanceAdvanceHandlers := advance.NewIssuanceAdvanceHandlers(cfg, repo, repo, repo, repo, repo, repo, repo)
+	emergencyAdvanceHandlers := advance.NewEmergencyAdvanceHandlers(cfg, repo)
+	attestationAdvanceHandlers := advance.NewAttestationAdvanceHandlers(repo, repo)
 	orderInspectHandlers := inspect.NewOrderInspectHandlers(repo, repo)
-	userInspectHandlers := inspect.NewUserInspectHandlers(repo)
+	userInspectHandlers := inspect.NewUserInspectHandlers(repo, repo)
 	socialAccountInspectHandlers := inspect.NewSocialAccountInspectHandlers(repo)
 	issuanceInspectHandlers := inspect.NewIssuanceInspectHandlers(repo, repo)
+	catalogInspectHandlers := inspect.NewCatalogInspectHandlers()
+	proposalInspectHandlers := inspect.NewProposalInspectHandlers(repo)
+	emergencyWithdrawalInspectHandlers := inspect.NewEmergencyWithdrawalInspectHandlers(repo)
+	attestationInspectHandlers := inspect.NewAttestationInspectHandlers(repo, repo)
+	portfolioInspectHandlers := inspect.NewPortfolioInspectHandlers(cfg, repo, repo)
+	analyticsInspectHandlers := inspect.NewAnalyticsInspectHandlers(cfg, repo)
+	ledgerInspectHandlers := inspect.NewLedgerInspectHandlers(repo)
+	stateInspectHandlers := inspect.NewStateInspectHandlers(repo)
+	genesisInspectHandlers := inspect.NewGenesisInspectHandlers(repo)
 	handlers := &Handlers{
-		OrderAdvanceHandlers:     orderAdvanceHandlers,
-		UserAdvanceHandlers:      userAdvanceHandlers,
-		SocialAccountsHandlers:   socialAccountAdvanceHandlers,
-		IssuanceAdvanceHandlers:  issuanceAdvanceHandlers,
-		EmergencyAdvanceHandlers: emergencyAdvanceHandlers,
-		OrderInspectHandlers:     orderInspectHandlers,
-		UserInspectHandlers:      userInspectHandlers,
-		SocialAccountHandlers:    socialAccountInspectHandlers,
-		IssuanceInspectHandlers:  issuanceInspectHandlers,
+		OrderAdvanceHandlers:               orderAdvanceHandlers,
+		UserAdvanceHandlers:                userAdvanceHandlers,
+		SocialAccountsHandlers:             socialAccountAdvanceHandlers,
+		IssuanceAdvanceHandlers:            issuanceAdvanceHandlers,
+		EmergencyAdvanceHandlers:           emergencyAdvanceHandlers,
+		AttestationAdvanceHandlers:         attestationAdvanceHandlers,
+		OrderInspectHandlers:               orderInspectHandlers,
+		UserInspectHandlers:                userInspectHandlers,
+		SocialAccountHandlers:              socialAccountInspectHandlers,
+		IssuanceInspectHandlers:            issuanceInspectHandlers,
+		CatalogInspectHandlers:             catalogInspectHandlers,
+		ProposalInspectHandlers:            proposalInspectHandlers,
+		EmergencyWithdrawalInspectHandlers: emergencyWithdrawalInspectHandlers,
+		AttestationInspectHandlers:         attestationInspectHandlers,
+		PortfolioInspectHandlers:           portfolioInspectHandlers,
+		AnalyticsInspectHandlers:           analyticsInspectHandlers,
+		LedgerInspectHandlers:              ledgerInspectHandlers,
+		StateInspectHandlers:               stateInspectHandlers,
+		GenesisInspectHandlers:             genesisInspectHandlers,
 	}
 	return handlers, nil
 }
@@ -44,15 +64,25 @@ func NewHandlers(repo repository.Repository, cfg *configs.RollupConfig) (*Handle
 // Handlers contains all handler dependencies
 type Handlers struct {
 	// Advance handlers
-	OrderAdvanceHandlers     *advance.OrderAdvanceHandlers
-	UserAdvanceHandlers      *advance.UserAdvanceHandlers
-	SocialAccountsHandlers   *advance.SocialAccountAdvanceHandlers
-	IssuanceAdvanceHandlers  *advance.IssuanceAdvanceHandlers
-	EmergencyAdvanceHandlers *advance.EmergencyAdvanceHandlers
+	OrderAdvanceHandlers       *advance.OrderAdvanceHandlers
+	UserAdvanceHandlers        *advance.UserAdvanceHandlers
+	SocialAccountsHandlers     *advance.SocialAccountAdvanceHandlers
+	IssuanceAdvanceHandlers    *advance.IssuanceAdvanceHandlers
+	EmergencyAdvanceHandlers   *advance.EmergencyAdvanceHandlers
+	AttestationAdvanceHandlers *advance.AttestationAdvanceHandlers
 
 	// Inspect handlers
-	OrderInspectHandlers    *inspect.OrderInspectHandlers
-	UserInspectHandlers     *inspect.UserInspectHandlers
-	SocialAccountHandlers   *inspect.SocialAccountInspectHandlers
-	IssuanceInspectHandlers *inspect.IssuanceInspectHandlers
+	OrderInspectHandlers               *inspect.OrderInspectHandlers
+	UserInspectHandlers                *inspect.UserInspectHandlers
+	SocialAccountHandlers              *inspect.SocialAccountInspectHandlers
+	IssuanceInspectHandlers            *inspect.IssuanceInspectHandlers
+	CatalogInspectHandlers             *inspect.CatalogInspectHandlers
+	ProposalInspectHandlers            *inspect.ProposalInspectHandlers
+	EmergencyWithdrawalInspectHandlers *inspect.EmergencyWithdrawalInspectHandlers
+	AttestationInspectHandlers         *inspect.AttestationInspectHandlers
+	PortfolioInspectHandlers           *inspect.PortfolioInspectHandlers
+	AnalyticsInspectHandlers           *inspect.AnalyticsInspectHandlers
+	LedgerInspectHandlers              *inspect.LedgerInspectHandlers
+	StateInspectHandlers               *inspect.StateInspectHandlers
+	GenesisInspectHandlers             *inspect.GenesisInspectHandlers
 }
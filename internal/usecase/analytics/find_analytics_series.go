@@ -0,0 +1,54 @@
+package analytics
+
+import (
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/api"
+)
+
+const (
+	IntervalDaily  = "daily"
+	IntervalWeekly = "weekly"
+)
+
+var intervalSeconds = map[string]int64{
+	IntervalDaily:  24 * 60 * 60,
+	IntervalWeekly: 7 * 24 * 60 * 60,
+}
+
+type FindAnalyticsSeriesInputDTO = api.FindAnalyticsSeriesInputDTO
+
+type BucketOutputDTO = api.BucketOutputDTO
+
+type FindAnalyticsSeriesOutputDTO = api.FindAnalyticsSeriesOutputDTO
+
+type FindAnalyticsSeriesUseCase struct {
+	AnalyticsRepository repository.AnalyticsRepository
+}
+
+func NewFindAnalyticsSeriesUseCase(analyticsRepo repository.AnalyticsRepository) *FindAnalyticsSeriesUseCase {
+	return &FindAnalyticsSeriesUseCase{
+		AnalyticsRepository: analyticsRepo,
+	}
+}
+
+func (f *FindAnalyticsSeriesUseCase) Execute(input *FindAnalyticsSeriesInputDTO) (FindAnalyticsSeriesOutputDTO, error) {
+	buckets, err := f.AnalyticsRepository.FindActivitySeries(intervalSeconds[input.Interval], input.From, input.To)
+	if err != nil {
+		return nil, err
+	}
+	output := make(FindAnalyticsSeriesOutputDTO, len(buckets))
+	for i, bucket := range buckets {
+		volume := make([]*TokenAmountOutputDTO, len(bucket.Volume))
+		for j, amounts := range bucket.Volume {
+			volume[j] = NewTokenAmountOutputDTO(amounts)
+		}
+		output[i] = &BucketOutputDTO{
+			Start:     bucket.Start,
+			Issuances: bucket.Issuances,
+			Orders:    bucket.Orders,
+			Investors: bucket.Investors,
+			Volume:    volume,
+		}
+	}
+	return output, nil
+}
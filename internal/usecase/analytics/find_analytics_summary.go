@@ -0,0 +1,92 @@
+package analytics
+
+import (
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/issuance"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/api"
+	"github.com/holiman/uint256"
+)
+
+type RaisedOutputDTO = api.RaisedOutputDTO
+
+type FindAnalyticsSummaryOutputDTO = api.FindAnalyticsSummaryOutputDTO
+
+type FindAnalyticsSummaryUseCase struct {
+	AnalyticsRepository repository.AnalyticsRepository
+	// IssuanceFee is the fee charged on the amount raised, in basis points.
+	IssuanceFee uint64
+}
+
+func NewFindAnalyticsSummaryUseCase(analyticsRepo repository.AnalyticsRepository, issuanceFee uint64) *FindAnalyticsSummaryUseCase {
+	return &FindAnalyticsSummaryUseCase{
+		AnalyticsRepository: analyticsRepo,
+		IssuanceFee:         issuanceFee,
+	}
+}
+
+func (f *FindAnalyticsSummaryUseCase) Execute() (*FindAnalyticsSummaryOutputDTO, error) {
+	issuances, err := f.AnalyticsRepository.CountIssuancesByState()
+	if err != nil {
+		return nil, err
+	}
+	raisedAmounts, err := f.AnalyticsRepository.FindRaisedAmountsByToken()
+	if err != nil {
+		return nil, err
+	}
+	clearingRate, err := f.AnalyticsRepository.FindAverageClearingRate()
+	if err != nil {
+		return nil, err
+	}
+	activeInvestors, err := f.AnalyticsRepository.CountInvestorsByOrderState(
+		entity.OrderStatePending,
+		entity.OrderStateAccepted,
+		entity.OrderStatePartiallyAccepted,
+	)
+	if err != nil {
+		return nil, err
+	}
+	investors, err := f.AnalyticsRepository.CountInvestorsByOrderState()
+	if err != nil {
+		return nil, err
+	}
+
+	raised := make([]*RaisedOutputDTO, len(raisedAmounts))
+	for i, amounts := range raisedAmounts {
+		total := NewTokenAmountOutputDTO(amounts)
+		// fees are charged per issuance when it closes, so they are rounded per issuance too
+		fees := uint256.NewInt(0)
+		for _, amount := range amounts.Amounts {
+			fee := new(uint256.Int).Mul(amount, uint256.NewInt(f.IssuanceFee))
+			fees.Add(fees, fee.Div(fee, issuance.BasisPointsDivisor))
+		}
+		raised[i] = &RaisedOutputDTO{
+			Token:     total.Token,
+			Issuances: uint64(len(amounts.Amounts)),
+			Amount:    total.Amount,
+			Fees:      fees,
+		}
+	}
+
+	var defaultRate float64
+	if matured := issuances[entity.IssuanceStateSettled] + issuances[entity.IssuanceStateCollateralExecuted]; matured > 0 {
+		defaultRate = float64(issuances[entity.IssuanceStateCollateralExecuted]) / float64(matured)
+	}
+
+	var states map[string]uint64
+	if issuances != nil {
+		states = make(map[string]uint64, len(issuances))
+		for state, count := range issuances {
+			states[string(state)] = count
+		}
+	}
+
+	return &FindAnalyticsSummaryOutputDTO{
+		Issuances:           states,
+		Raised:              raised,
+		AverageClearingRate: clearingRate,
+		DefaultRate:         defaultRate,
+		ActiveInvestors:     activeInvestors,
+		Investors:           investors,
+	}, nil
+}
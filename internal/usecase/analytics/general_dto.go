@@ -0,0 +1,20 @@
+package analytics
+
+import (
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/api"
+	"github.com/holiman/uint256"
+)
+
+type TokenAmountOutputDTO = api.TokenAmountOutputDTO
+
+func NewTokenAmountOutputDTO(amounts *entity.TokenAmounts) *TokenAmountOutputDTO {
+	total := uint256.NewInt(0)
+	for _, amount := range amounts.Amounts {
+		total.Add(total, amount)
+	}
+	return &TokenAmountOutputDTO{
+		Token:  amounts.Token,
+		Amount: total,
+	}
+}
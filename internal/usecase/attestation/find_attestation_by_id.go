@@ -0,0 +1,27 @@
+package attestation
+
+import (
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+)
+
+type FindAttestationByIdInputDTO struct {
+	Id uint `json:"id" validate:"required"`
+}
+
+type FindAttestationByIdUseCase struct {
+	AttestationRepository repository.AttestationRepository
+}
+
+func NewFindAttestationByIdUseCase(attestationRepo repository.AttestationRepository) *FindAttestationByIdUseCase {
+	return &FindAttestationByIdUseCase{
+		AttestationRepository: attestationRepo,
+	}
+}
+
+func (u *FindAttestationByIdUseCase) Execute(input *FindAttestationByIdInputDTO) (*AttestationOutputDTO, error) {
+	res, err := u.AttestationRepository.FindAttestationById(input.Id)
+	if err != nil {
+		return nil, err
+	}
+	return newAttestationOutputDTO(res), nil
+}
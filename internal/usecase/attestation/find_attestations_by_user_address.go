@@ -0,0 +1,41 @@
+package attestation
+
+import (
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+)
+
+type FindAttestationsByUserAddressInputDTO struct {
+	Address Address `json:"address" validate:"required"`
+}
+
+type FindAttestationsByUserAddressUseCase struct {
+	UserRepository        repository.UserRepository
+	AttestationRepository repository.AttestationRepository
+}
+
+func NewFindAttestationsByUserAddressUseCase(
+	userRepo repository.UserRepository,
+	attestationRepo repository.AttestationRepository,
+) *FindAttestationsByUserAddressUseCase {
+	return &FindAttestationsByUserAddressUseCase{
+		UserRepository:        userRepo,
+		AttestationRepository: attestationRepo,
+	}
+}
+
+func (u *FindAttestationsByUserAddressUseCase) Execute(input *FindAttestationsByUserAddressInputDTO) ([]*AttestationOutputDTO, error) {
+	subject, err := u.UserRepository.FindUserByAddress(input.Address)
+	if err != nil {
+		return nil, err
+	}
+	res, err := u.AttestationRepository.FindAttestationsBySubjectId(subject.Id)
+	if err != nil {
+		return nil, err
+	}
+	output := make([]*AttestationOutputDTO, len(res))
+	for i, attestation := range res {
+		output[i] = newAttestationOutputDTO(attestation)
+	}
+	return output, nil
+}
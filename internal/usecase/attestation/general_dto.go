@@ -0,0 +1,36 @@
+package attestation
+
+import (
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
+	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+)
+
+type AttestationOutputDTO struct {
+	Id           uint    `json:"id"`
+	SubjectId    uint    `json:"subject_id"`
+	Type         string  `json:"type"`
+	Jurisdiction string  `json:"jurisdiction,omitempty"`
+	Issuer       Address `json:"issuer"`
+	EvidenceHash string  `json:"evidence_hash"`
+	ExpiresAt    int64   `json:"expires_at"`
+	RevokedBy    Address `json:"revoked_by"`
+	RevokedAt    int64   `json:"revoked_at,omitempty"`
+	CreatedAt    int64   `json:"created_at"`
+	UpdatedAt    int64   `json:"updated_at"`
+}
+
+func newAttestationOutputDTO(attestation *entity.Attestation) *AttestationOutputDTO {
+	return &AttestationOutputDTO{
+		Id:           attestation.Id,
+		SubjectId:    attestation.SubjectId,
+		Type:         string(attestation.Type),
+		Jurisdiction: attestation.Jurisdiction,
+		Issuer:       attestation.Issuer,
+		EvidenceHash: attestation.EvidenceHash,
+		ExpiresAt:    attestation.ExpiresAt,
+		RevokedBy:    attestation.RevokedBy,
+		RevokedAt:    attestation.RevokedAt,
+		CreatedAt:    attestation.CreatedAt,
+		UpdatedAt:    attestation.UpdatedAt,
+	}
+}
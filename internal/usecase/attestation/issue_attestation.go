@@ -0,0 +1,62 @@
+package attestation
+
+import (
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+	"github.com/rollmelette/rollmelette"
+)
+
+type IssueAttestationInputDTO struct {
+	Address      Address `json:"address" validate:"required"`
+	Type         string  `json:"type" validate:"required,oneof=kyc accredited_investor jurisdiction"`
+	Jurisdiction string  `json:"jurisdiction"`
+	EvidenceHash string  `json:"evidence_hash" validate:"required"`
+	ExpiresAt    int64   `json:"expires_at" validate:"required"`
+}
+
+func (IssueAttestationInputDTO) ABIMethod() string {
+	return "issueAttestation"
+}
+
+type IssueAttestationUseCase struct {
+	UserRepository        repository.UserRepository
+	AttestationRepository repository.AttestationRepository
+}
+
+func NewIssueAttestationUseCase(
+	userRepo repository.UserRepository,
+	attestationRepo repository.AttestationRepository,
+) *IssueAttestationUseCase {
+	return &IssueAttestationUseCase{
+		UserRepository:        userRepo,
+		AttestationRepository: attestationRepo,
+	}
+}
+
+// Execute records an attestation about the user at input.Address, issued by the sender.
+func (u *IssueAttestationUseCase) Execute(input *IssueAttestationInputDTO, metadata rollmelette.Metadata) (*AttestationOutputDTO, error) {
+	subject, err := u.UserRepository.FindUserByAddress(input.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	attestation, err := entity.NewAttestation(
+		subject.Id,
+		input.Type,
+		input.Jurisdiction,
+		Address(metadata.MsgSender),
+		input.EvidenceHash,
+		input.ExpiresAt,
+		metadata.BlockTimestamp,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := u.AttestationRepository.CreateAttestation(attestation)
+	if err != nil {
+		return nil, err
+	}
+	return newAttestationOutputDTO(res), nil
+}
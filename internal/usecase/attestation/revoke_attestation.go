@@ -0,0 +1,42 @@
+package attestation
+
+import (
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+	"github.com/rollmelette/rollmelette"
+)
+
+type RevokeAttestationInputDTO struct {
+	Id uint `json:"id" validate:"required"`
+}
+
+func (RevokeAttestationInputDTO) ABIMethod() string {
+	return "revokeAttestation"
+}
+
+type RevokeAttestationUseCase struct {
+	AttestationRepository repository.AttestationRepository
+}
+
+func NewRevokeAttestationUseCase(attestationRepo repository.AttestationRepository) *RevokeAttestationUseCase {
+	return &RevokeAttestationUseCase{
+		AttestationRepository: attestationRepo,
+	}
+}
+
+func (u *RevokeAttestationUseCase) Execute(input *RevokeAttestationInputDTO, metadata rollmelette.Metadata) (*AttestationOutputDTO, error) {
+	attestation, err := u.AttestationRepository.FindAttestationById(input.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := attestation.Revoke(Address(metadata.MsgSender), metadata.BlockTimestamp); err != nil {
+		return nil, err
+	}
+
+	res, err := u.AttestationRepository.UpdateAttestation(attestation)
+	if err != nil {
+		return nil, err
+	}
+	return newAttestationOutputDTO(res), nil
+}
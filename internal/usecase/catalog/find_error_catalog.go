@@ -0,0 +1,36 @@
+package catalog
+
+import (
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain"
+)
+
+type ErrorOutputDTO struct {
+	Code     string   `json:"code"`
+	Category string   `json:"category"`
+	Status   int      `json:"status"`
+	Message  string   `json:"message"`
+	Params   []string `json:"params"`
+}
+
+type FindErrorCatalogOutputDTO []*ErrorOutputDTO
+
+type FindErrorCatalogUseCase struct{}
+
+func NewFindErrorCatalogUseCase() *FindErrorCatalogUseCase {
+	return &FindErrorCatalogUseCase{}
+}
+
+func (u *FindErrorCatalogUseCase) Execute() (*FindErrorCatalogOutputDTO, error) {
+	defs := domain.Catalog()
+	output := make(FindErrorCatalogOutputDTO, len(defs))
+	for i, def := range defs {
+		output[i] = &ErrorOutputDTO{
+			Code:     def.Code,
+			Category: string(def.Category),
+			Status:   def.Status,
+			Message:  def.Message,
+			Params:   def.Params,
+		}
+	}
+	return &output, nil
+}
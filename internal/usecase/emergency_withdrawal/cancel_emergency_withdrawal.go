@@ -0,0 +1,42 @@
+package emergency_withdrawal
+
+import (
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+	"github.com/rollmelette/rollmelette"
+)
+
+type CancelEmergencyWithdrawalInputDTO struct {
+	Id uint `json:"id" validate:"required"`
+}
+
+func (CancelEmergencyWithdrawalInputDTO) ABIMethod() string {
+	return "cancelEmergencyWithdrawal"
+}
+
+type CancelEmergencyWithdrawalUseCase struct {
+	EmergencyWithdrawalRepository repository.EmergencyWithdrawalRepository
+}
+
+func NewCancelEmergencyWithdrawalUseCase(emergencyWithdrawalRepo repository.EmergencyWithdrawalRepository) *CancelEmergencyWithdrawalUseCase {
+	return &CancelEmergencyWithdrawalUseCase{
+		EmergencyWithdrawalRepository: emergencyWithdrawalRepo,
+	}
+}
+
+func (u *CancelEmergencyWithdrawalUseCase) Execute(input *CancelEmergencyWithdrawalInputDTO, metadata rollmelette.Metadata) (*EmergencyWithdrawalOutputDTO, error) {
+	withdrawal, err := u.EmergencyWithdrawalRepository.FindEmergencyWithdrawalById(input.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := withdrawal.Cancel(Address(metadata.MsgSender), metadata.BlockTimestamp); err != nil {
+		return nil, err
+	}
+
+	res, err := u.EmergencyWithdrawalRepository.UpdateEmergencyWithdrawal(withdrawal)
+	if err != nil {
+		return nil, err
+	}
+	return newEmergencyWithdrawalOutputDTO(res), nil
+}
@@ -0,0 +1,42 @@
+package emergency_withdrawal
+
+import (
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+	"github.com/rollmelette/rollmelette"
+)
+
+type ExecuteEmergencyWithdrawalInputDTO struct {
+	Id uint `json:"id" validate:"required"`
+}
+
+func (ExecuteEmergencyWithdrawalInputDTO) ABIMethod() string {
+	return "executeEmergencyWithdrawal"
+}
+
+type ExecuteEmergencyWithdrawalUseCase struct {
+	EmergencyWithdrawalRepository repository.EmergencyWithdrawalRepository
+}
+
+func NewExecuteEmergencyWithdrawalUseCase(emergencyWithdrawalRepo repository.EmergencyWithdrawalRepository) *ExecuteEmergencyWithdrawalUseCase {
+	return &ExecuteEmergencyWithdrawalUseCase{
+		EmergencyWithdrawalRepository: emergencyWithdrawalRepo,
+	}
+}
+
+func (u *ExecuteEmergencyWithdrawalUseCase) Execute(input *ExecuteEmergencyWithdrawalInputDTO, metadata rollmelette.Metadata) (*EmergencyWithdrawalOutputDTO, error) {
+	withdrawal, err := u.EmergencyWithdrawalRepository.FindEmergencyWithdrawalById(input.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := withdrawal.Execute(Address(metadata.MsgSender), metadata.BlockTimestamp); err != nil {
+		return nil, err
+	}
+
+	res, err := u.EmergencyWithdrawalRepository.UpdateEmergencyWithdrawal(withdrawal)
+	if err != nil {
+		return nil, err
+	}
+	return newEmergencyWithdrawalOutputDTO(res), nil
+}
@@ -0,0 +1,29 @@
+package emergency_withdrawal
+
+import (
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+)
+
+type FindAllEmergencyWithdrawalsOutputDTO []*EmergencyWithdrawalOutputDTO
+
+type FindAllEmergencyWithdrawalsUseCase struct {
+	EmergencyWithdrawalRepository repository.EmergencyWithdrawalRepository
+}
+
+func NewFindAllEmergencyWithdrawalsUseCase(emergencyWithdrawalRepo repository.EmergencyWithdrawalRepository) *FindAllEmergencyWithdrawalsUseCase {
+	return &FindAllEmergencyWithdrawalsUseCase{
+		EmergencyWithdrawalRepository: emergencyWithdrawalRepo,
+	}
+}
+
+func (u *FindAllEmergencyWithdrawalsUseCase) Execute() (FindAllEmergencyWithdrawalsOutputDTO, error) {
+	res, err := u.EmergencyWithdrawalRepository.FindAllEmergencyWithdrawals()
+	if err != nil {
+		return nil, err
+	}
+	output := make(FindAllEmergencyWithdrawalsOutputDTO, len(res))
+	for i, withdrawal := range res {
+		output[i] = newEmergencyWithdrawalOutputDTO(withdrawal)
+	}
+	return output, nil
+}
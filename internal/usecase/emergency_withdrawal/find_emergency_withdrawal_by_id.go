@@ -0,0 +1,27 @@
+package emergency_withdrawal
+
+import (
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+)
+
+type FindEmergencyWithdrawalByIdInputDTO struct {
+	Id uint `json:"id" validate:"required"`
+}
+
+type FindEmergencyWithdrawalByIdUseCase struct {
+	EmergencyWithdrawalRepository repository.EmergencyWithdrawalRepository
+}
+
+func NewFindEmergencyWithdrawalByIdUseCase(emergencyWithdrawalRepo repository.EmergencyWithdrawalRepository) *FindEmergencyWithdrawalByIdUseCase {
+	return &FindEmergencyWithdrawalByIdUseCase{
+		EmergencyWithdrawalRepository: emergencyWithdrawalRepo,
+	}
+}
+
+func (u *FindEmergencyWithdrawalByIdUseCase) Execute(input *FindEmergencyWithdrawalByIdInputDTO) (*EmergencyWithdrawalOutputDTO, error) {
+	res, err := u.EmergencyWithdrawalRepository.FindEmergencyWithdrawalById(input.Id)
+	if err != nil {
+		return nil, err
+	}
+	return newEmergencyWithdrawalOutputDTO(res), nil
+}
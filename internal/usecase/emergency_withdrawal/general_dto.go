@@ -0,0 +1,40 @@
+package emergency_withdrawal
+
+import (
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
+	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+)
+
+type EmergencyWithdrawalOutputDTO struct {
+	Id           uint    `json:"id"`
+	Kind         string  `json:"kind"`
+	Token        Address `json:"token"`
+	To           Address `json:"to"`
+	State        string  `json:"state"`
+	RequestedBy  Address `json:"requested_by"`
+	CanceledBy   Address `json:"canceled_by"`
+	ExecutedBy   Address `json:"executed_by"`
+	ExecutableAt int64   `json:"executable_at"`
+	CanceledAt   int64   `json:"canceled_at,omitempty"`
+	ExecutedAt   int64   `json:"executed_at,omitempty"`
+	CreatedAt    int64   `json:"created_at"`
+	UpdatedAt    int64   `json:"updated_at"`
+}
+
+func newEmergencyWithdrawalOutputDTO(withdrawal *entity.EmergencyWithdrawal) *EmergencyWithdrawalOutputDTO {
+	return &EmergencyWithdrawalOutputDTO{
+		Id:           withdrawal.Id,
+		Kind:         string(withdrawal.Kind),
+		Token:        withdrawal.Token,
+		To:           withdrawal.To,
+		State:        string(withdrawal.State),
+		RequestedBy:  withdrawal.RequestedBy,
+		CanceledBy:   withdrawal.CanceledBy,
+		ExecutedBy:   withdrawal.ExecutedBy,
+		ExecutableAt: withdrawal.ExecutableAt,
+		CanceledAt:   withdrawal.CanceledAt,
+		ExecutedAt:   withdrawal.ExecutedAt,
+		CreatedAt:    withdrawal.CreatedAt,
+		UpdatedAt:    withdrawal.UpdatedAt,
+	}
+}
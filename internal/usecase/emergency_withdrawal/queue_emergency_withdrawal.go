@@ -0,0 +1,47 @@
+package emergency_withdrawal
+
+import (
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+	"github.com/rollmelette/rollmelette"
+)
+
+// QueueEmergencyWithdrawalInputDTO is built by the emergency withdraw handlers.
+type QueueEmergencyWithdrawalInputDTO struct {
+	Kind  entity.EmergencyWithdrawalKind
+	Token Address
+	To    Address
+	// Delay is the number of seconds before the withdrawal can be executed
+	Delay int64
+}
+
+type QueueEmergencyWithdrawalUseCase struct {
+	EmergencyWithdrawalRepository repository.EmergencyWithdrawalRepository
+}
+
+func NewQueueEmergencyWithdrawalUseCase(emergencyWithdrawalRepo repository.EmergencyWithdrawalRepository) *QueueEmergencyWithdrawalUseCase {
+	return &QueueEmergencyWithdrawalUseCase{
+		EmergencyWithdrawalRepository: emergencyWithdrawalRepo,
+	}
+}
+
+func (u *QueueEmergencyWithdrawalUseCase) Execute(input *QueueEmergencyWithdrawalInputDTO, metadata rollmelette.Metadata) (*EmergencyWithdrawalOutputDTO, error) {
+	withdrawal, err := entity.NewEmergencyWithdrawal(
+		input.Kind,
+		input.Token,
+		input.To,
+		Address(metadata.MsgSender),
+		metadata.BlockTimestamp+input.Delay,
+		metadata.BlockTimestamp,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := u.EmergencyWithdrawalRepository.CreateEmergencyWithdrawal(withdrawal)
+	if err != nil {
+		return nil, err
+	}
+	return newEmergencyWithdrawalOutputDTO(res), nil
+}
@@ -0,0 +1,111 @@
+package genesis
+
+import (
+	"errors"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+	"github.com/rollmelette/rollmelette"
+)
+
+type ApplyGenesisOutputDTO struct {
+	Hash           string `json:"hash"`
+	Users          int    `json:"users"`
+	SocialAccounts int    `json:"social_accounts"`
+	Platforms      int    `json:"platforms"`
+	AllowedTokens  int    `json:"allowed_tokens"`
+	InputIndex     uint   `json:"input_index"`
+	AppliedAt      int64  `json:"applied_at"`
+}
+
+type ApplyGenesisUseCase struct {
+	GenesisRepository       repository.GenesisRepository
+	UserRepository          repository.UserRepository
+	SocialAccountRepository repository.SocialAccountRepository
+}
+
+func NewApplyGenesisUseCase(
+	genesisRepo repository.GenesisRepository,
+	userRepo repository.UserRepository,
+	socialAccountRepo repository.SocialAccountRepository,
+) *ApplyGenesisUseCase {
+	return &ApplyGenesisUseCase{
+		GenesisRepository:       genesisRepo,
+		UserRepository:          userRepo,
+		SocialAccountRepository: socialAccountRepo,
+	}
+}
+
+// Execute writes the genesis file to an empty database, dated by the input applying it. It fails
+// with ErrGenesisAlreadyApplied once a genesis was applied.
+func (u *ApplyGenesisUseCase) Execute(file *File, metadata rollmelette.Metadata) (*ApplyGenesisOutputDTO, error) {
+	applied, err := u.GenesisRepository.FindGenesis()
+	if err == nil {
+		return nil, entity.ErrGenesisAlreadyApplied.New(applied.Hash)
+	}
+	if !errors.Is(err, entity.ErrGenesisNotFound) {
+		return nil, err
+	}
+
+	genesis, err := entity.NewGenesis(file.Hash, uint(metadata.Index), metadata.BlockTimestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	socialAccounts := 0
+	for _, entry := range file.Users {
+		user, err := newUser(entry, metadata.BlockTimestamp)
+		if err != nil {
+			return nil, err
+		}
+		user, err = u.UserRepository.CreateUser(user)
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range entry.SocialAccounts {
+			account, err := entity.NewGenesisSocialAccount(user.Id, a.Username, a.Platform, metadata.BlockTimestamp)
+			if err != nil {
+				return nil, err
+			}
+			if _, err := u.SocialAccountRepository.CreateSocialAccount(account); err != nil {
+				return nil, err
+			}
+			socialAccounts++
+		}
+	}
+
+	for _, p := range file.Platforms {
+		setting, err := entity.NewPlatformSetting(p.Platform, p.Enabled, Address{}, metadata.BlockTimestamp)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := u.SocialAccountRepository.SavePlatformSetting(setting); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, token := range file.AllowedTokens {
+		allowed, err := entity.NewAllowedToken(token, metadata.BlockTimestamp)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := u.GenesisRepository.CreateAllowedToken(allowed); err != nil {
+			return nil, err
+		}
+	}
+
+	res, err := u.GenesisRepository.CreateGenesis(genesis)
+	if err != nil {
+		return nil, err
+	}
+	return &ApplyGenesisOutputDTO{
+		Hash:           res.Hash,
+		Users:          len(file.Users),
+		SocialAccounts: socialAccounts,
+		Platforms:      len(file.Platforms),
+		AllowedTokens:  len(file.AllowedTokens),
+		InputIndex:     res.InputIndex,
+		AppliedAt:      res.AppliedAt,
+	}, nil
+}
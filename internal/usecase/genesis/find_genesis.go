@@ -0,0 +1,42 @@
+package genesis
+
+import (
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/api"
+	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+)
+
+type FindGenesisOutputDTO = api.FindGenesisOutputDTO
+
+type FindGenesisUseCase struct {
+	GenesisRepository repository.GenesisRepository
+}
+
+func NewFindGenesisUseCase(genesisRepo repository.GenesisRepository) *FindGenesisUseCase {
+	return &FindGenesisUseCase{
+		GenesisRepository: genesisRepo,
+	}
+}
+
+// Execute returns the genesis applied to the database with the tokens it allows, empty when
+// every token is allowed.
+func (u *FindGenesisUseCase) Execute() (*FindGenesisOutputDTO, error) {
+	genesis, err := u.GenesisRepository.FindGenesis()
+	if err != nil {
+		return nil, err
+	}
+	tokens, err := u.GenesisRepository.FindAllAllowedTokens()
+	if err != nil {
+		return nil, err
+	}
+	allowedTokens := make([]Address, 0, len(tokens))
+	for _, token := range tokens {
+		allowedTokens = append(allowedTokens, token.Token)
+	}
+	return &FindGenesisOutputDTO{
+		Hash:          genesis.Hash,
+		InputIndex:    genesis.InputIndex,
+		AppliedAt:     genesis.AppliedAt,
+		AllowedTokens: allowedTokens,
+	}, nil
+}
@@ -0,0 +1,156 @@
+package genesis
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
+	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+)
+
+// MaxIssuanceFee is the largest issuance fee, in basis points: the whole amount raised.
+const MaxIssuanceFee = 10000
+
+type SocialAccountDTO struct {
+	Username string `json:"username"`
+	Platform string `json:"platform"`
+}
+
+type UserDTO struct {
+	Address        Address            `json:"address"`
+	Roles          []string           `json:"roles"`
+	SocialAccounts []SocialAccountDTO `json:"social_accounts,omitempty"`
+}
+
+type PlatformDTO struct {
+	Platform string `json:"platform"`
+	Enabled  bool   `json:"enabled"`
+}
+
+// File is a genesis file: the users, social accounts and settings the rollup starts with. An
+// empty AllowedTokens allows every token and a nil IssuanceFee keeps the configured fee.
+type File struct {
+	Users         []UserDTO     `json:"users"`
+	Platforms     []PlatformDTO `json:"platforms,omitempty"`
+	AllowedTokens []Address     `json:"allowed_tokens,omitempty"`
+	IssuanceFee   *uint64       `json:"issuance_fee,omitempty"`
+
+	// Hash is the hex encoded SHA-256 of the file content, recorded when it is applied.
+	Hash string `json:"-"`
+}
+
+// Load reads and decodes the genesis file at path.
+func Load(path string) (*File, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read genesis file: %w", err)
+	}
+	return Decode(content)
+}
+
+// Decode parses a genesis file, rejecting unknown fields, and validates it.
+func Decode(content []byte) (*File, error) {
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.DisallowUnknownFields()
+	var file File
+	if err := decoder.Decode(&file); err != nil {
+		return nil, entity.ErrInvalidGenesis.New(err.Error())
+	}
+	if err := file.Validate(); err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(content)
+	file.Hash = hex.EncodeToString(sum[:])
+	return &file, nil
+}
+
+// Validate checks the file builds valid entities: unique users with valid roles, at least one
+// admin, unique social accounts and platforms, unique allowed tokens and a fee within bounds.
+func (f *File) Validate() error {
+	if len(f.Users) == 0 {
+		return entity.ErrInvalidGenesis.New("no users")
+	}
+
+	admins := 0
+	addresses := make(map[Address]bool, len(f.Users))
+	accounts := make(map[string]bool)
+	for i, u := range f.Users {
+		// placeholders stand in for the values given when the genesis is applied
+		user, err := newUser(u, 1)
+		if err != nil {
+			return entity.ErrInvalidGenesis.New(fmt.Sprintf("user %s: %v", u.Address, err))
+		}
+		if addresses[user.Address] {
+			return entity.ErrInvalidGenesis.New(fmt.Sprintf("user %s is listed twice", user.Address))
+		}
+		addresses[user.Address] = true
+		if user.HasRole(entity.UserRoleAdmin) {
+			admins++
+		}
+
+		for _, a := range u.SocialAccounts {
+			account, err := entity.NewGenesisSocialAccount(uint(i+1), a.Username, a.Platform, 1)
+			if err != nil {
+				return entity.ErrInvalidGenesis.New(fmt.Sprintf("social account %s of user %s: %v", a.Username, u.Address, err))
+			}
+			key := string(account.Platform) + "/" + account.Username
+			if accounts[key] {
+				return entity.ErrInvalidGenesis.New(fmt.Sprintf("social account %s on %s is listed twice", account.Username, account.Platform))
+			}
+			accounts[key] = true
+		}
+	}
+	if admins == 0 {
+		return entity.ErrInvalidGenesis.New("no user has the admin role")
+	}
+
+	platforms := make(map[string]bool, len(f.Platforms))
+	for _, p := range f.Platforms {
+		if _, err := entity.NewPlatformSetting(p.Platform, p.Enabled, Address{}, 1); err != nil {
+			return entity.ErrInvalidGenesis.New(fmt.Sprintf("platform %s: %v", p.Platform, err))
+		}
+		if platforms[p.Platform] {
+			return entity.ErrInvalidGenesis.New(fmt.Sprintf("platform %s is listed twice", p.Platform))
+		}
+		platforms[p.Platform] = true
+	}
+
+	tokens := make(map[Address]bool, len(f.AllowedTokens))
+	for _, token := range f.AllowedTokens {
+		if _, err := entity.NewAllowedToken(token, 1); err != nil {
+			return entity.ErrInvalidGenesis.New(err.Error())
+		}
+		if tokens[token] {
+			return entity.ErrInvalidGenesis.New(fmt.Sprintf("allowed token %s is listed twice", token))
+		}
+		tokens[token] = true
+	}
+
+	if f.IssuanceFee != nil && *f.IssuanceFee > MaxIssuanceFee {
+		return entity.ErrInvalidGenesis.New(fmt.Sprintf("issuance fee %d exceeds %d basis points", *f.IssuanceFee, MaxIssuanceFee))
+	}
+	return nil
+}
+
+// newUser builds a user with every role of the genesis entry.
+func newUser(u UserDTO, createdAt int64) (*entity.User, error) {
+	if len(u.Roles) == 0 {
+		return nil, fmt.Errorf("%w: role cannot be empty", entity.ErrInvalidUser)
+	}
+	user, err := entity.NewUser(u.Roles[0], u.Address, createdAt)
+	if err != nil {
+		return nil, err
+	}
+	for _, role := range u.Roles[1:] {
+		if _, err := user.GrantRole(entity.UserRole(role), createdAt); err != nil {
+			return nil, err
+		}
+	}
+	// granting a role touches the user, which is only being created
+	user.UpdatedAt = 0
+	return user, nil
+}
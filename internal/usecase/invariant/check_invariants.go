@@ -0,0 +1,216 @@
+package invariant
+
+import (
+	"slices"
+	"sort"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+	"github.com/holiman/uint256"
+)
+
+const (
+	// InvariantEscrowCoverage holds when the application balance of every token covers the
+	// pending orders escrowed in it and the collateral of the open issuances held in it.
+	InvariantEscrowCoverage = "escrow_coverage"
+	// InvariantCollateralHeld holds when the collateral deposited for an open issuance, minus
+	// the collateral paid out for it, is its collateral amount.
+	InvariantCollateralHeld = "collateral_held"
+	// InvariantCollateralPayout holds when the collateral paid out for an issuance does not
+	// exceed its collateral amount.
+	InvariantCollateralPayout = "collateral_payout"
+	// InvariantTotalRaised holds when the accepted orders of a closed issuance add up to its
+	// total raised.
+	InvariantTotalRaised = "total_raised"
+)
+
+// openStates are the states of issuances whose collateral is held by the application.
+var openStates = []entity.IssuanceState{
+	entity.IssuanceStateOngoing,
+	entity.IssuanceStateClosed,
+}
+
+// raisedStates are the states of issuances whose orders were accepted.
+var raisedStates = []entity.IssuanceState{
+	entity.IssuanceStateClosed,
+	entity.IssuanceStateSettled,
+	entity.IssuanceStateCollateralExecuted,
+}
+
+// acceptedStates are the states of orders that were accepted when their issuance closed.
+var acceptedStates = []entity.OrderState{
+	entity.OrderStateAccepted,
+	entity.OrderStatePartiallyAccepted,
+	entity.OrderStateSettled,
+	entity.OrderStateSettledByCollateral,
+}
+
+// CheckInvariantsInputDTO is built by the invariants middleware from the application wallet
+// and the issuances the input wrote.
+type CheckInvariantsInputDTO struct {
+	// Balances holds the wallet balance of the application for every token it may hold.
+	// Tokens missing from it have a zero balance.
+	Balances map[Address]*uint256.Int
+	// IssuanceIds are the issuances checked one by one. Issuances the input did not write
+	// still hold the invariants checked when they were last written.
+	IssuanceIds []uint
+}
+
+type ViolationOutputDTO struct {
+	Invariant  string       `json:"invariant"`
+	Token      Address      `json:"token"`
+	IssuanceId uint         `json:"issuance_id,omitempty"`
+	Expected   *uint256.Int `json:"expected"`
+	Actual     *uint256.Int `json:"actual"`
+}
+
+type CheckInvariantsOutputDTO []*ViolationOutputDTO
+
+type CheckInvariantsUseCase struct {
+	IssuanceRepository repository.IssuanceRepository
+	OrderRepository    repository.OrderRepository
+	LedgerRepository   repository.LedgerRepository
+}
+
+func NewCheckInvariantsUseCase(issuanceRepo repository.IssuanceRepository, orderRepo repository.OrderRepository, ledgerRepo repository.LedgerRepository) *CheckInvariantsUseCase {
+	return &CheckInvariantsUseCase{
+		IssuanceRepository: issuanceRepo,
+		OrderRepository:    orderRepo,
+		LedgerRepository:   ledgerRepo,
+	}
+}
+
+// Execute returns the violated invariants, or an empty list when every invariant holds.
+func (u *CheckInvariantsUseCase) Execute(input *CheckInvariantsInputDTO) (CheckInvariantsOutputDTO, error) {
+	violations := CheckInvariantsOutputDTO{}
+	issuanceIds := slices.Sorted(slices.Values(input.IssuanceIds))
+	for _, id := range slices.Compact(issuanceIds) {
+		issuanceViolations, err := u.checkIssuance(id)
+		if err != nil {
+			return nil, err
+		}
+		violations = append(violations, issuanceViolations...)
+	}
+
+	// escrow coverage spans every issuance, so it is checked on the amounts summed per token
+	required := make(map[Address]*uint256.Int)
+	collateralAmounts, err := u.IssuanceRepository.FindCollateralAmountsByToken(openStates...)
+	if err != nil {
+		return nil, err
+	}
+	orderAmounts, err := u.OrderRepository.FindOrderAmountsByToken(entity.OrderStatePending)
+	if err != nil {
+		return nil, err
+	}
+	for _, tokenAmounts := range slices.Concat(collateralAmounts, orderAmounts) {
+		for _, amount := range tokenAmounts.Amounts {
+			addTo(required, tokenAmounts.Token, amount)
+		}
+	}
+
+	tokens := make([]Address, 0, len(required))
+	for token := range required {
+		tokens = append(tokens, token)
+	}
+	sort.Slice(tokens, func(i, j int) bool { return tokens[i].Hex() < tokens[j].Hex() })
+	for _, token := range tokens {
+		balance := amountOf(input.Balances, token)
+		if balance.Lt(required[token]) {
+			violations = append(violations, &ViolationOutputDTO{
+				Invariant: InvariantEscrowCoverage,
+				Token:     token,
+				Expected:  required[token],
+				Actual:    balance,
+			})
+		}
+	}
+	return violations, nil
+}
+
+// checkIssuance returns the violated invariants of a single issuance.
+func (u *CheckInvariantsUseCase) checkIssuance(id uint) (CheckInvariantsOutputDTO, error) {
+	issuance, err := u.IssuanceRepository.FindIssuanceById(id)
+	if err != nil {
+		return nil, err
+	}
+	entries, err := u.LedgerRepository.FindLedgerEntriesByIssuanceId(id)
+	if err != nil {
+		return nil, err
+	}
+
+	deposited := uint256.NewInt(0)
+	paidOut := uint256.NewInt(0)
+	for _, entry := range entries {
+		switch entry.Reason {
+		case entity.LedgerReasonCollateralDeposit:
+			deposited.Add(deposited, entry.Amount)
+		case entity.LedgerReasonCollateralExecution:
+			paidOut.Add(paidOut, entry.Amount)
+		}
+	}
+
+	violations := CheckInvariantsOutputDTO{}
+	held := new(uint256.Int)
+	if paidOut.Cmp(deposited) <= 0 {
+		held.Sub(deposited, paidOut)
+	}
+	if slices.Contains(openStates, issuance.State) && !held.Eq(issuance.CollateralAmount) {
+		violations = append(violations, &ViolationOutputDTO{
+			Invariant:  InvariantCollateralHeld,
+			Token:      issuance.CollateralAddress,
+			IssuanceId: issuance.Id,
+			Expected:   issuance.CollateralAmount,
+			Actual:     held,
+		})
+	}
+	if paidOut.Gt(issuance.CollateralAmount) {
+		violations = append(violations, &ViolationOutputDTO{
+			Invariant:  InvariantCollateralPayout,
+			Token:      issuance.CollateralAddress,
+			IssuanceId: issuance.Id,
+			Expected:   issuance.CollateralAmount,
+			Actual:     paidOut,
+		})
+	}
+
+	if slices.Contains(raisedStates, issuance.State) {
+		accepted := uint256.NewInt(0)
+		for _, order := range issuance.Orders {
+			if slices.Contains(acceptedStates, order.State) {
+				accepted.Add(accepted, order.Amount)
+			}
+		}
+		totalRaised := issuance.TotalRaised
+		if totalRaised == nil {
+			totalRaised = uint256.NewInt(0)
+		}
+		if !accepted.Eq(totalRaised) {
+			violations = append(violations, &ViolationOutputDTO{
+				Invariant:  InvariantTotalRaised,
+				Token:      issuance.Token,
+				IssuanceId: issuance.Id,
+				Expected:   totalRaised,
+				Actual:     accepted,
+			})
+		}
+	}
+	return violations, nil
+}
+
+func addTo[K comparable](amounts map[K]*uint256.Int, key K, amount *uint256.Int) {
+	if amount == nil {
+		return
+	}
+	if _, ok := amounts[key]; !ok {
+		amounts[key] = uint256.NewInt(0)
+	}
+	amounts[key].Add(amounts[key], amount)
+}
+
+func amountOf[K comparable](amounts map[K]*uint256.Int, key K) *uint256.Int {
+	if amount, ok := amounts[key]; ok && amount != nil {
+		return amount
+	}
+	return uint256.NewInt(0)
+}
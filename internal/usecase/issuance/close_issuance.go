@@ -2,13 +2,16 @@ package issuance
 
 import (
 	"fmt"
+	"slices"
 	"sort"
 
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain"
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/order"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/reputation"
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/user"
-	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/api"
 	"github.com/holiman/uint256"
 	"github.com/rollmelette/rollmelette"
 )
@@ -20,43 +23,23 @@ var (
 	BasisPointsDivisor = uint256.NewInt(10000)
 )
 
-type CloseIssuanceInputDTO struct {
-	CreatorAddress Address `json:"creator_address" validate:"required"`
-}
+type CloseIssuanceInputDTO = api.CloseIssuanceInputDTO
 
-type CloseIssuanceOutputDTO struct {
-	Id                uint                    `json:"id"`
-	Title             string                  `json:"title,omitempty"`
-	Description       string                  `json:"description,omitempty"`
-	Promotion         string                  `json:"promotion,omitempty"`
-	Token             Address                 `json:"token,omitempty"`
-	Creator           *user.UserOutputDTO     `json:"creator,omitempty"`
-	CollateralAddress Address                 `json:"collateral,omitempty"`
-	CollateralAmount  *uint256.Int            `json:"collateral_amount,omitempty"`
-	BadgeAddress      Address                 `json:"badge_address,omitempty"`
-	DebtIssued        *uint256.Int            `json:"debt_issued,omitempty"`
-	MaxInterestRate   *uint256.Int            `json:"max_interest_rate,omitempty"`
-	TotalObligation   *uint256.Int            `json:"total_obligation,omitempty"`
-	TotalRaised       *uint256.Int            `json:"total_raised,omitempty"`
-	State             string                  `json:"state,omitempty"`
-	Orders            []*order.OrderOutputDTO `json:"orders,omitempty"`
-	CreatedAt         int64                   `json:"created_at,omitempty"`
-	ClosesAt          int64                   `json:"closes_at,omitempty"`
-	MaturityAt        int64                   `json:"maturity_at,omitempty"`
-	UpdatedAt         int64                   `json:"updated_at,omitempty"`
-}
+type CloseIssuanceOutputDTO = api.CloseIssuanceOutputDTO
 
 type CloseIssuanceUseCase struct {
-	UserRepository     repository.UserRepository
-	OrderRepository    repository.OrderRepository
-	IssuanceRepository repository.IssuanceRepository
+	UserRepository       repository.UserRepository
+	OrderRepository      repository.OrderRepository
+	IssuanceRepository   repository.IssuanceRepository
+	ReputationRepository repository.ReputationRepository
 }
 
-func NewCloseIssuanceUseCase(userRepo repository.UserRepository, issuanceRepo repository.IssuanceRepository, orderRepo repository.OrderRepository) *CloseIssuanceUseCase {
+func NewCloseIssuanceUseCase(userRepo repository.UserRepository, issuanceRepo repository.IssuanceRepository, orderRepo repository.OrderRepository, reputationRepo repository.ReputationRepository) *CloseIssuanceUseCase {
 	return &CloseIssuanceUseCase{
-		UserRepository:     userRepo,
-		IssuanceRepository: issuanceRepo,
-		OrderRepository:    orderRepo,
+		UserRepository:       userRepo,
+		IssuanceRepository:   issuanceRepo,
+		OrderRepository:      orderRepo,
+		ReputationRepository: reputationRepo,
 	}
 }
 
@@ -73,7 +56,7 @@ func (u *CloseIssuanceUseCase) Execute(input *CloseIssuanceInputDTO, metadata ro
 	// 2. Validate issuance expiration
 	// -------------------------------------------------------------------------
 	if metadata.BlockTimestamp < ongoingIssuance.ClosesAt {
-		return nil, fmt.Errorf("issuance not expired yet, cannot close it")
+		return nil, domain.ErrIssuanceNotExpired.New(ongoingIssuance.Id, ongoingIssuance.ClosesAt)
 	}
 
 	// -------------------------------------------------------------------------
@@ -83,6 +66,10 @@ func (u *CloseIssuanceUseCase) Execute(input *CloseIssuanceInputDTO, metadata ro
 	if err != nil {
 		return nil, err
 	}
+	// canceled orders were already refunded and take no part in the close
+	orders = slices.DeleteFunc(orders, func(o *entity.Order) bool {
+		return o.State != entity.OrderStatePending
+	})
 	sort.Slice(orders, func(i, j int) bool {
 		cmp := orders[i].InterestRate.Cmp(orders[j].InterestRate)
 		if cmp == 0 {
@@ -173,7 +160,11 @@ func (u *CloseIssuanceUseCase) Execute(input *CloseIssuanceInputDTO, metadata ro
 		if _, err := u.IssuanceRepository.UpdateIssuance(ongoingIssuance); err != nil {
 			return nil, err
 		}
-		return nil, fmt.Errorf("issuance canceled due to insufficient funds collected, expected at least 2/3 of the debt issued: %s, got: %s", twoThirds.String(), totalCollected.String())
+		if _, err := reputation.NewEngine(u.UserRepository, u.IssuanceRepository, u.ReputationRepository).
+			Refresh(ongoingIssuance.CreatorAddress, metadata.BlockTimestamp); err != nil {
+			return nil, fmt.Errorf("error refreshing creator reputation: %w", err)
+		}
+		return nil, domain.ErrIssuanceUnderfunded.New(ongoingIssuance.Id, twoThirds, totalCollected)
 	}
 
 	// -------------------------------------------------------------------------
@@ -192,6 +183,11 @@ func (u *CloseIssuanceUseCase) Execute(input *CloseIssuanceInputDTO, metadata ro
 	if err != nil {
 		return nil, fmt.Errorf("error finding creator: %w", err)
 	}
+	creator.Reputation, err = reputation.NewEngine(u.UserRepository, u.IssuanceRepository, u.ReputationRepository).
+		Refresh(creator.Address, metadata.BlockTimestamp)
+	if err != nil {
+		return nil, fmt.Errorf("error refreshing creator reputation: %w", err)
+	}
 
 	orderDTOs := make([]*order.OrderOutputDTO, len(res.Orders))
 	for i, o := range res.Orders {
@@ -204,9 +200,11 @@ func (u *CloseIssuanceUseCase) Execute(input *CloseIssuanceInputDTO, metadata ro
 			IssuanceId: o.IssuanceId,
 			Investor: &user.UserOutputDTO{
 				Id:             investor.Id,
-				Role:           string(investor.Role),
+				Roles:          investor.RoleNames(),
 				Address:        investor.Address,
-				SocialAccounts: investor.SocialAccounts,
+				Status:         string(investor.Status),
+				SocialAccounts: user.SocialAccountsOf(investor),
+				Reputation:     reputation.Of(investor),
 				CreatedAt:      investor.CreatedAt,
 				UpdatedAt:      investor.UpdatedAt,
 			},
@@ -226,9 +224,11 @@ func (u *CloseIssuanceUseCase) Execute(input *CloseIssuanceInputDTO, metadata ro
 		Token:       res.Token,
 		Creator: &user.UserOutputDTO{
 			Id:             creator.Id,
-			Role:           string(creator.Role),
+			Roles:          creator.RoleNames(),
 			Address:        creator.Address,
-			SocialAccounts: creator.SocialAccounts,
+			Status:         string(creator.Status),
+			SocialAccounts: user.SocialAccountsOf(creator),
+			Reputation:     reputation.Of(creator),
 			CreatedAt:      creator.CreatedAt,
 			UpdatedAt:      creator.UpdatedAt,
 		},
@@ -5,9 +5,12 @@ import (
 	"strconv"
 
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/assets"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain"
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/reputation"
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/user"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/api"
 	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
@@ -16,58 +19,45 @@ import (
 	"github.com/rollmelette/rollmelette"
 )
 
-type CreateIssuanceInputDTO struct {
-	Title           string       `json:"title" validate:"required,min=3,max=100"`
-	Description     string       `json:"description" validate:"required,min=10,max=1000"`
-	Promotion       string       `json:"promotion" validate:"required,min=5,max=500"`
-	Token           Address      `json:"token" validate:"required"`
-	DebtIssued      *uint256.Int `json:"debt_issued" validate:"required"`
-	MaxInterestRate *uint256.Int `json:"max_interest_rate" validate:"required"`
-	ClosesAt        int64        `json:"closes_at" validate:"required"`
-	MaturityAt      int64        `json:"maturity_at" validate:"required"`
-}
+type CreateIssuanceInputDTO = api.CreateIssuanceInputDTO
 
-type CreateIssuanceOutputDTO struct {
-	Id                uint                `json:"id"`
-	Title             string              `json:"title,omitempty"`
-	Description       string              `json:"description,omitempty"`
-	Promotion         string              `json:"promotion,omitempty"`
-	Token             Address             `json:"token,omitempty"`
-	Creator           *user.UserOutputDTO `json:"creator,omitempty"`
-	CollateralAddress Address             `json:"collateral,omitempty"`
-	CollateralAmount  *uint256.Int        `json:"collateral_amount,omitempty"`
-	BadgeAddress      Address             `json:"badge_address,omitempty"`
-	DebtIssued        *uint256.Int        `json:"debt_issued"`
-	MaxInterestRate   *uint256.Int        `json:"max_interest_rate"`
-	State             string              `json:"state"`
-	Orders            []*entity.Order     `json:"orders"`
-	CreatedAt         int64               `json:"created_at"`
-	ClosesAt          int64               `json:"closes_at"`
-	MaturityAt        int64               `json:"maturity_at"`
-}
+type CreateIssuanceOutputDTO = api.CreateIssuanceOutputDTO
 
 type CreateIssuanceUseCase struct {
-	BadgeFactoryAddress common.Address
-	IssuanceRepository  repository.IssuanceRepository
-	UserRepository      repository.UserRepository
+	BadgeFactoryAddress   common.Address
+	IssuanceRepository    repository.IssuanceRepository
+	UserRepository        repository.UserRepository
+	AttestationRepository repository.AttestationRepository
+	ReputationRepository  repository.ReputationRepository
+	GenesisRepository     repository.GenesisRepository
+	// ReputationGates are the minimum creator scores required by debt issued. Empty disables the gate.
+	ReputationGates []reputation.Gate
 }
 
 func NewCreateIssuanceUseCase(
 	badgeFactoryAddress common.Address,
 	issuanceRepo repository.IssuanceRepository,
 	userRepo repository.UserRepository,
+	attestationRepo repository.AttestationRepository,
+	reputationRepo repository.ReputationRepository,
+	genesisRepo repository.GenesisRepository,
+	reputationGates []reputation.Gate,
 ) *CreateIssuanceUseCase {
 	return &CreateIssuanceUseCase{
-		BadgeFactoryAddress: badgeFactoryAddress,
-		IssuanceRepository:  issuanceRepo,
-		UserRepository:      userRepo,
+		BadgeFactoryAddress:   badgeFactoryAddress,
+		IssuanceRepository:    issuanceRepo,
+		UserRepository:        userRepo,
+		AttestationRepository: attestationRepo,
+		ReputationRepository:  reputationRepo,
+		GenesisRepository:     genesisRepo,
+		ReputationGates:       reputationGates,
 	}
 }
 
 func (c *CreateIssuanceUseCase) Execute(input *CreateIssuanceInputDTO, deposit rollmelette.Deposit, metadata rollmelette.Metadata) (*CreateIssuanceOutputDTO, error) {
 	erc20Deposit, ok := deposit.(*rollmelette.ERC20Deposit)
 	if !ok {
-		return nil, fmt.Errorf("invalid deposit types: %T", deposit)
+		return nil, domain.ErrInvalidDeposit.New(fmt.Sprintf("%T", deposit))
 	}
 
 	creator, err := c.UserRepository.FindUserByAddress(Address(erc20Deposit.Sender))
@@ -90,7 +80,7 @@ func (c *CreateIssuanceUseCase) Execute(input *CreateIssuanceInputDTO, deposit r
 	}
 	for _, issuance := range issuances {
 		if issuance.State == entity.IssuanceStateOngoing || issuance.State == entity.IssuanceStateClosed {
-			return nil, fmt.Errorf("cannot create a new issuance: creator has an active issuance (id: %d, state: %s)", issuance.Id, issuance.State)
+			return nil, domain.ErrCreatorHasActiveIssuance.New(issuance.Id, issuance.State)
 		}
 	}
 
@@ -119,6 +109,8 @@ func (c *CreateIssuanceUseCase) Execute(input *CreateIssuanceInputDTO, deposit r
 		Address(badgeAddress),
 		input.DebtIssued,
 		input.MaxInterestRate,
+		attestationTypes(input.RequiredCreatorAttestations),
+		attestationTypes(input.RequiredInvestorAttestations),
 		input.ClosesAt,
 		input.MaturityAt,
 		metadata.BlockTimestamp,
@@ -132,6 +124,12 @@ func (c *CreateIssuanceUseCase) Execute(input *CreateIssuanceInputDTO, deposit r
 		return nil, fmt.Errorf("error creating Issuance: %w", err)
 	}
 
+	creator.Reputation, err = reputation.NewEngine(c.UserRepository, c.IssuanceRepository, c.ReputationRepository).
+		Refresh(creator.Address, metadata.BlockTimestamp)
+	if err != nil {
+		return nil, fmt.Errorf("error refreshing creator reputation: %w", err)
+	}
+
 	return &CreateIssuanceOutputDTO{
 		Id:          createdIssuance.Id,
 		Title:       createdIssuance.Title,
@@ -140,22 +138,26 @@ func (c *CreateIssuanceUseCase) Execute(input *CreateIssuanceInputDTO, deposit r
 		Token:       createdIssuance.Token,
 		Creator: &user.UserOutputDTO{
 			Id:             creator.Id,
-			Role:           string(creator.Role),
+			Roles:          creator.RoleNames(),
 			Address:        creator.Address,
-			SocialAccounts: creator.SocialAccounts,
+			Status:         string(creator.Status),
+			SocialAccounts: user.SocialAccountsOf(creator),
+			Reputation:     reputation.Of(creator),
 			CreatedAt:      creator.CreatedAt,
 			UpdatedAt:      creator.UpdatedAt,
 		},
-		CollateralAddress: createdIssuance.CollateralAddress,
-		CollateralAmount:  createdIssuance.CollateralAmount,
-		BadgeAddress:      createdIssuance.BadgeAddress,
-		DebtIssued:        createdIssuance.DebtIssued,
-		MaxInterestRate:   createdIssuance.MaxInterestRate,
-		Orders:            createdIssuance.Orders,
-		State:             string(createdIssuance.State),
-		ClosesAt:          createdIssuance.ClosesAt,
-		MaturityAt:        createdIssuance.MaturityAt,
-		CreatedAt:         createdIssuance.CreatedAt,
+		CollateralAddress:            createdIssuance.CollateralAddress,
+		CollateralAmount:             createdIssuance.CollateralAmount,
+		BadgeAddress:                 createdIssuance.BadgeAddress,
+		DebtIssued:                   createdIssuance.DebtIssued,
+		MaxInterestRate:              createdIssuance.MaxInterestRate,
+		Orders:                       []*api.OrderOutputDTO{},
+		State:                        string(createdIssuance.State),
+		RequiredCreatorAttestations:  attestationNames(createdIssuance.RequiredCreatorAttestations),
+		RequiredInvestorAttestations: attestationNames(createdIssuance.RequiredInvestorAttestations),
+		ClosesAt:                     createdIssuance.ClosesAt,
+		MaturityAt:                   createdIssuance.MaturityAt,
+		CreatedAt:                    createdIssuance.CreatedAt,
 	}, nil
 }
 
@@ -166,19 +168,46 @@ func (c *CreateIssuanceUseCase) Validate(
 	metadata rollmelette.Metadata,
 ) error {
 	if len(user.SocialAccounts) == 0 {
-		return fmt.Errorf("%w: user has no social accounts, please verify at least one social account", entity.ErrInvalidIssuance)
+		return domain.ErrCreatorWithoutSocial.New(user.Address)
+	}
+
+	allowedTokens, err := c.GenesisRepository.FindAllAllowedTokens()
+	if err != nil {
+		return fmt.Errorf("error finding allowed tokens: %w", err)
+	}
+	if err := entity.CheckTokenAllowed(input.Token, allowedTokens); err != nil {
+		return err
+	}
+	if err := entity.CheckTokenAllowed(Address(deposit.Token), allowedTokens); err != nil {
+		return err
 	}
 
 	if input.ClosesAt > metadata.BlockTimestamp+180*24*60*60 {
-		return fmt.Errorf("%w: close date cannot be greater than 180 days", entity.ErrInvalidIssuance)
+		return domain.ErrIssuanceCloseTooFar.New(180)
 	}
 
 	if input.ClosesAt > input.MaturityAt {
-		return fmt.Errorf("%w: close date cannot be greater than maturity date", entity.ErrInvalidIssuance)
+		return domain.ErrIssuanceCloseAfterMaturity.New(input.ClosesAt, input.MaturityAt)
 	}
 
 	if metadata.BlockTimestamp >= input.ClosesAt {
-		return fmt.Errorf("%w: creation date cannot be greater than or equal to close date", entity.ErrInvalidIssuance)
+		return domain.ErrIssuanceCloseInPast.New(input.ClosesAt, metadata.BlockTimestamp)
+	}
+
+	if len(input.RequiredCreatorAttestations) > 0 {
+		attestations, err := c.AttestationRepository.FindAttestationsBySubjectId(user.Id)
+		if err != nil {
+			return fmt.Errorf("error finding creator attestations: %w", err)
+		}
+		if missing, ok := entity.MissingAttestation(attestationTypes(input.RequiredCreatorAttestations), attestations, metadata.BlockTimestamp); ok {
+			return domain.ErrAttestationRequired.New("creator", user.Address, missing)
+		}
+	}
+
+	if required := reputation.RequiredScore(c.ReputationGates, input.DebtIssued); required > 0 {
+		if score := reputation.Of(user).Score; score < required {
+			return domain.ErrReputationTooLow.New(user.Address, score, input.DebtIssued, required)
+		}
 	}
 	return nil
 }
@@ -3,52 +3,33 @@ package issuance
 import (
 	"fmt"
 
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain"
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/order"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/reputation"
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/user"
-	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
-	"github.com/holiman/uint256"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/api"
 	"github.com/rollmelette/rollmelette"
 )
 
-type ExecuteIssuanceCollateralInputDTO struct {
-	Id uint `json:"id" validate:"required"`
-}
+type ExecuteIssuanceCollateralInputDTO = api.ExecuteIssuanceCollateralInputDTO
 
-type ExecuteIssuanceCollateralOutputDTO struct {
-	Id                uint                    `json:"id"`
-	Title             string                  `json:"title,omitempty"`
-	Description       string                  `json:"description,omitempty"`
-	Promotion         string                  `json:"promotion,omitempty"`
-	Token             Address                 `json:"token"`
-	Creator           *user.UserOutputDTO     `json:"creator"`
-	CollateralAddress Address                 `json:"collateral"`
-	CollateralAmount  *uint256.Int            `json:"collateral_amount"`
-	BadgeAddress      Address                 `json:"badge_address"`
-	DebtIssued        *uint256.Int            `json:"debt_issued"`
-	MaxInterestRate   *uint256.Int            `json:"max_interest_rate"`
-	TotalObligation   *uint256.Int            `json:"total_obligation"`
-	TotalRaised       *uint256.Int            `json:"total_raised"`
-	State             string                  `json:"state"`
-	Orders            []*order.OrderOutputDTO `json:"orders"`
-	CreatedAt         int64                   `json:"created_at"`
-	ClosesAt          int64                   `json:"closes_at"`
-	MaturityAt        int64                   `json:"maturity_at"`
-	UpdatedAt         int64                   `json:"updated_at"`
-}
+type ExecuteIssuanceCollateralOutputDTO = api.ExecuteIssuanceCollateralOutputDTO
 
 type ExecuteIssuanceCollateralUseCase struct {
-	UserRepository     repository.UserRepository
-	IssuanceRepository repository.IssuanceRepository
-	OrderRepository    repository.OrderRepository
+	UserRepository       repository.UserRepository
+	IssuanceRepository   repository.IssuanceRepository
+	OrderRepository      repository.OrderRepository
+	ReputationRepository repository.ReputationRepository
 }
 
-func NewExecuteIssuanceCollateralUseCase(userRepo repository.UserRepository, issuanceRepo repository.IssuanceRepository, orderRepo repository.OrderRepository) *ExecuteIssuanceCollateralUseCase {
+func NewExecuteIssuanceCollateralUseCase(userRepo repository.UserRepository, issuanceRepo repository.IssuanceRepository, orderRepo repository.OrderRepository, reputationRepo repository.ReputationRepository) *ExecuteIssuanceCollateralUseCase {
 	return &ExecuteIssuanceCollateralUseCase{
-		UserRepository:     userRepo,
-		IssuanceRepository: issuanceRepo,
-		OrderRepository:    orderRepo,
+		UserRepository:       userRepo,
+		IssuanceRepository:   issuanceRepo,
+		OrderRepository:      orderRepo,
+		ReputationRepository: reputationRepo,
 	}
 }
 
@@ -84,6 +65,11 @@ func (uc *ExecuteIssuanceCollateralUseCase) Execute(input *ExecuteIssuanceCollat
 	if err != nil {
 		return nil, fmt.Errorf("error finding creator: %w", err)
 	}
+	creator.Reputation, err = reputation.NewEngine(uc.UserRepository, uc.IssuanceRepository, uc.ReputationRepository).
+		Refresh(creator.Address, metadata.BlockTimestamp)
+	if err != nil {
+		return nil, fmt.Errorf("error refreshing creator reputation: %w", err)
+	}
 
 	orderDTOs := make([]*order.OrderOutputDTO, len(res.Orders))
 	for i, o := range res.Orders {
@@ -96,9 +82,11 @@ func (uc *ExecuteIssuanceCollateralUseCase) Execute(input *ExecuteIssuanceCollat
 			IssuanceId: o.IssuanceId,
 			Investor: &user.UserOutputDTO{
 				Id:             investor.Id,
-				Role:           string(investor.Role),
+				Roles:          investor.RoleNames(),
 				Address:        investor.Address,
-				SocialAccounts: investor.SocialAccounts,
+				Status:         string(investor.Status),
+				SocialAccounts: user.SocialAccountsOf(investor),
+				Reputation:     reputation.Of(investor),
 				CreatedAt:      investor.CreatedAt,
 				UpdatedAt:      investor.UpdatedAt,
 			},
@@ -118,9 +106,11 @@ func (uc *ExecuteIssuanceCollateralUseCase) Execute(input *ExecuteIssuanceCollat
 		Token:       res.Token,
 		Creator: &user.UserOutputDTO{
 			Id:             creator.Id,
-			Role:           string(creator.Role),
+			Roles:          creator.RoleNames(),
 			Address:        creator.Address,
-			SocialAccounts: creator.SocialAccounts,
+			Status:         string(creator.Status),
+			SocialAccounts: user.SocialAccountsOf(creator),
+			Reputation:     reputation.Of(creator),
 			CreatedAt:      creator.CreatedAt,
 			UpdatedAt:      creator.UpdatedAt,
 		},
@@ -142,10 +132,10 @@ func (uc *ExecuteIssuanceCollateralUseCase) Execute(input *ExecuteIssuanceCollat
 
 func (uc *ExecuteIssuanceCollateralUseCase) Validate(issuance *entity.Issuance, metadata rollmelette.Metadata) error {
 	if metadata.BlockTimestamp < issuance.MaturityAt {
-		return fmt.Errorf("the maturity date of the issuance issuance has not passed")
+		return domain.ErrIssuanceNotMatured.New(issuance.Id)
 	}
 	if issuance.State != entity.IssuanceStateClosed {
-		return fmt.Errorf("issuance issuance not closed")
+		return domain.ErrIssuanceNotClosed.New(issuance.Id)
 	}
 	return nil
 }
@@ -5,10 +5,12 @@ import (
 
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/order"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/reputation"
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/user"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/api"
 )
 
-type FindAllIssuancesOutputDTO []*IssuanceOutputDTO
+type FindAllIssuancesOutputDTO = api.FindAllIssuancesOutputDTO
 
 type FindAllIssuancesUseCase struct {
 	UserRepository     repository.UserRepository
@@ -40,9 +42,11 @@ func (f *FindAllIssuancesUseCase) Execute() (*FindAllIssuancesOutputDTO, error)
 				IssuanceId: o.IssuanceId,
 				Investor: &user.UserOutputDTO{
 					Id:             investor.Id,
-					Role:           string(investor.Role),
+					Roles:          investor.RoleNames(),
 					Address:        investor.Address,
-					SocialAccounts: investor.SocialAccounts,
+					Status:         string(investor.Status),
+					SocialAccounts: user.SocialAccountsOf(investor),
+					Reputation:     reputation.Of(investor),
 					CreatedAt:      investor.CreatedAt,
 					UpdatedAt:      investor.UpdatedAt,
 				},
@@ -65,25 +69,29 @@ func (f *FindAllIssuancesUseCase) Execute() (*FindAllIssuancesOutputDTO, error)
 			Token:       issuance.Token,
 			Creator: &user.UserOutputDTO{
 				Id:             creator.Id,
-				Role:           string(creator.Role),
+				Roles:          creator.RoleNames(),
 				Address:        creator.Address,
-				SocialAccounts: creator.SocialAccounts,
+				Status:         string(creator.Status),
+				SocialAccounts: user.SocialAccountsOf(creator),
+				Reputation:     reputation.Of(creator),
 				CreatedAt:      creator.CreatedAt,
 				UpdatedAt:      creator.UpdatedAt,
 			},
-			CollateralAddress: issuance.CollateralAddress,
-			CollateralAmount:  issuance.CollateralAmount,
-			BadgeAddress:      issuance.BadgeAddress,
-			DebtIssued:        issuance.DebtIssued,
-			MaxInterestRate:   issuance.MaxInterestRate,
-			TotalObligation:   issuance.TotalObligation,
-			TotalRaised:       issuance.TotalRaised,
-			State:             string(issuance.State),
-			Orders:            orders,
-			CreatedAt:         issuance.CreatedAt,
-			ClosesAt:          issuance.ClosesAt,
-			MaturityAt:        issuance.MaturityAt,
-			UpdatedAt:         issuance.UpdatedAt,
+			CollateralAddress:            issuance.CollateralAddress,
+			CollateralAmount:             issuance.CollateralAmount,
+			BadgeAddress:                 issuance.BadgeAddress,
+			DebtIssued:                   issuance.DebtIssued,
+			MaxInterestRate:              issuance.MaxInterestRate,
+			TotalObligation:              issuance.TotalObligation,
+			TotalRaised:                  issuance.TotalRaised,
+			State:                        string(issuance.State),
+			RequiredCreatorAttestations:  attestationNames(issuance.RequiredCreatorAttestations),
+			RequiredInvestorAttestations: attestationNames(issuance.RequiredInvestorAttestations),
+			Orders:                       orders,
+			CreatedAt:                    issuance.CreatedAt,
+			ClosesAt:                     issuance.ClosesAt,
+			MaturityAt:                   issuance.MaturityAt,
+			UpdatedAt:                    issuance.UpdatedAt,
 		}
 	}
 	return &output, nil
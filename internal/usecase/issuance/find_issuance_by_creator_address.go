@@ -5,15 +5,14 @@ import (
 
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/order"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/reputation"
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/user"
-	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/api"
 )
 
-type FindIssuancesByCreatorAddressInputDTO struct {
-	CreatorAddress Address `json:"creator" validate:"required"`
-}
+type FindIssuancesByCreatorAddressInputDTO = api.FindIssuancesByCreatorAddressInputDTO
 
-type FindIssuancesByCreatorAddressOutputDTO []*IssuanceOutputDTO
+type FindIssuancesByCreatorAddressOutputDTO = api.FindIssuancesByCreatorAddressOutputDTO
 
 type FindIssuancesByCreatorAddressUseCase struct {
 	UserRepository     repository.UserRepository
@@ -45,9 +44,11 @@ func (f *FindIssuancesByCreatorAddressUseCase) Execute(input *FindIssuancesByCre
 				IssuanceId: o.IssuanceId,
 				Investor: &user.UserOutputDTO{
 					Id:             investor.Id,
-					Role:           string(investor.Role),
+					Roles:          investor.RoleNames(),
 					Address:        investor.Address,
-					SocialAccounts: investor.SocialAccounts,
+					Status:         string(investor.Status),
+					SocialAccounts: user.SocialAccountsOf(investor),
+					Reputation:     reputation.Of(investor),
 					CreatedAt:      investor.CreatedAt,
 					UpdatedAt:      investor.UpdatedAt,
 				},
@@ -70,25 +71,29 @@ func (f *FindIssuancesByCreatorAddressUseCase) Execute(input *FindIssuancesByCre
 			Token:       issuance.Token,
 			Creator: &user.UserOutputDTO{
 				Id:             creator.Id,
-				Role:           string(creator.Role),
+				Roles:          creator.RoleNames(),
 				Address:        creator.Address,
-				SocialAccounts: creator.SocialAccounts,
+				Status:         string(creator.Status),
+				SocialAccounts: user.SocialAccountsOf(creator),
+				Reputation:     reputation.Of(creator),
 				CreatedAt:      creator.CreatedAt,
 				UpdatedAt:      creator.UpdatedAt,
 			},
-			CollateralAddress: issuance.CollateralAddress,
-			CollateralAmount:  issuance.CollateralAmount,
-			BadgeAddress:      issuance.BadgeAddress,
-			DebtIssued:        issuance.DebtIssued,
-			MaxInterestRate:   issuance.MaxInterestRate,
-			TotalObligation:   issuance.TotalObligation,
-			TotalRaised:       issuance.TotalRaised,
-			State:             string(issuance.State),
-			Orders:            orders,
-			CreatedAt:         issuance.CreatedAt,
-			ClosesAt:          issuance.ClosesAt,
-			MaturityAt:        issuance.MaturityAt,
-			UpdatedAt:         issuance.UpdatedAt,
+			CollateralAddress:            issuance.CollateralAddress,
+			CollateralAmount:             issuance.CollateralAmount,
+			BadgeAddress:                 issuance.BadgeAddress,
+			DebtIssued:                   issuance.DebtIssued,
+			MaxInterestRate:              issuance.MaxInterestRate,
+			TotalObligation:              issuance.TotalObligation,
+			TotalRaised:                  issuance.TotalRaised,
+			State:                        string(issuance.State),
+			RequiredCreatorAttestations:  attestationNames(issuance.RequiredCreatorAttestations),
+			RequiredInvestorAttestations: attestationNames(issuance.RequiredInvestorAttestations),
+			Orders:                       orders,
+			CreatedAt:                    issuance.CreatedAt,
+			ClosesAt:                     issuance.ClosesAt,
+			MaturityAt:                   issuance.MaturityAt,
+			UpdatedAt:                    issuance.UpdatedAt,
 		}
 	}
 	return &output, nil
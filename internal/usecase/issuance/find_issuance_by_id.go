@@ -5,12 +5,12 @@ import (
 
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/order"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/reputation"
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/user"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/api"
 )
 
-type FindIssuanceByIdInputDTO struct {
-	Id uint `json:"id" validate:"required"`
-}
+type FindIssuanceByIdInputDTO = api.FindIssuanceByIdInputDTO
 
 type FindIssuanceByIdUseCase struct {
 	UserRepository     repository.UserRepository
@@ -40,9 +40,11 @@ func (f *FindIssuanceByIdUseCase) Execute(input *FindIssuanceByIdInputDTO) (*Iss
 			IssuanceId: o.IssuanceId,
 			Investor: &user.UserOutputDTO{
 				Id:             investor.Id,
-				Role:           string(investor.Role),
+				Roles:          investor.RoleNames(),
 				Address:        investor.Address,
-				SocialAccounts: investor.SocialAccounts,
+				Status:         string(investor.Status),
+				SocialAccounts: user.SocialAccountsOf(investor),
+				Reputation:     reputation.Of(investor),
 				CreatedAt:      investor.CreatedAt,
 				UpdatedAt:      investor.UpdatedAt,
 			},
@@ -65,24 +67,28 @@ func (f *FindIssuanceByIdUseCase) Execute(input *FindIssuanceByIdInputDTO) (*Iss
 		Token:       res.Token,
 		Creator: &user.UserOutputDTO{
 			Id:             creator.Id,
-			Role:           string(creator.Role),
+			Roles:          creator.RoleNames(),
 			Address:        creator.Address,
-			SocialAccounts: creator.SocialAccounts,
+			Status:         string(creator.Status),
+			SocialAccounts: user.SocialAccountsOf(creator),
+			Reputation:     reputation.Of(creator),
 			CreatedAt:      creator.CreatedAt,
 			UpdatedAt:      creator.UpdatedAt,
 		},
-		CollateralAddress: res.CollateralAddress,
-		CollateralAmount:  res.CollateralAmount,
-		BadgeAddress:      res.BadgeAddress,
-		DebtIssued:        res.DebtIssued,
-		MaxInterestRate:   res.MaxInterestRate,
-		TotalObligation:   res.TotalObligation,
-		TotalRaised:       res.TotalRaised,
-		State:             string(res.State),
-		Orders:            orders,
-		CreatedAt:         res.CreatedAt,
-		ClosesAt:          res.ClosesAt,
-		MaturityAt:        res.MaturityAt,
-		UpdatedAt:         res.UpdatedAt,
+		CollateralAddress:            res.CollateralAddress,
+		CollateralAmount:             res.CollateralAmount,
+		BadgeAddress:                 res.BadgeAddress,
+		DebtIssued:                   res.DebtIssued,
+		MaxInterestRate:              res.MaxInterestRate,
+		TotalObligation:              res.TotalObligation,
+		TotalRaised:                  res.TotalRaised,
+		State:                        string(res.State),
+		RequiredCreatorAttestations:  attestationNames(res.RequiredCreatorAttestations),
+		RequiredInvestorAttestations: attestationNames(res.RequiredInvestorAttestations),
+		Orders:                       orders,
+		CreatedAt:                    res.CreatedAt,
+		ClosesAt:                     res.ClosesAt,
+		MaturityAt:                   res.MaturityAt,
+		UpdatedAt:                    res.UpdatedAt,
 	}, nil
 }
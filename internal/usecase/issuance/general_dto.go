@@ -1,30 +1,32 @@
 package issuance
 
 import (
-	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/order"
-	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/user"
-	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
-	"github.com/holiman/uint256"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/api"
 )
 
-type IssuanceOutputDTO struct {
-	Id                uint                    `json:"id"`
-	Title             string                  `json:"title,omitempty"`
-	Description       string                  `json:"description,omitempty"`
-	Promotion         string                  `json:"promotion,omitempty"`
-	Token             Address                 `json:"token"`
-	Creator           *user.UserOutputDTO     `json:"creator"`
-	CollateralAddress Address                 `json:"collateral"`
-	CollateralAmount  *uint256.Int            `json:"collateral_amount"`
-	BadgeAddress      Address                 `json:"badge_address"`
-	DebtIssued        *uint256.Int            `json:"debt_issued"`
-	MaxInterestRate   *uint256.Int            `json:"max_interest_rate"`
-	TotalObligation   *uint256.Int            `json:"total_obligation"`
-	TotalRaised       *uint256.Int            `json:"total_raised"`
-	State             string                  `json:"state"`
-	Orders            []*order.OrderOutputDTO `json:"orders"`
-	CreatedAt         int64                   `json:"created_at"`
-	ClosesAt          int64                   `json:"closes_at"`
-	MaturityAt        int64                   `json:"maturity_at"`
-	UpdatedAt         int64                   `json:"updated_at"`
+type IssuanceOutputDTO = api.IssuanceOutputDTO
+
+// attestationTypes converts the attestation types of an input to those of the entities.
+func attestationTypes(names []string) []entity.AttestationType {
+	if names == nil {
+		return nil
+	}
+	types := make([]entity.AttestationType, len(names))
+	for i, name := range names {
+		types[i] = entity.AttestationType(name)
+	}
+	return types
+}
+
+// attestationNames converts the attestation types of an issuance to those of the outputs.
+func attestationNames(types []entity.AttestationType) []string {
+	if types == nil {
+		return nil
+	}
+	names := make([]string, len(types))
+	for i, t := range types {
+		names[i] = string(t)
+	}
+	return names
 }
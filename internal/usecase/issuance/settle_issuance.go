@@ -3,56 +3,39 @@ package issuance
 import (
 	"fmt"
 
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain"
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/order"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/reputation"
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/user"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/api"
 	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
-	"github.com/holiman/uint256"
 	"github.com/rollmelette/rollmelette"
 )
 
-type SettleIssuanceInputDTO struct {
-	Id uint `json:"id" validate:"required"`
-}
+type SettleIssuanceInputDTO = api.SettleIssuanceInputDTO
 
-type SettleIssuanceOutputDTO struct {
-	Id                uint                    `json:"id"`
-	Title             string                  `json:"title,omitempty"`
-	Description       string                  `json:"description,omitempty"`
-	Promotion         string                  `json:"promotion,omitempty"`
-	Token             Address                 `json:"token"`
-	Creator           *user.UserOutputDTO     `json:"creator"`
-	CollateralAddress Address                 `json:"collateral"`
-	CollateralAmount  *uint256.Int            `json:"collateral_amount"`
-	BadgeAddress      Address                 `json:"badge_address"`
-	DebtIssued        *uint256.Int            `json:"debt_issued"`
-	MaxInterestRate   *uint256.Int            `json:"max_interest_rate"`
-	TotalObligation   *uint256.Int            `json:"total_obligation"`
-	TotalRaised       *uint256.Int            `json:"total_raised"`
-	State             string                  `json:"state"`
-	Orders            []*order.OrderOutputDTO `json:"orders"`
-	CreatedAt         int64                   `json:"created_at"`
-	ClosesAt          int64                   `json:"closes_at"`
-	MaturityAt        int64                   `json:"maturity_at"`
-	UpdatedAt         int64                   `json:"updated_at"`
-}
+type SettleIssuanceOutputDTO = api.SettleIssuanceOutputDTO
 
 type SettleIssuanceUseCase struct {
-	UserRepository     repository.UserRepository
-	IssuanceRepository repository.IssuanceRepository
-	OrderRepository    repository.OrderRepository
+	UserRepository       repository.UserRepository
+	IssuanceRepository   repository.IssuanceRepository
+	OrderRepository      repository.OrderRepository
+	ReputationRepository repository.ReputationRepository
 }
 
 func NewSettleIssuanceUseCase(
 	UserRepository repository.UserRepository,
 	IssuanceRepository repository.IssuanceRepository,
 	OrderRepository repository.OrderRepository,
+	ReputationRepository repository.ReputationRepository,
 ) *SettleIssuanceUseCase {
 	return &SettleIssuanceUseCase{
-		UserRepository:     UserRepository,
-		IssuanceRepository: IssuanceRepository,
-		OrderRepository:    OrderRepository,
+		UserRepository:       UserRepository,
+		IssuanceRepository:   IssuanceRepository,
+		OrderRepository:      OrderRepository,
+		ReputationRepository: ReputationRepository,
 	}
 }
 
@@ -63,7 +46,7 @@ func (uc *SettleIssuanceUseCase) Execute(
 ) (*SettleIssuanceOutputDTO, error) {
 	erc20Deposit, ok := deposit.(*rollmelette.ERC20Deposit)
 	if !ok {
-		return nil, fmt.Errorf("invalid deposit types: %T", deposit)
+		return nil, domain.ErrInvalidDeposit.New(fmt.Sprintf("%T", deposit))
 	}
 
 	issuance, err := uc.IssuanceRepository.FindIssuanceById(input.Id)
@@ -97,6 +80,11 @@ func (uc *SettleIssuanceUseCase) Execute(
 	if err != nil {
 		return nil, fmt.Errorf("error finding creator: %w", err)
 	}
+	creator.Reputation, err = reputation.NewEngine(uc.UserRepository, uc.IssuanceRepository, uc.ReputationRepository).
+		Refresh(creator.Address, metadata.BlockTimestamp)
+	if err != nil {
+		return nil, fmt.Errorf("error refreshing creator reputation: %w", err)
+	}
 
 	orderDTOs := make([]*order.OrderOutputDTO, len(res.Orders))
 	for i, o := range res.Orders {
@@ -109,9 +97,11 @@ func (uc *SettleIssuanceUseCase) Execute(
 			IssuanceId: o.IssuanceId,
 			Investor: &user.UserOutputDTO{
 				Id:             investor.Id,
-				Role:           string(investor.Role),
+				Roles:          investor.RoleNames(),
 				Address:        investor.Address,
-				SocialAccounts: investor.SocialAccounts,
+				Status:         string(investor.Status),
+				SocialAccounts: user.SocialAccountsOf(investor),
+				Reputation:     reputation.Of(investor),
 				CreatedAt:      investor.CreatedAt,
 				UpdatedAt:      investor.UpdatedAt,
 			},
@@ -131,9 +121,11 @@ func (uc *SettleIssuanceUseCase) Execute(
 		Token:       res.Token,
 		Creator: &user.UserOutputDTO{
 			Id:             creator.Id,
-			Role:           string(creator.Role),
+			Roles:          creator.RoleNames(),
 			Address:        creator.Address,
-			SocialAccounts: creator.SocialAccounts,
+			Status:         string(creator.Status),
+			SocialAccounts: user.SocialAccountsOf(creator),
+			Reputation:     reputation.Of(creator),
 			CreatedAt:      creator.CreatedAt,
 			UpdatedAt:      creator.UpdatedAt,
 		},
@@ -159,23 +151,23 @@ func (uc *SettleIssuanceUseCase) Validate(
 	metadata rollmelette.Metadata,
 ) error {
 	if metadata.BlockTimestamp > Issuance.MaturityAt {
-		return fmt.Errorf("the maturity date of the issuance issuance has passed")
+		return domain.ErrIssuanceMatured.New(Issuance.Id)
 	}
 
 	if Issuance.State == entity.IssuanceStateSettled {
-		return fmt.Errorf("issuance issuance already settled")
+		return domain.ErrIssuanceAlreadySettled.New(Issuance.Id)
 	}
 
 	if Issuance.State != entity.IssuanceStateClosed {
-		return fmt.Errorf("issuance issuance not closed")
+		return domain.ErrIssuanceNotClosed.New(Issuance.Id)
 	}
 
 	if deposit.Value.Cmp(Issuance.TotalObligation.ToBig()) < 0 {
-		return fmt.Errorf("deposit amount is lower than the total obligation")
+		return domain.ErrInsufficientSettlement.New(deposit.Value, Issuance.TotalObligation)
 	}
 
 	if Issuance.CreatorAddress != Address(deposit.Sender) {
-		return fmt.Errorf("only the issuance creator can settle the issuance")
+		return domain.ErrNotIssuanceCreator.New(Issuance.Id)
 	}
 	return nil
 }
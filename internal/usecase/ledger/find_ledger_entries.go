@@ -0,0 +1,47 @@
+package ledger
+
+import (
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/api"
+	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+)
+
+type FindLedgerEntriesInputDTO = api.FindLedgerEntriesInputDTO
+
+type FindLedgerEntriesOutputDTO = api.FindLedgerEntriesOutputDTO
+
+type FindLedgerEntriesUseCase struct {
+	LedgerRepository repository.LedgerRepository
+}
+
+func NewFindLedgerEntriesUseCase(ledgerRepo repository.LedgerRepository) *FindLedgerEntriesUseCase {
+	return &FindLedgerEntriesUseCase{
+		LedgerRepository: ledgerRepo,
+	}
+}
+
+func (u *FindLedgerEntriesUseCase) Execute(input *FindLedgerEntriesInputDTO) (FindLedgerEntriesOutputDTO, error) {
+	var res []*entity.LedgerEntry
+	var err error
+	switch {
+	case input.IssuanceId != 0:
+		res, err = u.LedgerRepository.FindLedgerEntriesByIssuanceId(input.IssuanceId)
+	case input.Account != (Address{}):
+		res, err = u.LedgerRepository.FindLedgerEntriesByAccount(input.Account)
+	default:
+		res, err = u.LedgerRepository.FindAllLedgerEntries()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	output := make(FindLedgerEntriesOutputDTO, 0, len(res))
+	for _, entry := range res {
+		if input.Account != (Address{}) && entry.DebitAccount != input.Account && entry.CreditAccount != input.Account {
+			continue
+		}
+		output = append(output, newLedgerEntryOutputDTO(entry))
+	}
+	return output, nil
+}
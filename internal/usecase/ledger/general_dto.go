@@ -0,0 +1,23 @@
+package ledger
+
+import (
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/api"
+)
+
+type LedgerEntryOutputDTO = api.LedgerEntryOutputDTO
+
+func newLedgerEntryOutputDTO(entry *entity.LedgerEntry) *LedgerEntryOutputDTO {
+	return &LedgerEntryOutputDTO{
+		Id:            entry.Id,
+		DebitAccount:  entry.DebitAccount,
+		CreditAccount: entry.CreditAccount,
+		Token:         entry.Token,
+		Amount:        entry.Amount,
+		Reason:        string(entry.Reason),
+		IssuanceId:    entry.IssuanceId,
+		OrderId:       entry.OrderId,
+		InputIndex:    entry.InputIndex,
+		CreatedAt:     entry.CreatedAt,
+	}
+}
@@ -0,0 +1,88 @@
+package ledger
+
+import (
+	"sort"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/api"
+	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+	"github.com/holiman/uint256"
+)
+
+// ReconcileLedgerInputDTO is built by the reconciliation handler from the application wallet.
+type ReconcileLedgerInputDTO struct {
+	Account Address
+	// Balances holds the wallet balance of the account for every token it may hold. Tokens
+	// missing from it have a zero balance.
+	Balances map[Address]*uint256.Int
+}
+
+type TokenReconciliationOutputDTO = api.TokenReconciliationOutputDTO
+
+type ReconcileLedgerOutputDTO = api.ReconcileLedgerOutputDTO
+
+type ReconcileLedgerUseCase struct {
+	LedgerRepository repository.LedgerRepository
+}
+
+func NewReconcileLedgerUseCase(ledgerRepo repository.LedgerRepository) *ReconcileLedgerUseCase {
+	return &ReconcileLedgerUseCase{
+		LedgerRepository: ledgerRepo,
+	}
+}
+
+// Execute checks, token by token, that the debits of the account minus its credits match its
+// wallet balance.
+func (u *ReconcileLedgerUseCase) Execute(input *ReconcileLedgerInputDTO) (*ReconcileLedgerOutputDTO, error) {
+	entries, err := u.LedgerRepository.FindLedgerEntriesByAccount(input.Account)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens := make(map[Address]*TokenReconciliationOutputDTO)
+	tokenAt := func(token Address) *TokenReconciliationOutputDTO {
+		if reconciliation, ok := tokens[token]; ok {
+			return reconciliation
+		}
+		reconciliation := &TokenReconciliationOutputDTO{
+			Token:         token,
+			Debits:        uint256.NewInt(0),
+			Credits:       uint256.NewInt(0),
+			WalletBalance: uint256.NewInt(0),
+		}
+		tokens[token] = reconciliation
+		return reconciliation
+	}
+
+	for _, entry := range entries {
+		reconciliation := tokenAt(entry.Token)
+		if entry.DebitAccount == input.Account {
+			reconciliation.Debits.Add(reconciliation.Debits, entry.Amount)
+		}
+		if entry.CreditAccount == input.Account {
+			reconciliation.Credits.Add(reconciliation.Credits, entry.Amount)
+		}
+	}
+	for token, balance := range input.Balances {
+		if balance.Sign() == 0 {
+			continue
+		}
+		tokenAt(token).WalletBalance = balance
+	}
+
+	output := &ReconcileLedgerOutputDTO{
+		Account:    input.Account,
+		Reconciled: true,
+		Tokens:     make([]*TokenReconciliationOutputDTO, 0, len(tokens)),
+	}
+	for _, reconciliation := range tokens {
+		expected := new(uint256.Int).Add(reconciliation.Credits, reconciliation.WalletBalance)
+		reconciliation.Reconciled = expected.Eq(reconciliation.Debits)
+		output.Reconciled = output.Reconciled && reconciliation.Reconciled
+		output.Tokens = append(output.Tokens, reconciliation)
+	}
+	sort.Slice(output.Tokens, func(i, j int) bool {
+		return output.Tokens[i].Token.Hex() < output.Tokens[j].Token.Hex()
+	})
+	return output, nil
+}
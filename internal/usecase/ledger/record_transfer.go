@@ -0,0 +1,53 @@
+package ledger
+
+import (
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+	"github.com/holiman/uint256"
+	"github.com/rollmelette/rollmelette"
+)
+
+// RecordTransferInputDTO is built by the advance handlers for every ERC20 transfer they make.
+type RecordTransferInputDTO struct {
+	Token      Address
+	From       Address
+	To         Address
+	Amount     *uint256.Int
+	Reason     entity.LedgerReason
+	IssuanceId uint
+	OrderId    uint
+}
+
+type RecordTransferUseCase struct {
+	LedgerRepository repository.LedgerRepository
+}
+
+func NewRecordTransferUseCase(ledgerRepo repository.LedgerRepository) *RecordTransferUseCase {
+	return &RecordTransferUseCase{
+		LedgerRepository: ledgerRepo,
+	}
+}
+
+func (u *RecordTransferUseCase) Execute(input *RecordTransferInputDTO, metadata rollmelette.Metadata) (*LedgerEntryOutputDTO, error) {
+	entry, err := entity.NewLedgerEntry(
+		input.To,
+		input.From,
+		input.Token,
+		input.Amount,
+		input.Reason,
+		input.IssuanceId,
+		input.OrderId,
+		uint(metadata.Index),
+		metadata.BlockTimestamp,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := u.LedgerRepository.CreateLedgerEntry(entry)
+	if err != nil {
+		return nil, err
+	}
+	return newLedgerEntryOutputDTO(res), nil
+}
@@ -1,31 +1,19 @@
 package order
 
 import (
-	"errors"
-
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain"
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/reputation"
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/user"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/api"
 	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
-	"github.com/holiman/uint256"
 	"github.com/rollmelette/rollmelette"
 )
 
-type CancelOrderInputDTO struct {
-	Id uint `json:"id" validate:"required"`
-}
+type CancelOrderInputDTO = api.CancelOrderInputDTO
 
-type CancelOrderOutputDTO struct {
-	Id           uint                `json:"id"`
-	IssuanceId   uint                `json:"issuance_id"`
-	Token        Address             `json:"token"`
-	Investor     *user.UserOutputDTO `json:"investor"`
-	Amount       *uint256.Int        `json:"amount"`
-	InterestRate *uint256.Int        `json:"interest_rate"`
-	State        string              `json:"state"`
-	CreatedAt    int64               `json:"created_at"`
-	UpdatedAt    int64               `json:"updated_at"`
-}
+type CancelOrderOutputDTO = api.CancelOrderOutputDTO
 
 type CancelOrderUseCase struct {
 	UserRepository     repository.UserRepository
@@ -47,16 +35,21 @@ func (c *CancelOrderUseCase) Execute(input *CancelOrderInputDTO, metadata rollme
 		return nil, err
 	}
 	if order.InvestorAddress != Address(metadata.MsgSender) {
-		return nil, errors.New("only the investor can cancel the order")
+		return nil, domain.ErrNotOrderInvestor.New(order.Id)
+	}
+	// only a pending order still holds its escrowed amount to refund
+	if order.State != entity.OrderStatePending {
+		return nil, domain.ErrOrderNotPending.New(order.Id, order.State)
 	}
 	issuance, err := c.IssuanceRepository.FindIssuanceById(order.IssuanceId)
 	if err != nil {
 		return nil, err
 	}
 	if issuance.State == entity.IssuanceStateClosed {
-		return nil, errors.New("cannot cancel order after Issuance closes")
+		return nil, domain.ErrOrderNotCancellable.New(order.Id, issuance.Id)
 	}
 	order.State = entity.OrderStateCancelled
+	order.UpdatedAt = metadata.BlockTimestamp
 	res, err := c.OrderRepository.UpdateOrder(order)
 	if err != nil {
 		return nil, err
@@ -71,9 +64,11 @@ func (c *CancelOrderUseCase) Execute(input *CancelOrderInputDTO, metadata rollme
 		Token:      issuance.Token,
 		Investor: &user.UserOutputDTO{
 			Id:             investor.Id,
-			Role:           string(investor.Role),
+			Roles:          investor.RoleNames(),
 			Address:        investor.Address,
-			SocialAccounts: investor.SocialAccounts,
+			Status:         string(investor.Status),
+			SocialAccounts: user.SocialAccountsOf(investor),
+			Reputation:     reputation.Of(investor),
 			CreatedAt:      investor.CreatedAt,
 			UpdatedAt:      investor.UpdatedAt,
 		},
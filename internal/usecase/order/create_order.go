@@ -3,51 +3,46 @@ package order
 import (
 	"fmt"
 
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain"
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/reputation"
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/user"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/api"
 	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
 	"github.com/holiman/uint256"
 	"github.com/rollmelette/rollmelette"
 )
 
-type CreateOrderInputDTO struct {
-	IssuanceId   uint         `json:"issuance_id" validate:"required"`
-	InterestRate *uint256.Int `json:"interest_rate" validate:"required"`
-}
+type CreateOrderInputDTO = api.CreateOrderInputDTO
 
-type CreateOrderOutputDTO struct {
-	Id           uint                `json:"id"`
-	IssuanceId   uint                `json:"issuance_id"`
-	Investor     *user.UserOutputDTO `json:"investor"`
-	Amount       *uint256.Int        `json:"amount"`
-	InterestRate *uint256.Int        `json:"interest_rate"`
-	State        string              `json:"state"`
-	CreatedAt    int64               `json:"created_at"`
-}
+type CreateOrderOutputDTO = api.CreateOrderOutputDTO
 
 type CreateOrderUseCase struct {
-	UserRepository     repository.UserRepository
-	OrderRepository    repository.OrderRepository
-	IssuanceRepository repository.IssuanceRepository
+	UserRepository        repository.UserRepository
+	OrderRepository       repository.OrderRepository
+	IssuanceRepository    repository.IssuanceRepository
+	AttestationRepository repository.AttestationRepository
 }
 
 func NewCreateOrderUseCase(
 	userRepo repository.UserRepository,
 	orderRepo repository.OrderRepository,
 	issuanceRepo repository.IssuanceRepository,
+	attestationRepo repository.AttestationRepository,
 ) *CreateOrderUseCase {
 	return &CreateOrderUseCase{
-		UserRepository:     userRepo,
-		OrderRepository:    orderRepo,
-		IssuanceRepository: issuanceRepo,
+		UserRepository:        userRepo,
+		OrderRepository:       orderRepo,
+		IssuanceRepository:    issuanceRepo,
+		AttestationRepository: attestationRepo,
 	}
 }
 
 func (c *CreateOrderUseCase) Execute(input *CreateOrderInputDTO, deposit rollmelette.Deposit, metadata rollmelette.Metadata) (*CreateOrderOutputDTO, error) {
 	erc20Deposit, ok := deposit.(*rollmelette.ERC20Deposit)
 	if !ok {
-		return nil, fmt.Errorf("invalid deposit type provided for order creation: %T", deposit)
+		return nil, domain.ErrInvalidDeposit.New(fmt.Sprintf("%T", deposit))
 	}
 
 	issuance, err := c.IssuanceRepository.FindIssuanceById(input.IssuanceId)
@@ -56,15 +51,19 @@ func (c *CreateOrderUseCase) Execute(input *CreateOrderInputDTO, deposit rollmel
 	}
 
 	if issuance.ClosesAt < metadata.BlockTimestamp {
-		return nil, fmt.Errorf("issuance issuance closed, order cannot be placed")
+		return nil, domain.ErrIssuanceClosed.New(issuance.Id)
 	}
 
 	if Address(erc20Deposit.Token) != issuance.Token {
-		return nil, fmt.Errorf("invalid contract address provided for order creation: %v", erc20Deposit.Token)
+		return nil, domain.ErrInvalidDepositToken.New(Address(erc20Deposit.Token), issuance.Token)
 	}
 
 	if input.InterestRate.Gt(issuance.MaxInterestRate) {
-		return nil, fmt.Errorf("order interest rate exceeds active Issuance max interest rate")
+		return nil, domain.ErrInterestRateTooHigh.New(input.InterestRate, issuance.MaxInterestRate)
+	}
+
+	if err := c.checkAttestations(issuance, Address(erc20Deposit.Sender), metadata.BlockTimestamp); err != nil {
+		return nil, err
 	}
 
 	order, err := entity.NewOrder(
@@ -94,9 +93,11 @@ func (c *CreateOrderUseCase) Execute(input *CreateOrderInputDTO, deposit rollmel
 		IssuanceId: res.IssuanceId,
 		Investor: &user.UserOutputDTO{
 			Id:             investor.Id,
-			Role:           string(investor.Role),
+			Roles:          investor.RoleNames(),
 			Address:        investor.Address,
-			SocialAccounts: investor.SocialAccounts,
+			Status:         string(investor.Status),
+			SocialAccounts: user.SocialAccountsOf(investor),
+			Reputation:     reputation.Of(investor),
 			CreatedAt:      investor.CreatedAt,
 			UpdatedAt:      investor.UpdatedAt,
 		},
@@ -106,3 +107,33 @@ func (c *CreateOrderUseCase) Execute(input *CreateOrderInputDTO, deposit rollmel
 		CreatedAt:    res.CreatedAt,
 	}, nil
 }
+
+// checkAttestations ensures the creator and the investor still hold the attestation types
+// required by the issuance.
+func (c *CreateOrderUseCase) checkAttestations(issuance *entity.Issuance, investor Address, at int64) error {
+	parties := []struct {
+		name     string
+		address  Address
+		required []entity.AttestationType
+	}{
+		{"creator", issuance.CreatorAddress, issuance.RequiredCreatorAttestations},
+		{"investor", investor, issuance.RequiredInvestorAttestations},
+	}
+	for _, party := range parties {
+		if len(party.required) == 0 {
+			continue
+		}
+		account, err := c.UserRepository.FindUserByAddress(party.address)
+		if err != nil {
+			return fmt.Errorf("error finding %s: %w", party.name, err)
+		}
+		attestations, err := c.AttestationRepository.FindAttestationsBySubjectId(account.Id)
+		if err != nil {
+			return fmt.Errorf("error finding %s attestations: %w", party.name, err)
+		}
+		if missing, ok := entity.MissingAttestation(party.required, attestations, at); ok {
+			return domain.ErrAttestationRequired.New(party.name, party.address, missing)
+		}
+	}
+	return nil
+}
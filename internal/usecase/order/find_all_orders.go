@@ -2,6 +2,7 @@ package order
 
 import (
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/reputation"
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/user"
 )
 
@@ -38,9 +39,11 @@ func (f *FindAllOrdersUseCase) Execute() (*FindAllOrdersOutputDTO, error) {
 			IssuanceId: order.IssuanceId,
 			Investor: &user.UserOutputDTO{
 				Id:             investor.Id,
-				Role:           string(investor.Role),
+				Roles:          investor.RoleNames(),
 				Address:        investor.Address,
-				SocialAccounts: investor.SocialAccounts,
+				Status:         string(investor.Status),
+				SocialAccounts: user.SocialAccountsOf(investor),
+				Reputation:     reputation.Of(investor),
 				CreatedAt:      investor.CreatedAt,
 				UpdatedAt:      investor.UpdatedAt,
 			},
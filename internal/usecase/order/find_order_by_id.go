@@ -2,12 +2,12 @@ package order
 
 import (
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/reputation"
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/user"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/api"
 )
 
-type FindOrderByIdInputDTO struct {
-	Id uint `json:"id" validate:"required"`
-}
+type FindOrderByIdInputDTO = api.FindOrderByIdInputDTO
 
 type FindOrderByIdUseCase struct {
 	UserRepository  repository.UserRepository
@@ -38,9 +38,11 @@ func (c *FindOrderByIdUseCase) Execute(input *FindOrderByIdInputDTO) (*OrderOutp
 		IssuanceId: res.IssuanceId,
 		Investor: &user.UserOutputDTO{
 			Id:             investor.Id,
-			Role:           string(investor.Role),
+			Roles:          investor.RoleNames(),
 			Address:        investor.Address,
-			SocialAccounts: investor.SocialAccounts,
+			Status:         string(investor.Status),
+			SocialAccounts: user.SocialAccountsOf(investor),
+			Reputation:     reputation.Of(investor),
 			CreatedAt:      investor.CreatedAt,
 			UpdatedAt:      investor.UpdatedAt,
 		},
@@ -2,14 +2,14 @@ package order
 
 import (
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/reputation"
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/user"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/api"
 )
 
-type FindOrdersByIssuanceIdInputDTO struct {
-	IssuanceId uint `json:"issuance_id" validate:"required"`
-}
+type FindOrdersByIssuanceIdInputDTO = api.FindOrdersByIssuanceIdInputDTO
 
-type FindOrdersByIssuanceIdOutputDTO []*OrderOutputDTO
+type FindOrdersByIssuanceIdOutputDTO = api.FindOrdersByIssuanceIdOutputDTO
 
 type FindOrdersByIssuanceIdUseCase struct {
 	UserRepository  repository.UserRepository
@@ -42,9 +42,11 @@ func (c *FindOrdersByIssuanceIdUseCase) Execute(input *FindOrdersByIssuanceIdInp
 			IssuanceId: order.IssuanceId,
 			Investor:     &user.UserOutputDTO{
 				Id: investor.Id,
-				Role: string(investor.Role),
+				Roles: investor.RoleNames(),
 				Address: investor.Address,
-				SocialAccounts: investor.SocialAccounts,
+				Status: string(investor.Status),
+				SocialAccounts: user.SocialAccountsOf(investor),
+				Reputation: reputation.Of(investor),
 				CreatedAt: investor.CreatedAt,
 				UpdatedAt: investor.UpdatedAt,
 			},
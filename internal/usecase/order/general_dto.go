@@ -1,17 +1,7 @@
 package order
 
 import (
-	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/user"
-	"github.com/holiman/uint256"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/api"
 )
 
-type OrderOutputDTO struct {
-	Id           uint                `json:"id"`
-	IssuanceId   uint                `json:"issuance_id"`
-	Investor     *user.UserOutputDTO `json:"investor"`
-	Amount       *uint256.Int        `json:"amount"`
-	InterestRate *uint256.Int        `json:"interest_rate"`
-	State        string              `json:"state"`
-	CreatedAt    int64               `json:"created_at"`
-	UpdatedAt    int64               `json:"updated_at"`
-}
+type OrderOutputDTO = api.OrderOutputDTO
@@ -0,0 +1,101 @@
+package portfolio
+
+import (
+	"sort"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/issuance"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/api"
+	"github.com/holiman/uint256"
+)
+
+type FindCreatorDashboardInputDTO = api.FindCreatorDashboardInputDTO
+
+type ObligationOutputDTO = api.ObligationOutputDTO
+
+type FindCreatorDashboardOutputDTO = api.FindCreatorDashboardOutputDTO
+
+type FindCreatorDashboardUseCase struct {
+	UserRepository     repository.UserRepository
+	IssuanceRepository repository.IssuanceRepository
+	// IssuanceFee is the fee charged on the amount raised, in basis points.
+	IssuanceFee uint64
+}
+
+func NewFindCreatorDashboardUseCase(userRepo repository.UserRepository, issuanceRepo repository.IssuanceRepository, issuanceFee uint64) *FindCreatorDashboardUseCase {
+	return &FindCreatorDashboardUseCase{
+		UserRepository:     userRepo,
+		IssuanceRepository: issuanceRepo,
+		IssuanceFee:        issuanceFee,
+	}
+}
+
+func (f *FindCreatorDashboardUseCase) Execute(input *FindCreatorDashboardInputDTO) (*FindCreatorDashboardOutputDTO, error) {
+	creator, err := f.UserRepository.FindUserByAddress(input.CreatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	issuances, err := f.IssuanceRepository.FindIssuancesByCreatorAddress(creator.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	// the obligations are scheduled by maturity
+	sort.SliceStable(issuances, func(i, j int) bool {
+		if issuances[i].MaturityAt != issuances[j].MaturityAt {
+			return issuances[i].MaturityAt < issuances[j].MaturityAt
+		}
+		return issuances[i].Id < issuances[j].Id
+	})
+
+	output := &FindCreatorDashboardOutputDTO{
+		Creator:     creator.Address,
+		At:          input.At,
+		Obligations: make([]*ObligationOutputDTO, len(issuances)),
+	}
+	for i, iss := range issuances {
+		obligation := &ObligationOutputDTO{
+			IssuanceId:        iss.Id,
+			Title:             iss.Title,
+			State:             string(iss.State),
+			Token:             iss.Token,
+			TotalRaised:       orZero(iss.TotalRaised),
+			FeesDeducted:      uint256.NewInt(0),
+			TotalObligation:   orZero(iss.TotalObligation),
+			MaturityAt:        iss.MaturityAt,
+			SecondsToMaturity: max(0, iss.MaturityAt-input.At),
+			DaysToMaturity:    DaysUntil(iss.MaturityAt, input.At),
+			Collateral:        iss.CollateralAddress,
+			CollateralAtRisk:  uint256.NewInt(0),
+			SettlementDeposit: uint256.NewInt(0),
+		}
+
+		// fees are taken from the amount raised when the issuance closes
+		switch iss.State {
+		case entity.IssuanceStateClosed, entity.IssuanceStateSettled, entity.IssuanceStateCollateralExecuted:
+			obligation.FeesDeducted = new(uint256.Int).Mul(obligation.TotalRaised, uint256.NewInt(f.IssuanceFee))
+			obligation.FeesDeducted.Div(obligation.FeesDeducted, issuance.BasisPointsDivisor)
+		}
+		obligation.NetRaised = new(uint256.Int).Sub(obligation.TotalRaised, obligation.FeesDeducted)
+
+		// the collateral is locked until the issuance is settled or its collateral executed
+		switch iss.State {
+		case entity.IssuanceStateOngoing, entity.IssuanceStateClosed:
+			obligation.CollateralAtRisk = iss.CollateralAmount
+		}
+
+		if iss.State == entity.IssuanceStateClosed && input.At <= iss.MaturityAt {
+			obligation.SettlementDeposit = obligation.TotalObligation
+		}
+		output.Obligations[i] = obligation
+	}
+	return output, nil
+}
+
+func orZero(v *uint256.Int) *uint256.Int {
+	if v == nil {
+		return uint256.NewInt(0)
+	}
+	return v
+}
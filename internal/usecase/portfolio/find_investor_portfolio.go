@@ -0,0 +1,162 @@
+package portfolio
+
+import (
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/issuance"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/api"
+	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+	"github.com/holiman/uint256"
+)
+
+const secondsPerDay = 24 * 60 * 60
+
+type FindInvestorPortfolioInputDTO = api.FindInvestorPortfolioInputDTO
+
+type PositionOutputDTO = api.PositionOutputDTO
+
+type TokenTotalsOutputDTO = api.TokenTotalsOutputDTO
+
+type FindInvestorPortfolioOutputDTO = api.FindInvestorPortfolioOutputDTO
+
+type FindInvestorPortfolioUseCase struct {
+	UserRepository     repository.UserRepository
+	IssuanceRepository repository.IssuanceRepository
+}
+
+func NewFindInvestorPortfolioUseCase(userRepo repository.UserRepository, issuanceRepo repository.IssuanceRepository) *FindInvestorPortfolioUseCase {
+	return &FindInvestorPortfolioUseCase{
+		UserRepository:     userRepo,
+		IssuanceRepository: issuanceRepo,
+	}
+}
+
+func (f *FindInvestorPortfolioUseCase) Execute(input *FindInvestorPortfolioInputDTO) (*FindInvestorPortfolioOutputDTO, error) {
+	investor, err := f.UserRepository.FindUserByAddress(input.InvestorAddress)
+	if err != nil {
+		return nil, err
+	}
+	issuances, err := f.IssuanceRepository.FindIssuancesByInvestorAddress(investor.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &FindInvestorPortfolioOutputDTO{
+		Investor:  investor.Address,
+		At:        input.At,
+		Positions: []*PositionOutputDTO{},
+		Totals:    []*TokenTotalsOutputDTO{},
+	}
+	totals := make(map[Address]*TokenTotalsOutputDTO)
+	totalsOf := func(token Address) *TokenTotalsOutputDTO {
+		if t, ok := totals[token]; ok {
+			return t
+		}
+		t := &TokenTotalsOutputDTO{
+			Token:       token,
+			Invested:    uint256.NewInt(0),
+			Escrowed:    uint256.NewInt(0),
+			Outstanding: uint256.NewInt(0),
+			Realized:    uint256.NewInt(0),
+		}
+		totals[token] = t
+		output.Totals = append(output.Totals, t)
+		return t
+	}
+
+	// the issuances are joined with the orders, so an issuance shows up once per order
+	seen := make(map[uint]bool)
+	for _, iss := range issuances {
+		if seen[iss.Id] {
+			continue
+		}
+		seen[iss.Id] = true
+
+		collateralShares := CollateralShares(iss)
+		for _, o := range iss.Orders {
+			if o.InvestorAddress != investor.Address {
+				continue
+			}
+			position := &PositionOutputDTO{
+				OrderId:        o.Id,
+				IssuanceId:     iss.Id,
+				IssuanceTitle:  iss.Title,
+				IssuanceState:  string(iss.State),
+				Token:          iss.Token,
+				State:          string(o.State),
+				Principal:      o.Amount,
+				InterestRate:   o.InterestRate,
+				ExpectedPayout: uint256.NewInt(0),
+				MaturityAt:     iss.MaturityAt,
+				DaysToMaturity: DaysUntil(iss.MaturityAt, input.At),
+				Realized:       uint256.NewInt(0),
+				RealizedToken:  iss.Token,
+				Outstanding:    uint256.NewInt(0),
+			}
+
+			switch o.State {
+			case entity.OrderStatePending:
+				position.ExpectedPayout = Payout(o)
+				t := totalsOf(iss.Token)
+				t.Escrowed.Add(t.Escrowed, o.Amount)
+			case entity.OrderStateAccepted, entity.OrderStatePartiallyAccepted:
+				position.ExpectedPayout = Payout(o)
+				position.Outstanding = position.ExpectedPayout
+			case entity.OrderStateSettled:
+				position.ExpectedPayout = Payout(o)
+				position.Realized = position.ExpectedPayout
+			case entity.OrderStateSettledByCollateral:
+				position.ExpectedPayout = Payout(o)
+				position.Realized = collateralShares[o.Id]
+				position.RealizedToken = iss.CollateralAddress
+			}
+
+			switch o.State {
+			case entity.OrderStateAccepted, entity.OrderStatePartiallyAccepted, entity.OrderStateSettled, entity.OrderStateSettledByCollateral:
+				t := totalsOf(iss.Token)
+				t.Invested.Add(t.Invested, o.Amount)
+				t.Outstanding.Add(t.Outstanding, position.Outstanding)
+			}
+			if position.Realized.Sign() > 0 {
+				t := totalsOf(position.RealizedToken)
+				t.Realized.Add(t.Realized, position.Realized)
+			}
+			output.Positions = append(output.Positions, position)
+		}
+	}
+	return output, nil
+}
+
+// Payout returns the principal of an order plus its interest at the contracted rate, as paid on
+// settlement.
+func Payout(o *entity.Order) *uint256.Int {
+	interest := new(uint256.Int).Mul(o.Amount, o.InterestRate)
+	interest.Div(interest, issuance.BasisPointsDivisor)
+	return interest.Add(interest, o.Amount)
+}
+
+// CollateralShares returns the collateral paid to each order settled by collateral, split in
+// proportion to the payouts as done when the collateral is executed.
+func CollateralShares(iss *entity.Issuance) map[uint]*uint256.Int {
+	shares := make(map[uint]*uint256.Int)
+	total := uint256.NewInt(0)
+	for _, o := range iss.Orders {
+		if o.State == entity.OrderStateSettledByCollateral {
+			shares[o.Id] = Payout(o)
+			total.Add(total, shares[o.Id])
+		}
+	}
+	for id, payout := range shares {
+		share := new(uint256.Int).Mul(payout, iss.CollateralAmount)
+		shares[id] = share.Div(share, total)
+	}
+	return shares
+}
+
+// DaysUntil returns the whole days, rounded up, from at to timestamp, or zero once it passed.
+func DaysUntil(timestamp int64, at int64) int64 {
+	if timestamp <= at {
+		return 0
+	}
+	return (timestamp - at + secondsPerDay - 1) / secondsPerDay
+}
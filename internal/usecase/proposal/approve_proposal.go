@@ -0,0 +1,65 @@
+package proposal
+
+import (
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+	"github.com/rollmelette/rollmelette"
+)
+
+type ApproveProposalInputDTO struct {
+	ProposalId uint `json:"proposal_id" validate:"required"`
+}
+
+func (ApproveProposalInputDTO) ABIMethod() string {
+	return "approveProposal"
+}
+
+type ApproveProposalUseCase struct {
+	ProposalRepository repository.ProposalRepository
+	UserRepository     repository.UserRepository
+}
+
+func NewApproveProposalUseCase(proposalRepo repository.ProposalRepository, userRepo repository.UserRepository) *ApproveProposalUseCase {
+	return &ApproveProposalUseCase{
+		ProposalRepository: proposalRepo,
+		UserRepository:     userRepo,
+	}
+}
+
+// Execute approves the proposal on behalf of the sender. Once the threshold is reached,
+// execute runs the proposed input before anything is stored, so a failed execution
+// leaves the proposal untouched. Approvals of admins revoked or deactivated since they
+// approved do not count towards the threshold.
+func (u *ApproveProposalUseCase) Execute(input *ApproveProposalInputDTO, metadata rollmelette.Metadata, execute func(proposal *entity.Proposal) error) (*ProposalOutputDTO, error) {
+	proposal, err := u.ProposalRepository.FindProposalById(input.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	approval, err := proposal.Approve(Address(metadata.MsgSender), metadata.BlockTimestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	admins, err := u.UserRepository.FindUsersByRole(string(entity.UserRoleAdmin))
+	if err != nil {
+		return nil, err
+	}
+	if proposal.Approved(admins) {
+		if err := execute(proposal); err != nil {
+			return nil, err
+		}
+		proposal.State = entity.ProposalStateExecuted
+	}
+
+	if err := u.ProposalRepository.CreateProposalApproval(approval); err != nil {
+		return nil, err
+	}
+
+	res, err := u.ProposalRepository.UpdateProposal(proposal)
+	if err != nil {
+		return nil, err
+	}
+	return newProposalOutputDTO(res), nil
+}
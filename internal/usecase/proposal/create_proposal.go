@@ -0,0 +1,49 @@
+package proposal
+
+import (
+	"encoding/json"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+	"github.com/rollmelette/rollmelette"
+)
+
+// CreateProposalInputDTO is built by the governance middleware from a governed input.
+type CreateProposalInputDTO struct {
+	Path      string
+	Data      json.RawMessage
+	Threshold uint
+	// Ttl is the number of seconds the proposal collects approvals for
+	Ttl int64
+}
+
+type CreateProposalUseCase struct {
+	ProposalRepository repository.ProposalRepository
+}
+
+func NewCreateProposalUseCase(proposalRepo repository.ProposalRepository) *CreateProposalUseCase {
+	return &CreateProposalUseCase{
+		ProposalRepository: proposalRepo,
+	}
+}
+
+func (u *CreateProposalUseCase) Execute(input *CreateProposalInputDTO, metadata rollmelette.Metadata) (*ProposalOutputDTO, error) {
+	proposal, err := entity.NewProposal(
+		input.Path,
+		string(input.Data),
+		Address(metadata.MsgSender),
+		input.Threshold,
+		metadata.BlockTimestamp+input.Ttl,
+		metadata.BlockTimestamp,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := u.ProposalRepository.CreateProposal(proposal)
+	if err != nil {
+		return nil, err
+	}
+	return newProposalOutputDTO(res), nil
+}
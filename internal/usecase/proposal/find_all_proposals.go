@@ -0,0 +1,29 @@
+package proposal
+
+import (
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+)
+
+type FindAllProposalsOutputDTO []*ProposalOutputDTO
+
+type FindAllProposalsUseCase struct {
+	ProposalRepository repository.ProposalRepository
+}
+
+func NewFindAllProposalsUseCase(proposalRepo repository.ProposalRepository) *FindAllProposalsUseCase {
+	return &FindAllProposalsUseCase{
+		ProposalRepository: proposalRepo,
+	}
+}
+
+func (u *FindAllProposalsUseCase) Execute() (FindAllProposalsOutputDTO, error) {
+	res, err := u.ProposalRepository.FindAllProposals()
+	if err != nil {
+		return nil, err
+	}
+	output := make(FindAllProposalsOutputDTO, len(res))
+	for i, proposal := range res {
+		output[i] = newProposalOutputDTO(proposal)
+	}
+	return output, nil
+}
@@ -0,0 +1,27 @@
+package proposal
+
+import (
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+)
+
+type FindProposalByIdInputDTO struct {
+	Id uint `json:"id" validate:"required"`
+}
+
+type FindProposalByIdUseCase struct {
+	ProposalRepository repository.ProposalRepository
+}
+
+func NewFindProposalByIdUseCase(proposalRepo repository.ProposalRepository) *FindProposalByIdUseCase {
+	return &FindProposalByIdUseCase{
+		ProposalRepository: proposalRepo,
+	}
+}
+
+func (u *FindProposalByIdUseCase) Execute(input *FindProposalByIdInputDTO) (*ProposalOutputDTO, error) {
+	res, err := u.ProposalRepository.FindProposalById(input.Id)
+	if err != nil {
+		return nil, err
+	}
+	return newProposalOutputDTO(res), nil
+}
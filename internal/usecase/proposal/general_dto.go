@@ -0,0 +1,39 @@
+package proposal
+
+import (
+	"encoding/json"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
+	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+)
+
+type ProposalOutputDTO struct {
+	Id        uint                       `json:"id"`
+	Path      string                     `json:"path"`
+	Data      json.RawMessage            `json:"data,omitempty"`
+	Proposer  Address                    `json:"proposer"`
+	Threshold uint                       `json:"threshold"`
+	State     string                     `json:"state"`
+	Approvals []*entity.ProposalApproval `json:"approvals"`
+	ExpiresAt int64                      `json:"expires_at"`
+	CreatedAt int64                      `json:"created_at"`
+	UpdatedAt int64                      `json:"updated_at"`
+}
+
+func newProposalOutputDTO(proposal *entity.Proposal) *ProposalOutputDTO {
+	output := &ProposalOutputDTO{
+		Id:        proposal.Id,
+		Path:      proposal.Path,
+		Proposer:  proposal.Proposer,
+		Threshold: proposal.Threshold,
+		State:     string(proposal.State),
+		Approvals: proposal.Approvals,
+		ExpiresAt: proposal.ExpiresAt,
+		CreatedAt: proposal.CreatedAt,
+		UpdatedAt: proposal.UpdatedAt,
+	}
+	if proposal.Payload != "" {
+		output.Data = json.RawMessage(proposal.Payload)
+	}
+	return output
+}
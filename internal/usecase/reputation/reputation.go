@@ -0,0 +1,155 @@
+package reputation
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/api"
+	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+	"github.com/holiman/uint256"
+)
+
+// Scores range from 0 to MaxScore. A creator without history starts at BaseScore, each
+// issuance settled on time adds SettledOnTimeBonus (SettledLateBonus if settled after
+// maturity), each collateral execution subtracts CollateralExecutionPenalty and each
+// underfunded issuance subtracts CancellationPenalty.
+const (
+	BaseScore                  = 500
+	MaxScore                   = 1000
+	SettledOnTimeBonus         = 100
+	SettledLateBonus           = 40
+	CollateralExecutionPenalty = 250
+	CancellationPenalty        = 50
+)
+
+// Compute derives the reputation of a creator from its issuances. It only depends on the
+// issuances, so replaying the same inputs always yields the same reputation.
+func Compute(userId uint, issuances []*entity.Issuance, at int64) *entity.CreatorReputation {
+	reputation := &entity.CreatorReputation{
+		UserId:      userId,
+		Issuances:   uint64(len(issuances)),
+		TotalVolume: uint256.NewInt(0),
+		UpdatedAt:   at,
+	}
+	for _, issuance := range issuances {
+		switch issuance.State {
+		case entity.IssuanceStateSettled:
+			if issuance.UpdatedAt <= issuance.MaturityAt {
+				reputation.SettledOnTime++
+			} else {
+				reputation.SettledLate++
+			}
+		case entity.IssuanceStateCollateralExecuted:
+			reputation.CollateralExecutions++
+		case entity.IssuanceStateCanceled:
+			reputation.Cancellations++
+		}
+		if issuance.TotalRaised != nil {
+			reputation.TotalVolume.Add(reputation.TotalVolume, issuance.TotalRaised)
+		}
+	}
+
+	score := int64(BaseScore)
+	score += int64(reputation.SettledOnTime) * SettledOnTimeBonus
+	score += int64(reputation.SettledLate) * SettledLateBonus
+	score -= int64(reputation.CollateralExecutions) * CollateralExecutionPenalty
+	score -= int64(reputation.Cancellations) * CancellationPenalty
+	reputation.Score = uint64(max(0, min(MaxScore, score)))
+	return reputation
+}
+
+// Of returns the reputation shown for a user: the stored one, a clean record for creators
+// that never transitioned an issuance, and nil for users who are not creators.
+func Of(user *entity.User) *api.CreatorReputationOutputDTO {
+	reputation := user.Reputation
+	if reputation == nil {
+		if !user.HasRole(entity.UserRoleCreator) {
+			return nil
+		}
+		reputation = Compute(user.Id, nil, 0)
+	}
+	return &api.CreatorReputationOutputDTO{
+		Score:                reputation.Score,
+		Issuances:            reputation.Issuances,
+		SettledOnTime:        reputation.SettledOnTime,
+		SettledLate:          reputation.SettledLate,
+		CollateralExecutions: reputation.CollateralExecutions,
+		Cancellations:        reputation.Cancellations,
+		TotalVolume:          reputation.TotalVolume,
+		UpdatedAt:            reputation.UpdatedAt,
+	}
+}
+
+// Engine keeps the stored reputation of creators up to date.
+type Engine struct {
+	UserRepository       repository.UserRepository
+	IssuanceRepository   repository.IssuanceRepository
+	ReputationRepository repository.ReputationRepository
+}
+
+func NewEngine(
+	userRepo repository.UserRepository,
+	issuanceRepo repository.IssuanceRepository,
+	reputationRepo repository.ReputationRepository,
+) *Engine {
+	return &Engine{
+		UserRepository:       userRepo,
+		IssuanceRepository:   issuanceRepo,
+		ReputationRepository: reputationRepo,
+	}
+}
+
+// Refresh recomputes and stores the reputation of a creator. Use cases call it after every
+// issuance lifecycle transition.
+func (e *Engine) Refresh(creator Address, at int64) (*entity.CreatorReputation, error) {
+	user, err := e.UserRepository.FindUserByAddress(creator)
+	if err != nil {
+		return nil, err
+	}
+	issuances, err := e.IssuanceRepository.FindIssuancesByCreatorAddress(creator)
+	if err != nil {
+		return nil, err
+	}
+	return e.ReputationRepository.SaveCreatorReputation(Compute(user.Id, issuances, at))
+}
+
+// Gate requires MinScore from creators issuing at least MinDebt.
+type Gate struct {
+	MinDebt  *uint256.Int
+	MinScore uint64
+}
+
+// ParseGates parses gates written as <debt_issued>:<min_score>.
+func ParseGates(items []string) ([]Gate, error) {
+	gates := make([]Gate, 0, len(items))
+	for _, item := range items {
+		debt, score, ok := strings.Cut(item, ":")
+		if !ok {
+			return nil, domain.ErrInvalidReputationGates.New(item)
+		}
+		minDebt, err := uint256.FromDecimal(strings.TrimSpace(debt))
+		if err != nil {
+			return nil, domain.ErrInvalidReputationGates.New(item)
+		}
+		minScore, err := strconv.ParseUint(strings.TrimSpace(score), 10, 64)
+		if err != nil {
+			return nil, domain.ErrInvalidReputationGates.New(item)
+		}
+		gates = append(gates, Gate{MinDebt: minDebt, MinScore: minScore})
+	}
+	return gates, nil
+}
+
+// RequiredScore returns the highest minimum score among the gates reached by debtIssued.
+func RequiredScore(gates []Gate, debtIssued *uint256.Int) uint64 {
+	var required uint64
+	for _, gate := range gates {
+		if debtIssued.Cmp(gate.MinDebt) >= 0 && gate.MinScore > required {
+			required = gate.MinScore
+		}
+	}
+	return required
+}
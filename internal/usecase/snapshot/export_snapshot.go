@@ -0,0 +1,55 @@
+package snapshot
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+)
+
+type ExportSnapshotOutputDTO struct {
+	Hash string
+	Rows int
+	// Content is the canonical JSON encoding of the snapshot.
+	Content []byte
+}
+
+type ExportSnapshotUseCase struct {
+	SnapshotRepository repository.SnapshotRepository
+}
+
+func NewExportSnapshotUseCase(snapshotRepo repository.SnapshotRepository) *ExportSnapshotUseCase {
+	return &ExportSnapshotUseCase{
+		SnapshotRepository: snapshotRepo,
+	}
+}
+
+func (u *ExportSnapshotUseCase) Execute() (*ExportSnapshotOutputDTO, error) {
+	tables, err := u.SnapshotRepository.ExportTables()
+	if err != nil {
+		return nil, err
+	}
+	hash, err := Hash(tables)
+	if err != nil {
+		return nil, err
+	}
+	content, err := json.Marshal(&entity.Snapshot{
+		Version: entity.SnapshotVersion,
+		Hash:    hash,
+		Tables:  tables,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode snapshot: %w", err)
+	}
+
+	rows := 0
+	for _, table := range tables {
+		rows += len(table)
+	}
+	return &ExportSnapshotOutputDTO{
+		Hash:    hash,
+		Rows:    rows,
+		Content: append(content, '\n'),
+	}, nil
+}
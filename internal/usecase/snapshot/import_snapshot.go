@@ -0,0 +1,44 @@
+package snapshot
+
+import (
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+)
+
+type ImportSnapshotInputDTO struct {
+	Content []byte
+}
+
+type ImportSnapshotOutputDTO struct {
+	Hash string
+	Rows int
+}
+
+type ImportSnapshotUseCase struct {
+	SnapshotRepository repository.SnapshotRepository
+}
+
+func NewImportSnapshotUseCase(snapshotRepo repository.SnapshotRepository) *ImportSnapshotUseCase {
+	return &ImportSnapshotUseCase{
+		SnapshotRepository: snapshotRepo,
+	}
+}
+
+// Execute restores a snapshot into an empty repository, after checking its hash.
+func (u *ImportSnapshotUseCase) Execute(input *ImportSnapshotInputDTO) (*ImportSnapshotOutputDTO, error) {
+	snapshot, err := Decode(input.Content)
+	if err != nil {
+		return nil, err
+	}
+	if err := u.SnapshotRepository.ImportTables(snapshot.Tables); err != nil {
+		return nil, err
+	}
+
+	rows := 0
+	for _, table := range snapshot.Tables {
+		rows += len(table)
+	}
+	return &ImportSnapshotOutputDTO{
+		Hash: snapshot.Hash,
+		Rows: rows,
+	}, nil
+}
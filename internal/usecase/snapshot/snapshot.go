@@ -0,0 +1,60 @@
+package snapshot
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
+)
+
+// Hash returns the hex encoded SHA-256 of the canonical JSON encoding of the tables. Object
+// keys are sorted by encoding/json and rows come sorted by primary key, so equal states
+// always hash the same.
+func Hash(tables map[string][]entity.SnapshotRow) (string, error) {
+	content, err := json.Marshal(tables)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode snapshot tables: %w", err)
+	}
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// Decode parses a snapshot and checks its version and hash.
+func Decode(content []byte) (*entity.Snapshot, error) {
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.UseNumber()
+	var snapshot entity.Snapshot
+	if err := decoder.Decode(&snapshot); err != nil {
+		return nil, entity.ErrInvalidSnapshot.New(err.Error())
+	}
+	if snapshot.Version != entity.SnapshotVersion {
+		return nil, entity.ErrInvalidSnapshot.New(fmt.Sprintf("unsupported version %d", snapshot.Version))
+	}
+	for table, rows := range snapshot.Tables {
+		for _, row := range rows {
+			for column, value := range row {
+				number, ok := value.(json.Number)
+				if !ok {
+					continue
+				}
+				integer, err := number.Int64()
+				if err != nil {
+					return nil, entity.ErrInvalidSnapshot.New(fmt.Sprintf("column %s of table %s holds %s, expected an integer", column, table, number))
+				}
+				row[column] = integer
+			}
+		}
+	}
+
+	hash, err := Hash(snapshot.Tables)
+	if err != nil {
+		return nil, err
+	}
+	if hash != snapshot.Hash {
+		return nil, entity.ErrSnapshotHashMismatch.New(snapshot.Hash, hash)
+	}
+	return &snapshot, nil
+}
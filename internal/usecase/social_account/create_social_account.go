@@ -3,49 +3,55 @@ package social_account
 import (
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/api"
 	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
 	"github.com/rollmelette/rollmelette"
 )
 
-type CreateSocialAccountInputDTO struct {
-	Address  Address `json:"address" validate:"required"`
-	Username string  `json:"username" validate:"required"`
-	Platform string  `json:"platform" validate:"required"`
-}
+type CreateSocialAccountInputDTO = api.CreateSocialAccountInputDTO
 
-type CreateSocialAccountOutputDTO struct {
-	Id        uint   `json:"id"`
-	UserId    uint   `json:"user_id"`
-	Username  string `json:"username"`
-	Platform  string `json:"platform"`
-	CreatedAt int64  `json:"created_at"`
-}
+type CreateSocialAccountOutputDTO = api.CreateSocialAccountOutputDTO
 
 type CreateSocialAccountUseCase struct {
-	UserRepository          repository.UserRepository
 	SocialAccountRepository repository.SocialAccountRepository
 }
 
-func NewCreateSocialAccountUseCase(
-	userRepo repository.UserRepository,
-	socialAccountRepo repository.SocialAccountRepository,
-) *CreateSocialAccountUseCase {
+func NewCreateSocialAccountUseCase(socialAccountRepo repository.SocialAccountRepository) *CreateSocialAccountUseCase {
 	return &CreateSocialAccountUseCase{
-		UserRepository:          userRepo,
 		SocialAccountRepository: socialAccountRepo,
 	}
 }
 
 func (s *CreateSocialAccountUseCase) Execute(input *CreateSocialAccountInputDTO, metadata *rollmelette.Metadata) (*CreateSocialAccountOutputDTO, error) {
-	user, err := s.UserRepository.FindUserByAddress(input.Address)
+	challenge, err := s.SocialAccountRepository.FindSocialAccountChallengeById(input.ChallengeId)
 	if err != nil {
 		return nil, err
 	}
 
+	// the platform may have been disabled since the challenge was requested
+	settings, err := s.SocialAccountRepository.FindAllPlatformSettings()
+	if err != nil {
+		return nil, err
+	}
+	if err := entity.CheckPlatformEnabled(challenge.Platform, settings); err != nil {
+		return nil, err
+	}
+
+	if err := challenge.Use(input.Signature, metadata.BlockTimestamp); err != nil {
+		return nil, err
+	}
+
 	socialAccount, err := entity.NewSocialAccount(
-		user.Id,
-		input.Username,
-		input.Platform,
+		challenge.UserId,
+		challenge.Username,
+		string(challenge.Platform),
+		entity.SocialAccountEvidence{
+			ChallengeId: challenge.Id,
+			PostId:      input.PostId,
+			ContentHash: input.ContentHash,
+			Signature:   input.Signature,
+			VerifiedBy:  Address(metadata.MsgSender),
+		},
 		metadata.BlockTimestamp,
 	)
 	if err != nil {
@@ -56,11 +62,16 @@ func (s *CreateSocialAccountUseCase) Execute(input *CreateSocialAccountInputDTO,
 	if err != nil {
 		return nil, err
 	}
+
+	if _, err := s.SocialAccountRepository.UpdateSocialAccountChallenge(challenge); err != nil {
+		return nil, err
+	}
 	return &CreateSocialAccountOutputDTO{
 		Id:        socialAccount.Id,
 		UserId:    socialAccount.UserId,
 		Username:  socialAccount.Username,
 		Platform:  string(socialAccount.Platform),
+		Evidence:  newSocialAccountEvidenceOutputDTO(socialAccount.Evidence),
 		CreatedAt: socialAccount.CreatedAt,
 	}, nil
 }
@@ -8,6 +8,10 @@ type DeleteSocialAccountInputDTO struct {
 	SocialAccountId uint `json:"social_account_id" validate:"required"`
 }
 
+func (DeleteSocialAccountInputDTO) ABIMethod() string {
+	return "deleteSocialAccount"
+}
+
 type DeleteSocialAccountUseCase struct {
 	SocialAccountRepository repository.SocialAccountRepository
 }
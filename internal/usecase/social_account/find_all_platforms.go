@@ -0,0 +1,32 @@
+package social_account
+
+import (
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/api"
+)
+
+type FindAllPlatformsOutputDTO = api.FindAllPlatformsOutputDTO
+
+type FindAllPlatformsUseCase struct {
+	SocialAccountRepository repository.SocialAccountRepository
+}
+
+func NewFindAllPlatformsUseCase(socialAccountRepo repository.SocialAccountRepository) *FindAllPlatformsUseCase {
+	return &FindAllPlatformsUseCase{
+		SocialAccountRepository: socialAccountRepo,
+	}
+}
+
+// Execute lists the registered platforms and whether they are enabled.
+func (s *FindAllPlatformsUseCase) Execute() (FindAllPlatformsOutputDTO, error) {
+	settings, err := s.SocialAccountRepository.FindAllPlatformSettings()
+	if err != nil {
+		return nil, err
+	}
+	output := make(FindAllPlatformsOutputDTO, 0, len(entity.Platforms()))
+	for _, platform := range entity.Platforms() {
+		output = append(output, newPlatformOutputDTO(platform, settings))
+	}
+	return output, nil
+}
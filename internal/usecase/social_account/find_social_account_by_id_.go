@@ -30,6 +30,7 @@ func (s *FindSocialAccountByIdUseCase) Execute(input *FindSocialAccountByIdInput
 		UserId:    socialAccount.UserId,
 		Username:  socialAccount.Username,
 		Platform:  string(socialAccount.Platform),
+		Evidence:  newSocialAccountEvidenceOutputDTO(socialAccount.Evidence),
 		CreatedAt: socialAccount.CreatedAt,
 		UpdatedAt: socialAccount.UpdatedAt,
 	}, nil
@@ -34,6 +34,7 @@ func (s *FindSocialAccountsByUserIdUseCase) Execute(input *FindSocialAccountsByU
 			UserId:    socialAccount.UserId,
 			Username:  socialAccount.Username,
 			Platform:  string(socialAccount.Platform),
+			Evidence:  newSocialAccountEvidenceOutputDTO(socialAccount.Evidence),
 			CreatedAt: socialAccount.CreatedAt,
 			UpdatedAt: socialAccount.UpdatedAt,
 		}
@@ -0,0 +1,27 @@
+package social_account
+
+import (
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+)
+
+type FindSocialAccountChallengeByIdInputDTO struct {
+	Id uint `json:"id" validate:"required"`
+}
+
+type FindSocialAccountChallengeByIdUseCase struct {
+	SocialAccountRepository repository.SocialAccountRepository
+}
+
+func NewFindSocialAccountChallengeByIdUseCase(socialAccountRepo repository.SocialAccountRepository) *FindSocialAccountChallengeByIdUseCase {
+	return &FindSocialAccountChallengeByIdUseCase{
+		SocialAccountRepository: socialAccountRepo,
+	}
+}
+
+func (s *FindSocialAccountChallengeByIdUseCase) Execute(input *FindSocialAccountChallengeByIdInputDTO) (*SocialAccountChallengeOutputDTO, error) {
+	res, err := s.SocialAccountRepository.FindSocialAccountChallengeById(input.Id)
+	if err != nil {
+		return nil, err
+	}
+	return newSocialAccountChallengeOutputDTO(res), nil
+}
@@ -1,10 +1,63 @@
 package social_account
 
+import (
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/api"
+)
+
 type SocialAccountOutputDTO struct {
-	Id        uint   `json:"id"`
-	UserId    uint   `json:"user_id"`
-	Username  string `json:"username"`
-	Platform  string `json:"platform"`
-	CreatedAt int64  `json:"created_at"`
-	UpdatedAt int64  `json:"updated_at"`
+	Id        uint                               `json:"id"`
+	UserId    uint                               `json:"user_id"`
+	Username  string                             `json:"username"`
+	Platform  string                             `json:"platform"`
+	Evidence  api.SocialAccountEvidenceOutputDTO `json:"evidence"`
+	CreatedAt int64                              `json:"created_at"`
+	UpdatedAt int64                              `json:"updated_at"`
+}
+
+func newSocialAccountEvidenceOutputDTO(evidence entity.SocialAccountEvidence) api.SocialAccountEvidenceOutputDTO {
+	return api.SocialAccountEvidenceOutputDTO{
+		ChallengeId: evidence.ChallengeId,
+		PostId:      evidence.PostId,
+		ContentHash: evidence.ContentHash,
+		Signature:   evidence.Signature,
+		VerifiedBy:  evidence.VerifiedBy,
+	}
+}
+
+type SocialAccountChallengeOutputDTO = api.SocialAccountChallengeOutputDTO
+
+func newSocialAccountChallengeOutputDTO(challenge *entity.SocialAccountChallenge) *SocialAccountChallengeOutputDTO {
+	return &SocialAccountChallengeOutputDTO{
+		Id:        challenge.Id,
+		UserId:    challenge.UserId,
+		Address:   challenge.Address,
+		Username:  challenge.Username,
+		Platform:  string(challenge.Platform),
+		Nonce:     challenge.Nonce,
+		Message:   challenge.Message(),
+		ExpiresAt: challenge.ExpiresAt,
+		UsedAt:    challenge.UsedAt,
+		CreatedAt: challenge.CreatedAt,
+		UpdatedAt: challenge.UpdatedAt,
+	}
+}
+
+type PlatformOutputDTO = api.PlatformOutputDTO
+
+func newPlatformOutputDTO(platform entity.Platform, settings []*entity.PlatformSetting) *PlatformOutputDTO {
+	spec, _ := entity.LookupPlatform(platform)
+	output := &PlatformOutputDTO{
+		Platform:  string(platform),
+		Enabled:   entity.PlatformEnabled(platform, settings),
+		MinLength: spec.MinLength,
+		MaxLength: spec.MaxLength,
+	}
+	for _, setting := range settings {
+		if setting.Platform == platform {
+			output.UpdatedBy = setting.UpdatedBy
+			output.UpdatedAt = setting.UpdatedAt
+		}
+	}
+	return output
 }
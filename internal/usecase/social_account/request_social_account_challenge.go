@@ -0,0 +1,73 @@
+package social_account
+
+import (
+	"encoding/binary"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/api"
+	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/rollmelette/rollmelette"
+)
+
+type RequestSocialAccountChallengeInputDTO = api.RequestSocialAccountChallengeInputDTO
+
+type RequestSocialAccountChallengeUseCase struct {
+	UserRepository          repository.UserRepository
+	SocialAccountRepository repository.SocialAccountRepository
+	ChallengeTTL            int64
+}
+
+func NewRequestSocialAccountChallengeUseCase(
+	userRepo repository.UserRepository,
+	socialAccountRepo repository.SocialAccountRepository,
+	challengeTTL int64,
+) *RequestSocialAccountChallengeUseCase {
+	return &RequestSocialAccountChallengeUseCase{
+		UserRepository:          userRepo,
+		SocialAccountRepository: socialAccountRepo,
+		ChallengeTTL:            challengeTTL,
+	}
+}
+
+// Execute issues a nonce binding the social account to the wallet of the sender.
+func (s *RequestSocialAccountChallengeUseCase) Execute(input *RequestSocialAccountChallengeInputDTO, metadata rollmelette.Metadata) (*SocialAccountChallengeOutputDTO, error) {
+	user, err := s.UserRepository.FindUserByAddress(Address(metadata.MsgSender))
+	if err != nil {
+		return nil, err
+	}
+	if !user.Active() {
+		return nil, entity.ErrUserDeactivated.New(user.Address)
+	}
+
+	settings, err := s.SocialAccountRepository.FindAllPlatformSettings()
+	if err != nil {
+		return nil, err
+	}
+	if err := entity.CheckPlatformEnabled(entity.Platform(input.Platform), settings); err != nil {
+		return nil, err
+	}
+
+	// the input index makes the nonce unique and unknown before the request is processed
+	nonce := crypto.Keccak256Hash(metadata.MsgSender.Bytes(), binary.BigEndian.AppendUint64(nil, uint64(metadata.Index))).Hex()
+
+	challenge, err := entity.NewSocialAccountChallenge(
+		user.Id,
+		user.Address,
+		input.Username,
+		input.Platform,
+		nonce,
+		metadata.BlockTimestamp+s.ChallengeTTL,
+		metadata.BlockTimestamp,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.SocialAccountRepository.CreateSocialAccountChallenge(challenge)
+	if err != nil {
+		return nil, err
+	}
+	return newSocialAccountChallengeOutputDTO(res), nil
+}
@@ -0,0 +1,35 @@
+package social_account
+
+import (
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/api"
+	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+	"github.com/rollmelette/rollmelette"
+)
+
+type UpdatePlatformSettingInputDTO = api.UpdatePlatformSettingInputDTO
+
+type UpdatePlatformSettingUseCase struct {
+	SocialAccountRepository repository.SocialAccountRepository
+}
+
+func NewUpdatePlatformSettingUseCase(socialAccountRepo repository.SocialAccountRepository) *UpdatePlatformSettingUseCase {
+	return &UpdatePlatformSettingUseCase{
+		SocialAccountRepository: socialAccountRepo,
+	}
+}
+
+// Execute enables or disables a registered platform. Existing social accounts are kept,
+// only new challenges and registrations are affected.
+func (s *UpdatePlatformSettingUseCase) Execute(input *UpdatePlatformSettingInputDTO, metadata rollmelette.Metadata) (*PlatformOutputDTO, error) {
+	setting, err := entity.NewPlatformSetting(input.Platform, input.Enabled, Address(metadata.MsgSender), metadata.BlockTimestamp)
+	if err != nil {
+		return nil, err
+	}
+	setting, err = s.SocialAccountRepository.SavePlatformSetting(setting)
+	if err != nil {
+		return nil, err
+	}
+	return newPlatformOutputDTO(setting.Platform, []*entity.PlatformSetting{setting}), nil
+}
@@ -0,0 +1,60 @@
+package state
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/api"
+)
+
+type FindStateProofInputDTO = api.FindStateProofInputDTO
+
+type FindStateProofOutputDTO = api.FindStateProofOutputDTO
+
+type FindStateProofUseCase struct {
+	StateRepository repository.StateRepository
+}
+
+func NewFindStateProofUseCase(stateRepo repository.StateRepository) *FindStateProofUseCase {
+	return &FindStateProofUseCase{
+		StateRepository: stateRepo,
+	}
+}
+
+func (u *FindStateProofUseCase) Execute(input *FindStateProofInputDTO) (*FindStateProofOutputDTO, error) {
+	leaves, err := u.StateRepository.FindAllStateLeaves()
+	if err != nil {
+		return nil, err
+	}
+	index := -1
+	for i, leaf := range leaves {
+		if leaf.Key == input.Key {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		return nil, entity.ErrStateLeafNotFound.New(input.Key)
+	}
+
+	row, err := u.StateRepository.FindStateRow(input.Key)
+	if err != nil {
+		return nil, err
+	}
+	content, err := json.Marshal(row.Content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode state row %s: %w", row.Key, err)
+	}
+
+	hashes := leafHashes(leaves)
+	return &FindStateProofOutputDTO{
+		Key:      input.Key,
+		Content:  content,
+		Leaf:     hashes[index],
+		Index:    index,
+		Siblings: Proof(hashes, index),
+		Root:     Root(hashes),
+	}, nil
+}
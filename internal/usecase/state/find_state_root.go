@@ -0,0 +1,27 @@
+package state
+
+import (
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+)
+
+type FindStateRootUseCase struct {
+	StateRepository repository.StateRepository
+}
+
+func NewFindStateRootUseCase(stateRepo repository.StateRepository) *FindStateRootUseCase {
+	return &FindStateRootUseCase{
+		StateRepository: stateRepo,
+	}
+}
+
+// Execute returns the root as of the last accepted advance input.
+func (u *FindStateRootUseCase) Execute() (*StateRootOutputDTO, error) {
+	leaves, err := u.StateRepository.FindAllStateLeaves()
+	if err != nil {
+		return nil, err
+	}
+	return &StateRootOutputDTO{
+		Root:   Root(leafHashes(leaves)),
+		Leaves: len(leaves),
+	}, nil
+}
@@ -0,0 +1,7 @@
+package state
+
+import (
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/api"
+)
+
+type StateRootOutputDTO = api.StateRootOutputDTO
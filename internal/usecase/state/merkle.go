@@ -0,0 +1,80 @@
+package state
+
+import (
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/api"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Leaves and inner nodes are hashed with different prefixes, so a node can never be passed off
+// as a leaf.
+const (
+	leafPrefix = 0x00
+	nodePrefix = 0x01
+)
+
+type ProofStepOutputDTO = api.ProofStepOutputDTO
+
+// LeafHash is keccak256(0x00 ‖ keccak256(key) ‖ keccak256(content)), where content is the
+// canonical JSON encoding of the row.
+func LeafHash(key string, content []byte) common.Hash {
+	return crypto.Keccak256Hash([]byte{leafPrefix}, crypto.Keccak256([]byte(key)), crypto.Keccak256(content))
+}
+
+func nodeHash(left common.Hash, right common.Hash) common.Hash {
+	return crypto.Keccak256Hash([]byte{nodePrefix}, left.Bytes(), right.Bytes())
+}
+
+// Root returns the root of the binary Merkle tree over the leaves. Inner nodes hash two
+// children with keccak256(0x01 ‖ left ‖ right), a node without sibling moves up unchanged
+// and the root of an empty tree is the zero hash.
+func Root(leaves []common.Hash) common.Hash {
+	if len(leaves) == 0 {
+		return common.Hash{}
+	}
+	level := leaves
+	for len(level) > 1 {
+		level = nextLevel(level)
+	}
+	return level[0]
+}
+
+// Proof returns the siblings on the path from the leaf at index to the root.
+func Proof(leaves []common.Hash, index int) []*ProofStepOutputDTO {
+	steps := []*ProofStepOutputDTO{}
+	level := leaves
+	for len(level) > 1 {
+		sibling := index ^ 1
+		if sibling < len(level) {
+			steps = append(steps, &ProofStepOutputDTO{Hash: level[sibling], Left: sibling < index})
+		}
+		level = nextLevel(level)
+		index /= 2
+	}
+	return steps
+}
+
+// Verify checks that the leaf belongs to the tree with the given root.
+func Verify(root common.Hash, leaf common.Hash, steps []*ProofStepOutputDTO) bool {
+	node := leaf
+	for _, step := range steps {
+		if step.Left {
+			node = nodeHash(step.Hash, node)
+		} else {
+			node = nodeHash(node, step.Hash)
+		}
+	}
+	return node == root
+}
+
+func nextLevel(level []common.Hash) []common.Hash {
+	next := make([]common.Hash, 0, (len(level)+1)/2)
+	for i := 0; i < len(level); i += 2 {
+		if i+1 < len(level) {
+			next = append(next, nodeHash(level[i], level[i+1]))
+		} else {
+			next = append(next, level[i])
+		}
+	}
+	return next
+}
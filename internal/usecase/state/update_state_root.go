@@ -0,0 +1,55 @@
+package state
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+type UpdateStateRootUseCase struct {
+	StateRepository repository.StateRepository
+}
+
+func NewUpdateStateRootUseCase(stateRepo repository.StateRepository) *UpdateStateRootUseCase {
+	return &UpdateStateRootUseCase{
+		StateRepository: stateRepo,
+	}
+}
+
+// Execute rehashes the rows of the tables written since the previous update and returns the
+// new root. The leaves of the other tables are reused as stored.
+func (u *UpdateStateRootUseCase) Execute() (*StateRootOutputDTO, error) {
+	changed, err := u.StateRepository.TakeChangedStateRows()
+	if err != nil {
+		return nil, err
+	}
+	for table, rows := range changed {
+		leaves := make([]*entity.StateLeaf, 0, len(rows))
+		for _, row := range rows {
+			content, err := json.Marshal(row.Content)
+			if err != nil {
+				return nil, fmt.Errorf("failed to encode state row %s: %w", row.Key, err)
+			}
+			leaves = append(leaves, &entity.StateLeaf{
+				Key:   row.Key,
+				Table: table,
+				Hash:  LeafHash(row.Key, content).Hex(),
+			})
+		}
+		if err := u.StateRepository.ReplaceStateLeaves(table, leaves); err != nil {
+			return nil, err
+		}
+	}
+	return NewFindStateRootUseCase(u.StateRepository).Execute()
+}
+
+func leafHashes(leaves []*entity.StateLeaf) []common.Hash {
+	hashes := make([]common.Hash, 0, len(leaves))
+	for _, leaf := range leaves {
+		hashes = append(hashes, common.HexToHash(leaf.Hash))
+	}
+	return hashes
+}
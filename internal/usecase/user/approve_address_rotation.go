@@ -0,0 +1,55 @@
+package user
+
+import (
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+	"github.com/rollmelette/rollmelette"
+)
+
+type ApproveAddressRotationInputDTO struct {
+	Id uint `json:"id" validate:"required"`
+}
+
+func (ApproveAddressRotationInputDTO) ABIMethod() string {
+	return "approveAddressRotation"
+}
+
+type ApproveAddressRotationUseCase struct {
+	UserRepository            repository.UserRepository
+	AddressRotationRepository repository.AddressRotationRepository
+}
+
+func NewApproveAddressRotationUseCase(
+	userRepo repository.UserRepository,
+	addressRotationRepo repository.AddressRotationRepository,
+) *ApproveAddressRotationUseCase {
+	return &ApproveAddressRotationUseCase{
+		UserRepository:            userRepo,
+		AddressRotationRepository: addressRotationRepo,
+	}
+}
+
+func (u *ApproveAddressRotationUseCase) Execute(input *ApproveAddressRotationInputDTO, metadata rollmelette.Metadata) (*AddressRotationOutputDTO, error) {
+	rotation, err := u.AddressRotationRepository.FindAddressRotationById(input.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ensureRotationCurrent(u.UserRepository, rotation); err != nil {
+		return nil, err
+	}
+
+	if err := rotation.Approve(Address(metadata.MsgSender), metadata.BlockTimestamp); err != nil {
+		return nil, err
+	}
+
+	if err := completeAddressRotation(u.UserRepository, rotation); err != nil {
+		return nil, err
+	}
+
+	res, err := u.AddressRotationRepository.UpdateAddressRotation(rotation)
+	if err != nil {
+		return nil, err
+	}
+	return newAddressRotationOutputDTO(res), nil
+}
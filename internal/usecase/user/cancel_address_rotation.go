@@ -0,0 +1,44 @@
+package user
+
+import (
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+	"github.com/rollmelette/rollmelette"
+)
+
+type CancelAddressRotationInputDTO struct {
+	Id uint `json:"id" validate:"required"`
+}
+
+func (CancelAddressRotationInputDTO) ABIMethod() string {
+	return "cancelAddressRotation"
+}
+
+type CancelAddressRotationUseCase struct {
+	AddressRotationRepository repository.AddressRotationRepository
+}
+
+func NewCancelAddressRotationUseCase(
+	addressRotationRepo repository.AddressRotationRepository,
+) *CancelAddressRotationUseCase {
+	return &CancelAddressRotationUseCase{
+		AddressRotationRepository: addressRotationRepo,
+	}
+}
+
+func (u *CancelAddressRotationUseCase) Execute(input *CancelAddressRotationInputDTO, metadata rollmelette.Metadata) (*AddressRotationOutputDTO, error) {
+	rotation, err := u.AddressRotationRepository.FindAddressRotationById(input.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := rotation.Cancel(Address(metadata.MsgSender), metadata.BlockTimestamp); err != nil {
+		return nil, err
+	}
+
+	res, err := u.AddressRotationRepository.UpdateAddressRotation(rotation)
+	if err != nil {
+		return nil, err
+	}
+	return newAddressRotationOutputDTO(res), nil
+}
@@ -3,24 +3,13 @@ package user
 import (
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
-	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
-	"github.com/holiman/uint256"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/api"
 	"github.com/rollmelette/rollmelette"
 )
 
-type CreateUserInputDTO struct {
-	Role    string  `json:"role" validate:"required"`
-	Address Address `json:"address" validate:"required"`
-}
+type CreateUserInputDTO = api.CreateUserInputDTO
 
-type CreateUserOutputDTO struct {
-	Id              uint                    `json:"id"`
-	Role            string                  `json:"role"`
-	Address         Address                 `json:"address"`
-	SocialAccounts  []*entity.SocialAccount `json:"social_accounts"`
-	InvestmentLimit *uint256.Int            `json:"investment_limit,omitempty" gorm:"type:bigint"`
-	CreatedAt       int64                   `json:"created_at"`
-}
+type CreateUserOutputDTO = api.CreateUserOutputDTO
 
 type CreateUserUseCase struct {
 	UserRepository repository.UserRepository
@@ -47,9 +36,10 @@ func (u *CreateUserUseCase) Execute(input *CreateUserInputDTO, metadata rollmele
 
 	return &CreateUserOutputDTO{
 		Id:             res.Id,
-		Role:           string(res.Role),
+		Roles:          res.RoleNames(),
 		Address:        res.Address,
-		SocialAccounts: res.SocialAccounts,
+		Status:         string(res.Status),
+		SocialAccounts: SocialAccountsOf(res),
 		CreatedAt:      res.CreatedAt,
 	}, nil
 }
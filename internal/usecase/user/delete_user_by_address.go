@@ -1,26 +1,102 @@
 package user
 
 import (
+	"fmt"
+	"strings"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/reputation"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/api"
 	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+	"github.com/rollmelette/rollmelette"
 )
 
-type DeleteUserInputDTO struct {
-	Address Address `json:"address" validate:"required"`
-}
+type DeleteUserInputDTO = api.DeleteUserInputDTO
 
+// DeleteUserUseCase deactivates a user. Users are never removed, since issuances and
+// orders reference them by address.
 type DeleteUserUseCase struct {
-	UserRepository repository.UserRepository
+	UserRepository     repository.UserRepository
+	IssuanceRepository repository.IssuanceRepository
+	OrderRepository    repository.OrderRepository
 }
 
 func NewDeleteUserUseCase(
 	userRepo repository.UserRepository,
+	issuanceRepo repository.IssuanceRepository,
+	orderRepo repository.OrderRepository,
 ) *DeleteUserUseCase {
 	return &DeleteUserUseCase{
-		UserRepository: userRepo,
+		UserRepository:     userRepo,
+		IssuanceRepository: issuanceRepo,
+		OrderRepository:    orderRepo,
+	}
+}
+
+func (u *DeleteUserUseCase) Execute(input *DeleteUserInputDTO, metadata rollmelette.Metadata) (*UserOutputDTO, error) {
+	user, err := u.UserRepository.FindUserByAddress(input.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	positions, err := u.openPositions(user.Address)
+	if err != nil {
+		return nil, err
+	}
+	if len(positions) > 0 {
+		return nil, domain.ErrUserOpenPositions.New(user.Address, strings.Join(positions, ", "))
+	}
+
+	if err := user.Deactivate(metadata.BlockTimestamp); err != nil {
+		return nil, err
 	}
+
+	res, err := u.UserRepository.UpdateUser(user)
+	if err != nil {
+		return nil, err
+	}
+
+	return &UserOutputDTO{
+		Id:             res.Id,
+		Roles:          res.RoleNames(),
+		Address:        res.Address,
+		Status:         string(res.Status),
+		SocialAccounts: SocialAccountsOf(res),
+		Reputation:     reputation.Of(res),
+		DeactivatedAt:  res.DeactivatedAt,
+		CreatedAt:      res.CreatedAt,
+		UpdatedAt:      res.UpdatedAt,
+	}, nil
 }
 
-func (u *DeleteUserUseCase) Execute(input *DeleteUserInputDTO) error {
-	return u.UserRepository.DeleteUser(input.Address)
+// openPositions lists the issuances the user still owes and the orders still awaiting
+// settlement.
+func (u *DeleteUserUseCase) openPositions(address Address) ([]string, error) {
+	var positions []string
+
+	issuances, err := u.IssuanceRepository.FindIssuancesByCreatorAddress(address)
+	if err != nil {
+		return nil, err
+	}
+	for _, issuance := range issuances {
+		switch issuance.State {
+		case entity.IssuanceStateOngoing, entity.IssuanceStateClosed:
+			positions = append(positions, fmt.Sprintf("issuance %d", issuance.Id))
+		}
+	}
+
+	orders, err := u.OrderRepository.FindOrdersByInvestorAddress(address)
+	if err != nil {
+		return nil, err
+	}
+	for _, order := range orders {
+		switch order.State {
+		case entity.OrderStatePending, entity.OrderStateAccepted, entity.OrderStatePartiallyAccepted:
+			positions = append(positions, fmt.Sprintf("order %d", order.Id))
+		}
+	}
+
+	return positions, nil
 }
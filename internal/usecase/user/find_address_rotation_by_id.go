@@ -0,0 +1,29 @@
+package user
+
+import (
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+)
+
+type FindAddressRotationByIdInputDTO struct {
+	Id uint `json:"id" validate:"required"`
+}
+
+type FindAddressRotationByIdUseCase struct {
+	AddressRotationRepository repository.AddressRotationRepository
+}
+
+func NewFindAddressRotationByIdUseCase(
+	addressRotationRepo repository.AddressRotationRepository,
+) *FindAddressRotationByIdUseCase {
+	return &FindAddressRotationByIdUseCase{
+		AddressRotationRepository: addressRotationRepo,
+	}
+}
+
+func (u *FindAddressRotationByIdUseCase) Execute(input *FindAddressRotationByIdInputDTO) (*AddressRotationOutputDTO, error) {
+	res, err := u.AddressRotationRepository.FindAddressRotationById(input.Id)
+	if err != nil {
+		return nil, err
+	}
+	return newAddressRotationOutputDTO(res), nil
+}
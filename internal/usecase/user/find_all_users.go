@@ -2,9 +2,11 @@ package user
 
 import (
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/reputation"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/api"
 )
 
-type FindAllUsersOutputDTO []*UserOutputDTO
+type FindAllUsersOutputDTO = api.FindAllUsersOutputDTO
 
 type FindAllUsersUseCase struct {
 	UserRepository repository.UserRepository
@@ -27,9 +29,12 @@ func (u *FindAllUsersUseCase) Execute() (*FindAllUsersOutputDTO, error) {
 	for i, user := range res {
 		output[i] = &UserOutputDTO{
 			Id:             user.Id,
-			Role:           string(user.Role),
+			Roles:          user.RoleNames(),
 			Address:        user.Address,
-			SocialAccounts: user.SocialAccounts,
+			Status:         string(user.Status),
+			SocialAccounts: SocialAccountsOf(user),
+			Reputation:     reputation.Of(user),
+			DeactivatedAt:  user.DeactivatedAt,
 			CreatedAt:      user.CreatedAt,
 			UpdatedAt:      user.UpdatedAt,
 		}
@@ -2,12 +2,11 @@ package user
 
 import (
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
-	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/reputation"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/api"
 )
 
-type FindUserByAddressInputDTO struct {
-	Address Address `json:"address" validate:"required"`
-}
+type FindUserByAddressInputDTO = api.FindUserByAddressInputDTO
 
 type FindUserByAddressUseCase struct {
 	UserRepository repository.UserRepository
@@ -28,9 +27,12 @@ func (u *FindUserByAddressUseCase) Execute(input *FindUserByAddressInputDTO) (*U
 	}
 	return &UserOutputDTO{
 		Id:             res.Id,
-		Role:           string(res.Role),
+		Roles:          res.RoleNames(),
 		Address:        res.Address,
-		SocialAccounts: res.SocialAccounts,
+		Status:         string(res.Status),
+		SocialAccounts: SocialAccountsOf(res),
+		Reputation:     reputation.Of(res),
+		DeactivatedAt:  res.DeactivatedAt,
 		CreatedAt:      res.CreatedAt,
 		UpdatedAt:      res.UpdatedAt,
 	}, nil
@@ -2,6 +2,7 @@ package user
 
 import (
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/reputation"
 )
 
 type FindUserByRoleInputDTO struct {
@@ -31,9 +32,12 @@ func (u *FindUsersByRoleUseCase) Execute(input *FindUserByRoleInputDTO) ([]*User
 	for i, user := range res {
 		output[i] = &UserOutputDTO{
 			Id:             user.Id,
-			Role:           string(user.Role),
+			Roles:          user.RoleNames(),
 			Address:        user.Address,
-			SocialAccounts: user.SocialAccounts,
+			Status:         string(user.Status),
+			SocialAccounts: SocialAccountsOf(user),
+			Reputation:     reputation.Of(user),
+			DeactivatedAt:  user.DeactivatedAt,
 			CreatedAt:      user.CreatedAt,
 			UpdatedAt:      user.UpdatedAt,
 		}
@@ -2,6 +2,7 @@ package user
 
 import (
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/api"
 	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
 )
 
@@ -10,11 +11,55 @@ type BalanceOfInputDTO struct {
 	Address Address `json:"address" validate:"required"`
 }
 
-type UserOutputDTO struct {
-	Id             uint                    `json:"id"`
-	Role           string                  `json:"role"`
-	Address        Address                 `json:"address"`
-	SocialAccounts []*entity.SocialAccount `json:"social_accounts"`
-	CreatedAt      int64                   `json:"created_at"`
-	UpdatedAt      int64                   `json:"updated_at"`
+type UserOutputDTO = api.UserOutputDTO
+
+// SocialAccountsOf returns the social accounts listed in the outputs of user.
+func SocialAccountsOf(user *entity.User) []*api.UserSocialAccountOutputDTO {
+	if user.SocialAccounts == nil {
+		return nil
+	}
+	accounts := make([]*api.UserSocialAccountOutputDTO, len(user.SocialAccounts))
+	for i, account := range user.SocialAccounts {
+		accounts[i] = &api.UserSocialAccountOutputDTO{
+			Id:        account.Id,
+			UserId:    account.UserId,
+			Username:  account.Username,
+			Platform:  string(account.Platform),
+			CreatedAt: account.CreatedAt,
+			UpdatedAt: account.UpdatedAt,
+		}
+	}
+	return accounts
+}
+
+type AddressRotationOutputDTO struct {
+	Id               uint    `json:"id"`
+	UserId           uint    `json:"user_id"`
+	OldAddress       Address `json:"old_address"`
+	NewAddress       Address `json:"new_address"`
+	State            string  `json:"state"`
+	RequiresApproval bool    `json:"requires_approval"`
+	ApprovedBy       Address `json:"approved_by"`
+	AcceptedAt       int64   `json:"accepted_at,omitempty"`
+	CompletedAt      int64   `json:"completed_at,omitempty"`
+	CanceledAt       int64   `json:"canceled_at,omitempty"`
+	CreatedAt        int64   `json:"created_at"`
+	UpdatedAt        int64   `json:"updated_at"`
+}
+
+func newAddressRotationOutputDTO(rotation *entity.AddressRotation) *AddressRotationOutputDTO {
+	return &AddressRotationOutputDTO{
+		Id:               rotation.Id,
+		UserId:           rotation.UserId,
+		OldAddress:       rotation.OldAddress,
+		NewAddress:       rotation.NewAddress,
+		State:            string(rotation.State),
+		RequiresApproval: rotation.RequiresApproval,
+		ApprovedBy:       rotation.ApprovedBy,
+		AcceptedAt:       rotation.AcceptedAt,
+		CompletedAt:      rotation.CompletedAt,
+		CanceledAt:       rotation.CanceledAt,
+		CreatedAt:        rotation.CreatedAt,
+		UpdatedAt:        rotation.UpdatedAt,
+	}
 }
@@ -0,0 +1,63 @@
+package user
+
+import (
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/reputation"
+	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+	"github.com/rollmelette/rollmelette"
+)
+
+type GrantUserRoleInputDTO struct {
+	Address Address `json:"address" validate:"required"`
+	Role    string  `json:"role" validate:"required,oneof=admin creator investor verifier"`
+}
+
+func (GrantUserRoleInputDTO) ABIMethod() string {
+	return "grantUserRole"
+}
+
+type GrantUserRoleUseCase struct {
+	UserRepository repository.UserRepository
+}
+
+func NewGrantUserRoleUseCase(
+	userRepo repository.UserRepository,
+) *GrantUserRoleUseCase {
+	return &GrantUserRoleUseCase{
+		UserRepository: userRepo,
+	}
+}
+
+func (u *GrantUserRoleUseCase) Execute(input *GrantUserRoleInputDTO, metadata rollmelette.Metadata) (*UserOutputDTO, error) {
+	user, err := u.UserRepository.FindUserByAddress(input.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	assignment, err := user.GrantRole(entity.UserRole(input.Role), metadata.BlockTimestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.UserRepository.CreateUserRole(assignment); err != nil {
+		return nil, err
+	}
+
+	res, err := u.UserRepository.UpdateUser(user)
+	if err != nil {
+		return nil, err
+	}
+
+	return &UserOutputDTO{
+		Id:             res.Id,
+		Roles:          res.RoleNames(),
+		Address:        res.Address,
+		Status:         string(res.Status),
+		SocialAccounts: SocialAccountsOf(res),
+		Reputation:     reputation.Of(res),
+		DeactivatedAt:  res.DeactivatedAt,
+		CreatedAt:      res.CreatedAt,
+		UpdatedAt:      res.UpdatedAt,
+	}, nil
+}
@@ -0,0 +1,109 @@
+package user
+
+import (
+	"errors"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+	"github.com/rollmelette/rollmelette"
+)
+
+type ProposeAddressRotationInputDTO struct {
+	NewAddress Address `json:"new_address" validate:"required"`
+}
+
+func (ProposeAddressRotationInputDTO) ABIMethod() string {
+	return "proposeAddressRotation"
+}
+
+type ProposeAddressRotationUseCase struct {
+	UserRepository            repository.UserRepository
+	AddressRotationRepository repository.AddressRotationRepository
+	RequiresApproval          bool
+}
+
+func NewProposeAddressRotationUseCase(
+	userRepo repository.UserRepository,
+	addressRotationRepo repository.AddressRotationRepository,
+	requiresApproval bool,
+) *ProposeAddressRotationUseCase {
+	return &ProposeAddressRotationUseCase{
+		UserRepository:            userRepo,
+		AddressRotationRepository: addressRotationRepo,
+		RequiresApproval:          requiresApproval,
+	}
+}
+
+// Execute proposes moving the sender to a new address, which must not belong to a user. A user
+// has one pending rotation at most.
+func (u *ProposeAddressRotationUseCase) Execute(input *ProposeAddressRotationInputDTO, metadata rollmelette.Metadata) (*AddressRotationOutputDTO, error) {
+	user, err := u.UserRepository.FindUserByAddress(Address(metadata.MsgSender))
+	if err != nil {
+		return nil, err
+	}
+	if !user.Active() {
+		return nil, domain.ErrUserDeactivated.New(user.Address)
+	}
+
+	pending, err := u.AddressRotationRepository.FindPendingAddressRotationByUserId(user.Id)
+	switch {
+	case err == nil:
+		return nil, domain.ErrAddressRotationPending.New(user.Address, pending.Id)
+	case !errors.Is(err, entity.ErrAddressRotationNotFound):
+		return nil, err
+	}
+
+	if err := ensureAddressAvailable(u.UserRepository, input.NewAddress); err != nil {
+		return nil, err
+	}
+
+	rotation, err := entity.NewAddressRotation(user.Id, user.Address, input.NewAddress, u.RequiresApproval, metadata.BlockTimestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := u.AddressRotationRepository.CreateAddressRotation(rotation)
+	if err != nil {
+		return nil, err
+	}
+	return newAddressRotationOutputDTO(res), nil
+}
+
+func ensureAddressAvailable(userRepo repository.UserRepository, address Address) error {
+	_, err := userRepo.FindUserByAddress(address)
+	if err == nil {
+		return domain.ErrAddressInUse.New(address)
+	}
+	if !errors.Is(err, entity.ErrUserNotFound) {
+		return err
+	}
+	return nil
+}
+
+// ensureRotationCurrent fails unless the user of the rotation still holds its old address, so a
+// rotation proposed before another one completed cannot move the user again.
+func ensureRotationCurrent(userRepo repository.UserRepository, rotation *entity.AddressRotation) error {
+	user, err := userRepo.FindUserByAddress(rotation.OldAddress)
+	switch {
+	case errors.Is(err, entity.ErrUserNotFound):
+		return domain.ErrAddressRotationStale.New(rotation.Id, rotation.OldAddress)
+	case err != nil:
+		return err
+	case user.Id != rotation.UserId:
+		return domain.ErrAddressRotationStale.New(rotation.Id, rotation.OldAddress)
+	}
+	return nil
+}
+
+// completeAddressRotation moves the user to the new address once the rotation completes.
+func completeAddressRotation(userRepo repository.UserRepository, rotation *entity.AddressRotation) error {
+	if !rotation.Completed() {
+		return nil
+	}
+	if err := ensureAddressAvailable(userRepo, rotation.NewAddress); err != nil {
+		return err
+	}
+	return userRepo.RotateUserAddress(rotation.UserId, rotation.OldAddress, rotation.NewAddress, rotation.CompletedAt)
+}
@@ -0,0 +1,50 @@
+package user
+
+import (
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/reputation"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/api"
+	"github.com/rollmelette/rollmelette"
+)
+
+type ReactivateUserInputDTO = api.ReactivateUserInputDTO
+
+type ReactivateUserUseCase struct {
+	UserRepository repository.UserRepository
+}
+
+func NewReactivateUserUseCase(
+	userRepo repository.UserRepository,
+) *ReactivateUserUseCase {
+	return &ReactivateUserUseCase{
+		UserRepository: userRepo,
+	}
+}
+
+func (u *ReactivateUserUseCase) Execute(input *ReactivateUserInputDTO, metadata rollmelette.Metadata) (*UserOutputDTO, error) {
+	user, err := u.UserRepository.FindUserByAddress(input.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := user.Reactivate(metadata.BlockTimestamp); err != nil {
+		return nil, err
+	}
+
+	res, err := u.UserRepository.UpdateUser(user)
+	if err != nil {
+		return nil, err
+	}
+
+	return &UserOutputDTO{
+		Id:             res.Id,
+		Roles:          res.RoleNames(),
+		Address:        res.Address,
+		Status:         string(res.Status),
+		SocialAccounts: SocialAccountsOf(res),
+		Reputation:     reputation.Of(res),
+		DeactivatedAt:  res.DeactivatedAt,
+		CreatedAt:      res.CreatedAt,
+		UpdatedAt:      res.UpdatedAt,
+	}, nil
+}
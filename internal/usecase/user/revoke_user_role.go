@@ -0,0 +1,63 @@
+package user
+
+import (
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/reputation"
+	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+	"github.com/rollmelette/rollmelette"
+)
+
+type RevokeUserRoleInputDTO struct {
+	Address Address `json:"address" validate:"required"`
+	Role    string  `json:"role" validate:"required,oneof=admin creator investor verifier"`
+}
+
+func (RevokeUserRoleInputDTO) ABIMethod() string {
+	return "revokeUserRole"
+}
+
+type RevokeUserRoleUseCase struct {
+	UserRepository repository.UserRepository
+}
+
+func NewRevokeUserRoleUseCase(
+	userRepo repository.UserRepository,
+) *RevokeUserRoleUseCase {
+	return &RevokeUserRoleUseCase{
+		UserRepository: userRepo,
+	}
+}
+
+func (u *RevokeUserRoleUseCase) Execute(input *RevokeUserRoleInputDTO, metadata rollmelette.Metadata) (*UserOutputDTO, error) {
+	user, err := u.UserRepository.FindUserByAddress(input.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	role := entity.UserRole(input.Role)
+	if err := user.RevokeRole(role, metadata.BlockTimestamp); err != nil {
+		return nil, err
+	}
+
+	if err := u.UserRepository.DeleteUserRole(user.Id, role); err != nil {
+		return nil, err
+	}
+
+	res, err := u.UserRepository.UpdateUser(user)
+	if err != nil {
+		return nil, err
+	}
+
+	return &UserOutputDTO{
+		Id:             res.Id,
+		Roles:          res.RoleNames(),
+		Address:        res.Address,
+		Status:         string(res.Status),
+		SocialAccounts: SocialAccountsOf(res),
+		Reputation:     reputation.Of(res),
+		DeactivatedAt:  res.DeactivatedAt,
+		CreatedAt:      res.CreatedAt,
+		UpdatedAt:      res.UpdatedAt,
+	}, nil
+}
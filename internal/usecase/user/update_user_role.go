@@ -0,0 +1,71 @@
+package user
+
+import (
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/reputation"
+	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+	"github.com/rollmelette/rollmelette"
+)
+
+type UpdateUserRoleInputDTO struct {
+	Address Address `json:"address" validate:"required"`
+	Role    string  `json:"role" validate:"required,oneof=admin creator investor verifier"`
+}
+
+func (UpdateUserRoleInputDTO) ABIMethod() string {
+	return "updateUserRole"
+}
+
+type UpdateUserRoleUseCase struct {
+	UserRepository repository.UserRepository
+}
+
+func NewUpdateUserRoleUseCase(
+	userRepo repository.UserRepository,
+) *UpdateUserRoleUseCase {
+	return &UpdateUserRoleUseCase{
+		UserRepository: userRepo,
+	}
+}
+
+// Execute replaces the roles of the user with the given role.
+func (u *UpdateUserRoleUseCase) Execute(input *UpdateUserRoleInputDTO, metadata rollmelette.Metadata) (*UserOutputDTO, error) {
+	user, err := u.UserRepository.FindUserByAddress(input.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	granted, revoked, err := user.ChangeRole(entity.UserRole(input.Role), metadata.BlockTimestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, role := range revoked {
+		if err := u.UserRepository.DeleteUserRole(user.Id, role); err != nil {
+			return nil, err
+		}
+	}
+	if granted != nil {
+		if err := u.UserRepository.CreateUserRole(granted); err != nil {
+			return nil, err
+		}
+	}
+
+	res, err := u.UserRepository.UpdateUser(user)
+	if err != nil {
+		return nil, err
+	}
+
+	return &UserOutputDTO{
+		Id:             res.Id,
+		Roles:          res.RoleNames(),
+		Address:        res.Address,
+		Status:         string(res.Status),
+		SocialAccounts: SocialAccountsOf(res),
+		Reputation:     reputation.Of(res),
+		DeactivatedAt:  res.DeactivatedAt,
+		CreatedAt:      res.CreatedAt,
+		UpdatedAt:      res.UpdatedAt,
+	}, nil
+}
@@ -1,20 +1,27 @@
 package user
 
 import (
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/api"
 	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
-	"github.com/holiman/uint256"
 )
 
-type WithdrawInputDTO struct {
-	Token  Address      `json:"token" validate:"required"`
-	Amount *uint256.Int `json:"amount" validate:"required"`
-}
+type WithdrawInputDTO = api.WithdrawInputDTO
+
+type WithdrawOutputDTO = api.WithdrawOutputDTO
 
 type EmergencyERC20WithdrawInputDTO struct {
 	To    Address `json:"to" validate:"required"`
 	Token Address `json:"token" validate:"required"`
 }
 
+func (EmergencyERC20WithdrawInputDTO) ABIMethod() string {
+	return "emergencyERC20Withdraw"
+}
+
 type EmergencyEtherWithdrawInputDTO struct {
 	To Address `json:"to" validate:"required"`
 }
+
+func (EmergencyEtherWithdrawInputDTO) ABIMethod() string {
+	return "emergencyEtherWithdraw"
+}
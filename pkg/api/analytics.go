@@ -0,0 +1,48 @@
+package api
+
+import (
+	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+	"github.com/holiman/uint256"
+)
+
+type FindAnalyticsSeriesInputDTO struct {
+	Interval string `json:"interval" validate:"required,oneof=daily weekly"`
+	// From and To bound the creation time of the activity, both inclusive. A zero To leaves the
+	// series open ended.
+	From int64 `json:"from"`
+	To   int64 `json:"to"`
+}
+
+type FindAnalyticsSummaryOutputDTO struct {
+	Issuances map[string]uint64  `json:"issuances"`
+	Raised    []*RaisedOutputDTO `json:"raised"`
+	// AverageClearingRate is the average of the highest accepted rate of each closed issuance,
+	// in basis points.
+	AverageClearingRate float64 `json:"average_clearing_rate"`
+	// DefaultRate is the share of matured issuances that had their collateral executed.
+	DefaultRate     float64 `json:"default_rate"`
+	ActiveInvestors uint64  `json:"active_investors"`
+	Investors       uint64  `json:"investors"`
+}
+
+type RaisedOutputDTO struct {
+	Token     Address      `json:"token"`
+	Issuances uint64       `json:"issuances"`
+	Amount    *uint256.Int `json:"amount"`
+	Fees      *uint256.Int `json:"fees"`
+}
+
+type FindAnalyticsSeriesOutputDTO []*BucketOutputDTO
+
+type BucketOutputDTO struct {
+	Start     int64                   `json:"start"`
+	Issuances uint64                  `json:"issuances"`
+	Orders    uint64                  `json:"orders"`
+	Investors uint64                  `json:"investors"`
+	Volume    []*TokenAmountOutputDTO `json:"volume"`
+}
+
+type TokenAmountOutputDTO struct {
+	Token  Address      `json:"token"`
+	Amount *uint256.Int `json:"amount"`
+}
@@ -0,0 +1,12 @@
+package api
+
+import (
+	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+)
+
+type FindGenesisOutputDTO struct {
+	Hash          string    `json:"hash"`
+	InputIndex    uint      `json:"input_index"`
+	AppliedAt     int64     `json:"applied_at"`
+	AllowedTokens []Address `json:"allowed_tokens"`
+}
@@ -0,0 +1,177 @@
+package api
+
+import (
+	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+	"github.com/holiman/uint256"
+)
+
+type CreateIssuanceInputDTO struct {
+	Title           string       `json:"title" validate:"required,min=3,max=100"`
+	Description     string       `json:"description" validate:"required,min=10,max=1000"`
+	Promotion       string       `json:"promotion" validate:"required,min=5,max=500"`
+	Token           Address      `json:"token" validate:"required"`
+	DebtIssued      *uint256.Int `json:"debt_issued" validate:"required"`
+	MaxInterestRate *uint256.Int `json:"max_interest_rate" validate:"required"`
+	ClosesAt        int64        `json:"closes_at" validate:"required"`
+	MaturityAt      int64        `json:"maturity_at" validate:"required"`
+	// Attestation types the creator and each investor must hold while the issuance is ongoing.
+	RequiredCreatorAttestations  []string `json:"required_creator_attestations" validate:"omitempty,unique,dive,oneof=kyc accredited_investor jurisdiction"`
+	RequiredInvestorAttestations []string `json:"required_investor_attestations" validate:"omitempty,unique,dive,oneof=kyc accredited_investor jurisdiction"`
+}
+
+func (CreateIssuanceInputDTO) ABIMethod() string {
+	return "createIssuance"
+}
+
+type CloseIssuanceInputDTO struct {
+	CreatorAddress Address `json:"creator_address" validate:"required"`
+}
+
+func (CloseIssuanceInputDTO) ABIMethod() string {
+	return "closeIssuance"
+}
+
+type SettleIssuanceInputDTO struct {
+	Id uint `json:"id" validate:"required"`
+}
+
+func (SettleIssuanceInputDTO) ABIMethod() string {
+	return "settleIssuance"
+}
+
+type ExecuteIssuanceCollateralInputDTO struct {
+	Id uint `json:"id" validate:"required"`
+}
+
+func (ExecuteIssuanceCollateralInputDTO) ABIMethod() string {
+	return "executeIssuanceCollateral"
+}
+
+type FindIssuanceByIdInputDTO struct {
+	Id uint `json:"id" validate:"required"`
+}
+
+type FindIssuancesByCreatorAddressInputDTO struct {
+	CreatorAddress Address `json:"creator" validate:"required"`
+}
+
+type FindIssuancesByInvestorAddressInputDTO struct {
+	InvestorAddress Address `json:"investor_address" validate:"required"`
+}
+
+type CreateIssuanceOutputDTO struct {
+	Id                           uint              `json:"id"`
+	Title                        string            `json:"title,omitempty"`
+	Description                  string            `json:"description,omitempty"`
+	Promotion                    string            `json:"promotion,omitempty"`
+	Token                        Address           `json:"token,omitempty"`
+	Creator                      *UserOutputDTO    `json:"creator,omitempty"`
+	CollateralAddress            Address           `json:"collateral,omitempty"`
+	CollateralAmount             *uint256.Int      `json:"collateral_amount,omitempty"`
+	BadgeAddress                 Address           `json:"badge_address,omitempty"`
+	DebtIssued                   *uint256.Int      `json:"debt_issued"`
+	MaxInterestRate              *uint256.Int      `json:"max_interest_rate"`
+	State                        string            `json:"state"`
+	RequiredCreatorAttestations  []string          `json:"required_creator_attestations,omitempty"`
+	RequiredInvestorAttestations []string          `json:"required_investor_attestations,omitempty"`
+	Orders                       []*OrderOutputDTO `json:"orders"`
+	CreatedAt                    int64             `json:"created_at"`
+	ClosesAt                     int64             `json:"closes_at"`
+	MaturityAt                   int64             `json:"maturity_at"`
+}
+
+type CloseIssuanceOutputDTO struct {
+	Id                uint              `json:"id"`
+	Title             string            `json:"title,omitempty"`
+	Description       string            `json:"description,omitempty"`
+	Promotion         string            `json:"promotion,omitempty"`
+	Token             Address           `json:"token,omitempty"`
+	Creator           *UserOutputDTO    `json:"creator,omitempty"`
+	CollateralAddress Address           `json:"collateral,omitempty"`
+	CollateralAmount  *uint256.Int      `json:"collateral_amount,omitempty"`
+	BadgeAddress      Address           `json:"badge_address,omitempty"`
+	DebtIssued        *uint256.Int      `json:"debt_issued,omitempty"`
+	MaxInterestRate   *uint256.Int      `json:"max_interest_rate,omitempty"`
+	TotalObligation   *uint256.Int      `json:"total_obligation,omitempty"`
+	TotalRaised       *uint256.Int      `json:"total_raised,omitempty"`
+	State             string            `json:"state,omitempty"`
+	Orders            []*OrderOutputDTO `json:"orders,omitempty"`
+	CreatedAt         int64             `json:"created_at,omitempty"`
+	ClosesAt          int64             `json:"closes_at,omitempty"`
+	MaturityAt        int64             `json:"maturity_at,omitempty"`
+	UpdatedAt         int64             `json:"updated_at,omitempty"`
+}
+
+type SettleIssuanceOutputDTO struct {
+	Id                uint              `json:"id"`
+	Title             string            `json:"title,omitempty"`
+	Description       string            `json:"description,omitempty"`
+	Promotion         string            `json:"promotion,omitempty"`
+	Token             Address           `json:"token"`
+	Creator           *UserOutputDTO    `json:"creator"`
+	CollateralAddress Address           `json:"collateral"`
+	CollateralAmount  *uint256.Int      `json:"collateral_amount"`
+	BadgeAddress      Address           `json:"badge_address"`
+	DebtIssued        *uint256.Int      `json:"debt_issued"`
+	MaxInterestRate   *uint256.Int      `json:"max_interest_rate"`
+	TotalObligation   *uint256.Int      `json:"total_obligation"`
+	TotalRaised       *uint256.Int      `json:"total_raised"`
+	State             string            `json:"state"`
+	Orders            []*OrderOutputDTO `json:"orders"`
+	CreatedAt         int64             `json:"created_at"`
+	ClosesAt          int64             `json:"closes_at"`
+	MaturityAt        int64             `json:"maturity_at"`
+	UpdatedAt         int64             `json:"updated_at"`
+}
+
+type ExecuteIssuanceCollateralOutputDTO struct {
+	Id                uint              `json:"id"`
+	Title             string            `json:"title,omitempty"`
+	Description       string            `json:"description,omitempty"`
+	Promotion         string            `json:"promotion,omitempty"`
+	Token             Address           `json:"token"`
+	Creator           *UserOutputDTO    `json:"creator"`
+	CollateralAddress Address           `json:"collateral"`
+	CollateralAmount  *uint256.Int      `json:"collateral_amount"`
+	BadgeAddress      Address           `json:"badge_address"`
+	DebtIssued        *uint256.Int      `json:"debt_issued"`
+	MaxInterestRate   *uint256.Int      `json:"max_interest_rate"`
+	TotalObligation   *uint256.Int      `json:"total_obligation"`
+	TotalRaised       *uint256.Int      `json:"total_raised"`
+	State             string            `json:"state"`
+	Orders            []*OrderOutputDTO `json:"orders"`
+	CreatedAt         int64             `json:"created_at"`
+	ClosesAt          int64             `json:"closes_at"`
+	MaturityAt        int64             `json:"maturity_at"`
+	UpdatedAt         int64             `json:"updated_at"`
+}
+
+type IssuanceOutputDTO struct {
+	Id                           uint              `json:"id"`
+	Title                        string            `json:"title,omitempty"`
+	Description                  string            `json:"description,omitempty"`
+	Promotion                    string            `json:"promotion,omitempty"`
+	Token                        Address           `json:"token"`
+	Creator                      *UserOutputDTO    `json:"creator"`
+	CollateralAddress            Address           `json:"collateral"`
+	CollateralAmount             *uint256.Int      `json:"collateral_amount"`
+	BadgeAddress                 Address           `json:"badge_address"`
+	DebtIssued                   *uint256.Int      `json:"debt_issued"`
+	MaxInterestRate              *uint256.Int      `json:"max_interest_rate"`
+	TotalObligation              *uint256.Int      `json:"total_obligation"`
+	TotalRaised                  *uint256.Int      `json:"total_raised"`
+	State                        string            `json:"state"`
+	RequiredCreatorAttestations  []string          `json:"required_creator_attestations,omitempty"`
+	RequiredInvestorAttestations []string          `json:"required_investor_attestations,omitempty"`
+	Orders                       []*OrderOutputDTO `json:"orders"`
+	CreatedAt                    int64             `json:"created_at"`
+	ClosesAt                     int64             `json:"closes_at"`
+	MaturityAt                   int64             `json:"maturity_at"`
+	UpdatedAt                    int64             `json:"updated_at"`
+}
+
+type FindAllIssuancesOutputDTO []*IssuanceOutputDTO
+
+type FindIssuancesByCreatorAddressOutputDTO []*IssuanceOutputDTO
+
+type FindIssuancesByInvestorAddressOutputDTO []*IssuanceOutputDTO
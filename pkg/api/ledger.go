@@ -0,0 +1,42 @@
+package api
+
+import (
+	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+	"github.com/holiman/uint256"
+)
+
+// FindLedgerEntriesInputDTO filters the entries by issuance or by account. Without filters
+// every entry is returned.
+type FindLedgerEntriesInputDTO struct {
+	IssuanceId uint    `json:"issuance_id"`
+	Account    Address `json:"account"`
+}
+
+type LedgerEntryOutputDTO struct {
+	Id            uint         `json:"id"`
+	DebitAccount  Address      `json:"debit_account"`
+	CreditAccount Address      `json:"credit_account"`
+	Token         Address      `json:"token"`
+	Amount        *uint256.Int `json:"amount"`
+	Reason        string       `json:"reason"`
+	IssuanceId    uint         `json:"issuance_id,omitempty"`
+	OrderId       uint         `json:"order_id,omitempty"`
+	InputIndex    uint         `json:"input_index"`
+	CreatedAt     int64        `json:"created_at"`
+}
+
+type FindLedgerEntriesOutputDTO []*LedgerEntryOutputDTO
+
+type ReconcileLedgerOutputDTO struct {
+	Account    Address                         `json:"account"`
+	Reconciled bool                            `json:"reconciled"`
+	Tokens     []*TokenReconciliationOutputDTO `json:"tokens"`
+}
+
+type TokenReconciliationOutputDTO struct {
+	Token         Address      `json:"token"`
+	Debits        *uint256.Int `json:"debits"`
+	Credits       *uint256.Int `json:"credits"`
+	WalletBalance *uint256.Int `json:"wallet_balance"`
+	Reconciled    bool         `json:"reconciled"`
+}
@@ -0,0 +1,66 @@
+package api
+
+import (
+	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+	"github.com/holiman/uint256"
+)
+
+type CreateOrderInputDTO struct {
+	IssuanceId   uint         `json:"issuance_id" validate:"required"`
+	InterestRate *uint256.Int `json:"interest_rate" validate:"required"`
+}
+
+func (CreateOrderInputDTO) ABIMethod() string {
+	return "createOrder"
+}
+
+type CancelOrderInputDTO struct {
+	Id uint `json:"id" validate:"required"`
+}
+
+func (CancelOrderInputDTO) ABIMethod() string {
+	return "cancelOrder"
+}
+
+type FindOrderByIdInputDTO struct {
+	Id uint `json:"id" validate:"required"`
+}
+
+type FindOrdersByIssuanceIdInputDTO struct {
+	IssuanceId uint `json:"issuance_id" validate:"required"`
+}
+
+type CreateOrderOutputDTO struct {
+	Id           uint           `json:"id"`
+	IssuanceId   uint           `json:"issuance_id"`
+	Investor     *UserOutputDTO `json:"investor"`
+	Amount       *uint256.Int   `json:"amount"`
+	InterestRate *uint256.Int   `json:"interest_rate"`
+	State        string         `json:"state"`
+	CreatedAt    int64          `json:"created_at"`
+}
+
+type CancelOrderOutputDTO struct {
+	Id           uint           `json:"id"`
+	IssuanceId   uint           `json:"issuance_id"`
+	Token        Address        `json:"token"`
+	Investor     *UserOutputDTO `json:"investor"`
+	Amount       *uint256.Int   `json:"amount"`
+	InterestRate *uint256.Int   `json:"interest_rate"`
+	State        string         `json:"state"`
+	CreatedAt    int64          `json:"created_at"`
+	UpdatedAt    int64          `json:"updated_at"`
+}
+
+type OrderOutputDTO struct {
+	Id           uint           `json:"id"`
+	IssuanceId   uint           `json:"issuance_id"`
+	Investor     *UserOutputDTO `json:"investor"`
+	Amount       *uint256.Int   `json:"amount"`
+	InterestRate *uint256.Int   `json:"interest_rate"`
+	State        string         `json:"state"`
+	CreatedAt    int64          `json:"created_at"`
+	UpdatedAt    int64          `json:"updated_at"`
+}
+
+type FindOrdersByIssuanceIdOutputDTO []*OrderOutputDTO
@@ -0,0 +1,75 @@
+package api
+
+import (
+	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+	"github.com/holiman/uint256"
+)
+
+type FindInvestorPortfolioInputDTO struct {
+	InvestorAddress Address `json:"investor_address" validate:"required"`
+	// At is the reference timestamp for days to maturity.
+	At int64 `json:"at" validate:"required"`
+}
+
+type FindInvestorPortfolioOutputDTO struct {
+	Investor  Address                 `json:"investor"`
+	At        int64                   `json:"at"`
+	Positions []*PositionOutputDTO    `json:"positions"`
+	Totals    []*TokenTotalsOutputDTO `json:"totals"`
+}
+
+type PositionOutputDTO struct {
+	OrderId        uint         `json:"order_id"`
+	IssuanceId     uint         `json:"issuance_id"`
+	IssuanceTitle  string       `json:"issuance_title"`
+	IssuanceState  string       `json:"issuance_state"`
+	Token          Address      `json:"token"`
+	State          string       `json:"state"`
+	Principal      *uint256.Int `json:"principal"`
+	InterestRate   *uint256.Int `json:"interest_rate"`
+	ExpectedPayout *uint256.Int `json:"expected_payout"`
+	MaturityAt     int64        `json:"maturity_at"`
+	DaysToMaturity int64        `json:"days_to_maturity"`
+	Realized       *uint256.Int `json:"realized"`
+	RealizedToken  Address      `json:"realized_token"`
+	Outstanding    *uint256.Int `json:"outstanding"`
+}
+
+type TokenTotalsOutputDTO struct {
+	Token       Address      `json:"token"`
+	Invested    *uint256.Int `json:"invested"`
+	Escrowed    *uint256.Int `json:"escrowed"`
+	Outstanding *uint256.Int `json:"outstanding"`
+	Realized    *uint256.Int `json:"realized"`
+}
+
+type FindCreatorDashboardInputDTO struct {
+	CreatorAddress Address `json:"creator_address" validate:"required"`
+	// At is the reference timestamp for the time left and the settlement deposit.
+	At int64 `json:"at" validate:"required"`
+}
+
+type FindCreatorDashboardOutputDTO struct {
+	Creator     Address                `json:"creator"`
+	At          int64                  `json:"at"`
+	Obligations []*ObligationOutputDTO `json:"obligations"`
+}
+
+type ObligationOutputDTO struct {
+	IssuanceId        uint         `json:"issuance_id"`
+	Title             string       `json:"title"`
+	State             string       `json:"state"`
+	Token             Address      `json:"token"`
+	TotalRaised       *uint256.Int `json:"total_raised"`
+	FeesDeducted      *uint256.Int `json:"fees_deducted"`
+	NetRaised         *uint256.Int `json:"net_raised"`
+	TotalObligation   *uint256.Int `json:"total_obligation"`
+	MaturityAt        int64        `json:"maturity_at"`
+	SecondsToMaturity int64        `json:"seconds_to_maturity"`
+	DaysToMaturity    int64        `json:"days_to_maturity"`
+	Collateral        Address      `json:"collateral"`
+	CollateralAtRisk  *uint256.Int `json:"collateral_at_risk"`
+	// SettlementDeposit is the deposit issuance/creator/settle accepts at At, zero if the
+	// issuance cannot be settled then.
+	SettlementDeposit *uint256.Int `json:"settlement_deposit"`
+}
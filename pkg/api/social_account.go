@@ -0,0 +1,78 @@
+package api
+
+import (
+	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+)
+
+type RequestSocialAccountChallengeInputDTO struct {
+	Username string `json:"username" validate:"required"`
+	Platform string `json:"platform" validate:"required"`
+}
+
+func (RequestSocialAccountChallengeInputDTO) ABIMethod() string {
+	return "requestSocialAccountChallenge"
+}
+
+// CreateSocialAccountInputDTO is submitted by a verifier once the user has published the
+// challenge nonce on the platform and signed the challenge message with the wallet.
+type CreateSocialAccountInputDTO struct {
+	ChallengeId uint   `json:"challenge_id" validate:"required"`
+	PostId      string `json:"post_id" validate:"required"`
+	ContentHash string `json:"content_hash" validate:"required"`
+	Signature   string `json:"signature" validate:"required"`
+}
+
+func (CreateSocialAccountInputDTO) ABIMethod() string {
+	return "createSocialAccount"
+}
+
+type UpdatePlatformSettingInputDTO struct {
+	Platform string `json:"platform" validate:"required"`
+	Enabled  bool   `json:"enabled"`
+}
+
+func (UpdatePlatformSettingInputDTO) ABIMethod() string {
+	return "updatePlatformSetting"
+}
+
+type SocialAccountChallengeOutputDTO struct {
+	Id        uint    `json:"id"`
+	UserId    uint    `json:"user_id"`
+	Address   Address `json:"address"`
+	Username  string  `json:"username"`
+	Platform  string  `json:"platform"`
+	Nonce     string  `json:"nonce"`
+	Message   string  `json:"message"`
+	ExpiresAt int64   `json:"expires_at"`
+	UsedAt    int64   `json:"used_at,omitempty"`
+	CreatedAt int64   `json:"created_at"`
+	UpdatedAt int64   `json:"updated_at"`
+}
+
+type CreateSocialAccountOutputDTO struct {
+	Id        uint                           `json:"id"`
+	UserId    uint                           `json:"user_id"`
+	Username  string                         `json:"username"`
+	Platform  string                         `json:"platform"`
+	Evidence  SocialAccountEvidenceOutputDTO `json:"evidence"`
+	CreatedAt int64                          `json:"created_at"`
+}
+
+type SocialAccountEvidenceOutputDTO struct {
+	ChallengeId uint    `json:"challenge_id"`
+	PostId      string  `json:"post_id"`
+	ContentHash string  `json:"content_hash"`
+	Signature   string  `json:"signature"`
+	VerifiedBy  Address `json:"verified_by"`
+}
+
+type PlatformOutputDTO struct {
+	Platform  string  `json:"platform"`
+	Enabled   bool    `json:"enabled"`
+	MinLength int     `json:"min_length"`
+	MaxLength int     `json:"max_length"`
+	UpdatedBy Address `json:"updated_by,omitempty"`
+	UpdatedAt int64   `json:"updated_at,omitempty"`
+}
+
+type FindAllPlatformsOutputDTO []*PlatformOutputDTO
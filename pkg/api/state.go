@@ -0,0 +1,34 @@
+package api
+
+import (
+	"encoding/json"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+type StateRootOutputDTO struct {
+	Root   common.Hash `json:"root"`
+	Leaves int         `json:"leaves"`
+}
+
+type FindStateProofInputDTO struct {
+	Key string `json:"key" validate:"required"`
+}
+
+// FindStateProofOutputDTO proves that Content is the row at Key: hashing Key and Content with
+// LeafHash gives Leaf, and folding Leaf with Siblings gives Root.
+type FindStateProofOutputDTO struct {
+	Key      string                `json:"key"`
+	Content  json.RawMessage       `json:"content"`
+	Leaf     common.Hash           `json:"leaf"`
+	Index    int                   `json:"index"`
+	Siblings []*ProofStepOutputDTO `json:"siblings"`
+	Root     common.Hash           `json:"root"`
+}
+
+// ProofStepOutputDTO is a sibling on the path from a leaf to the root. Left tells whether the
+// sibling is hashed before the current node.
+type ProofStepOutputDTO struct {
+	Hash common.Hash `json:"hash"`
+	Left bool        `json:"left"`
+}
@@ -0,0 +1,99 @@
+// Package api holds the inputs and outputs of the application routes the client covers. The
+// use cases serving the routes and pkg/client both use them, so they cannot drift apart.
+package api
+
+import (
+	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+	"github.com/holiman/uint256"
+)
+
+type CreateUserInputDTO struct {
+	Role    string  `json:"role" validate:"required"`
+	Address Address `json:"address" validate:"required"`
+}
+
+func (CreateUserInputDTO) ABIMethod() string {
+	return "createUser"
+}
+
+type DeleteUserInputDTO struct {
+	Address Address `json:"address" validate:"required"`
+}
+
+func (DeleteUserInputDTO) ABIMethod() string {
+	return "deleteUser"
+}
+
+type ReactivateUserInputDTO struct {
+	Address Address `json:"address" validate:"required"`
+}
+
+func (ReactivateUserInputDTO) ABIMethod() string {
+	return "reactivateUser"
+}
+
+type WithdrawInputDTO struct {
+	Token  Address      `json:"token" validate:"required"`
+	Amount *uint256.Int `json:"amount" validate:"required"`
+}
+
+func (WithdrawInputDTO) ABIMethod() string {
+	return "withdraw"
+}
+
+type FindUserByAddressInputDTO struct {
+	Address Address `json:"address" validate:"required"`
+}
+
+type CreateUserOutputDTO struct {
+	Id              uint                          `json:"id"`
+	Roles           []string                      `json:"roles"`
+	Address         Address                       `json:"address"`
+	Status          string                        `json:"status"`
+	SocialAccounts  []*UserSocialAccountOutputDTO `json:"social_accounts"`
+	InvestmentLimit *uint256.Int                  `json:"investment_limit,omitempty"`
+	CreatedAt       int64                         `json:"created_at"`
+}
+
+type UserOutputDTO struct {
+	Id             uint                          `json:"id"`
+	Roles          []string                      `json:"roles"`
+	Address        Address                       `json:"address"`
+	Status         string                        `json:"status"`
+	SocialAccounts []*UserSocialAccountOutputDTO `json:"social_accounts"`
+	// Reputation is only set for creators.
+	Reputation    *CreatorReputationOutputDTO `json:"reputation,omitempty"`
+	DeactivatedAt int64                       `json:"deactivated_at,omitempty"`
+	CreatedAt     int64                       `json:"created_at"`
+	UpdatedAt     int64                       `json:"updated_at"`
+}
+
+// UserSocialAccountOutputDTO is a social account listed in a user. Its evidence is only
+// exposed by the social account routes.
+type UserSocialAccountOutputDTO struct {
+	Id        uint   `json:"id"`
+	UserId    uint   `json:"user_id,omitempty"`
+	Username  string `json:"username,omitempty"`
+	Platform  string `json:"platform,omitempty"`
+	CreatedAt int64  `json:"created_at,omitempty"`
+	UpdatedAt int64  `json:"updated_at,omitempty"`
+}
+
+type CreatorReputationOutputDTO struct {
+	Score                uint64       `json:"score"`
+	Issuances            uint64       `json:"issuances"`
+	SettledOnTime        uint64       `json:"settled_on_time"`
+	SettledLate          uint64       `json:"settled_late"`
+	CollateralExecutions uint64       `json:"collateral_executions"`
+	Cancellations        uint64       `json:"cancellations"`
+	TotalVolume          *uint256.Int `json:"total_volume"`
+	UpdatedAt            int64        `json:"updated_at"`
+}
+
+type FindAllUsersOutputDTO []*UserOutputDTO
+
+type WithdrawOutputDTO struct {
+	Token  Address      `json:"token"`
+	Amount *uint256.Int `json:"amount"`
+	User   Address      `json:"user"`
+}
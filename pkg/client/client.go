@@ -0,0 +1,378 @@
+// Package client is a typed SDK for the application routes. Requests are built from the input
+// types of pkg/api and the notices and reports are parsed back into its output types.
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/api"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/router"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var ErrOutputNotFound = errors.New("output not found")
+
+type Client struct {
+	Transport Transport
+}
+
+func New(transport Transport) *Client {
+	return &Client{
+		Transport: transport,
+	}
+}
+
+type envelope struct {
+	V          int             `json:"v"`
+	Type       string          `json:"type"`
+	InputIndex *int            `json:"input_index,omitempty"`
+	Data       json.RawMessage `json:"data,omitempty"`
+}
+
+func request(path string, data any) ([]byte, error) {
+	req := router.Request{Path: path}
+	if data != nil {
+		raw, err := json.Marshal(data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal %s data: %w", path, err)
+		}
+		req.Data = raw
+	}
+	return json.Marshal(req)
+}
+
+// decode finds the first output of the given type and unmarshals its data into res.
+// An error envelope among the reports is returned as a *router.ErrorEnvelope.
+func decode(payloads [][]byte, reports [][]byte, kind string, res any) error {
+	for _, report := range reports {
+		var errorEnvelope router.ErrorEnvelope
+		if err := json.Unmarshal(report, &errorEnvelope); err == nil && errorEnvelope.Type == router.ErrorType {
+			return &errorEnvelope
+		}
+	}
+	for _, payload := range payloads {
+		var env envelope
+		if err := json.Unmarshal(payload, &env); err != nil {
+			continue
+		}
+		if env.Type != kind {
+			continue
+		}
+		if err := json.Unmarshal(env.Data, res); err != nil {
+			return fmt.Errorf("failed to unmarshal %s: %w", kind, err)
+		}
+		return nil
+	}
+	return fmt.Errorf("%w: %s", ErrOutputNotFound, kind)
+}
+
+func (c *Client) advance(sender common.Address, path string, input any, kind string, res any) (*Output, error) {
+	payload, err := request(path, input)
+	if err != nil {
+		return nil, err
+	}
+	output, err := c.Transport.Advance(sender, payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to advance %s: %w", path, err)
+	}
+	return output, decode(output.Notices, output.Reports, kind, res)
+}
+
+func (c *Client) deposit(token common.Address, sender common.Address, amount *big.Int, path string, input any, kind string, res any) (*Output, error) {
+	payload, err := request(path, input)
+	if err != nil {
+		return nil, err
+	}
+	output, err := c.Transport.DepositERC20(token, sender, amount, payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to deposit %s: %w", path, err)
+	}
+	return output, decode(output.Notices, output.Reports, kind, res)
+}
+
+func (c *Client) inspect(path string, input any, kind string, res any) error {
+	payload, err := request(path, input)
+	if err != nil {
+		return err
+	}
+	output, err := c.Transport.Inspect(payload)
+	if err != nil {
+		return fmt.Errorf("failed to inspect %s: %w", path, err)
+	}
+	return decode(output.Reports, output.Reports, kind, res)
+}
+
+// Users
+
+func (c *Client) CreateUser(admin common.Address, input *api.CreateUserInputDTO) (*api.CreateUserOutputDTO, error) {
+	var res api.CreateUserOutputDTO
+	if _, err := c.advance(admin, "user/admin/create", input, "user.created", &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// DeleteUser deactivates a user. The user record is kept.
+func (c *Client) DeleteUser(admin common.Address, input *api.DeleteUserInputDTO) (*api.UserOutputDTO, error) {
+	var res api.UserOutputDTO
+	if _, err := c.advance(admin, "user/admin/delete", input, "user.deactivated", &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (c *Client) ReactivateUser(admin common.Address, input *api.ReactivateUserInputDTO) (*api.UserOutputDTO, error) {
+	var res api.UserOutputDTO
+	if _, err := c.advance(admin, "user/admin/reactivate", input, "user.reactivated", &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (c *Client) Withdraw(sender common.Address, input *api.WithdrawInputDTO) (*api.WithdrawOutputDTO, error) {
+	var res api.WithdrawOutputDTO
+	if _, err := c.advance(sender, "user/withdraw", input, "user.erc20_withdrawn", &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (c *Client) FindUserByAddress(input *api.FindUserByAddressInputDTO) (*api.UserOutputDTO, error) {
+	var res api.UserOutputDTO
+	if err := c.inspect("user/address", input, "user", &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (c *Client) FindAllUsers() ([]*api.UserOutputDTO, error) {
+	var res []*api.UserOutputDTO
+	if err := c.inspect("user", nil, "user.list", &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Social accounts
+
+// RequestSocialAccountChallenge returns the nonce and the message the sender must publish
+// and sign to prove that it controls a social account.
+func (c *Client) RequestSocialAccountChallenge(sender common.Address, input *api.RequestSocialAccountChallengeInputDTO) (*api.SocialAccountChallengeOutputDTO, error) {
+	var res api.SocialAccountChallengeOutputDTO
+	if _, err := c.advance(sender, "social/challenge", input, "social_account.challenge_requested", &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (c *Client) CreateSocialAccount(verifier common.Address, input *api.CreateSocialAccountInputDTO) (*api.CreateSocialAccountOutputDTO, error) {
+	var res api.CreateSocialAccountOutputDTO
+	if _, err := c.advance(verifier, "social/verifier/create", input, "social_account.created", &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (c *Client) UpdatePlatformSetting(admin common.Address, input *api.UpdatePlatformSettingInputDTO) (*api.PlatformOutputDTO, error) {
+	var res api.PlatformOutputDTO
+	if _, err := c.advance(admin, "social/admin/platform", input, "social_platform.updated", &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (c *Client) FindAllPlatforms() ([]*api.PlatformOutputDTO, error) {
+	var res []*api.PlatformOutputDTO
+	if err := c.inspect("social/platforms", nil, "social_platform.list", &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Issuances
+
+// CreateIssuance creates an issuance, depositing collateralAmount of collateral.
+func (c *Client) CreateIssuance(creator common.Address, collateral common.Address, collateralAmount *big.Int, input *api.CreateIssuanceInputDTO) (*api.CreateIssuanceOutputDTO, error) {
+	var res api.CreateIssuanceOutputDTO
+	if _, err := c.deposit(collateral, creator, collateralAmount, "issuance/creator/create", input, "issuance.created", &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (c *Client) CloseIssuance(sender common.Address, input *api.CloseIssuanceInputDTO) (*api.CloseIssuanceOutputDTO, error) {
+	var res api.CloseIssuanceOutputDTO
+	if _, err := c.advance(sender, "issuance/close", input, "issuance.closed", &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// SettleIssuance settles an issuance, depositing amount of its token.
+func (c *Client) SettleIssuance(creator common.Address, token common.Address, amount *big.Int, input *api.SettleIssuanceInputDTO) (*api.SettleIssuanceOutputDTO, error) {
+	var res api.SettleIssuanceOutputDTO
+	if _, err := c.deposit(token, creator, amount, "issuance/creator/settle", input, "issuance.settled", &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (c *Client) ExecuteIssuanceCollateral(sender common.Address, input *api.ExecuteIssuanceCollateralInputDTO) (*api.ExecuteIssuanceCollateralOutputDTO, error) {
+	var res api.ExecuteIssuanceCollateralOutputDTO
+	if _, err := c.advance(sender, "issuance/execute-collateral", input, "issuance.collateral_executed", &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (c *Client) FindIssuanceById(input *api.FindIssuanceByIdInputDTO) (*api.IssuanceOutputDTO, error) {
+	var res api.IssuanceOutputDTO
+	if err := c.inspect("issuance/id", input, "issuance", &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (c *Client) FindAllIssuances() ([]*api.IssuanceOutputDTO, error) {
+	var res []*api.IssuanceOutputDTO
+	if err := c.inspect("issuance", nil, "issuance.list", &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *Client) FindIssuancesByCreatorAddress(input *api.FindIssuancesByCreatorAddressInputDTO) ([]*api.IssuanceOutputDTO, error) {
+	var res []*api.IssuanceOutputDTO
+	if err := c.inspect("issuance/creator", input, "issuance.list", &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *Client) FindIssuancesByInvestorAddress(input *api.FindIssuancesByInvestorAddressInputDTO) ([]*api.IssuanceOutputDTO, error) {
+	var res []*api.IssuanceOutputDTO
+	if err := c.inspect("issuance/investor", input, "issuance.list", &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Orders
+
+// PlaceOrder places an order on an issuance, depositing amount of the issuance token.
+func (c *Client) PlaceOrder(investor common.Address, token common.Address, amount *big.Int, input *api.CreateOrderInputDTO) (*api.CreateOrderOutputDTO, error) {
+	var res api.CreateOrderOutputDTO
+	if _, err := c.deposit(token, investor, amount, "order/create", input, "order.created", &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (c *Client) CancelOrder(investor common.Address, input *api.CancelOrderInputDTO) (*api.CancelOrderOutputDTO, error) {
+	var res api.CancelOrderOutputDTO
+	if _, err := c.advance(investor, "order/cancel", input, "order.canceled", &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (c *Client) FindOrderById(input *api.FindOrderByIdInputDTO) (*api.OrderOutputDTO, error) {
+	var res api.OrderOutputDTO
+	if err := c.inspect("order/id", input, "order", &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (c *Client) FindOrdersByIssuanceId(input *api.FindOrdersByIssuanceIdInputDTO) ([]*api.OrderOutputDTO, error) {
+	var res []*api.OrderOutputDTO
+	if err := c.inspect("order/issuance", input, "order.list", &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Portfolios
+
+func (c *Client) FindInvestorPortfolio(input *api.FindInvestorPortfolioInputDTO) (*api.FindInvestorPortfolioOutputDTO, error) {
+	var res api.FindInvestorPortfolioOutputDTO
+	if err := c.inspect("portfolio/investor", input, "portfolio.investor", &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (c *Client) FindCreatorDashboard(input *api.FindCreatorDashboardInputDTO) (*api.FindCreatorDashboardOutputDTO, error) {
+	var res api.FindCreatorDashboardOutputDTO
+	if err := c.inspect("portfolio/creator", input, "portfolio.creator", &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// Analytics
+
+func (c *Client) FindAnalyticsSummary() (*api.FindAnalyticsSummaryOutputDTO, error) {
+	var res api.FindAnalyticsSummaryOutputDTO
+	if err := c.inspect("analytics/summary", nil, "analytics.summary", &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (c *Client) FindAnalyticsSeries(input *api.FindAnalyticsSeriesInputDTO) ([]*api.BucketOutputDTO, error) {
+	var res []*api.BucketOutputDTO
+	if err := c.inspect("analytics/series", input, "analytics.series", &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Ledger
+
+func (c *Client) FindLedgerEntries(input *api.FindLedgerEntriesInputDTO) ([]*api.LedgerEntryOutputDTO, error) {
+	var res []*api.LedgerEntryOutputDTO
+	if err := c.inspect("ledger/entries", input, "ledger.entries", &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (c *Client) ReconcileLedger() (*api.ReconcileLedgerOutputDTO, error) {
+	var res api.ReconcileLedgerOutputDTO
+	if err := c.inspect("ledger/reconciliation", nil, "ledger.reconciliation", &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// Genesis
+
+func (c *Client) FindGenesis() (*api.FindGenesisOutputDTO, error) {
+	var res api.FindGenesisOutputDTO
+	if err := c.inspect("genesis", nil, "genesis", &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// State
+
+func (c *Client) FindStateRoot() (*api.StateRootOutputDTO, error) {
+	var res api.StateRootOutputDTO
+	if err := c.inspect("state/root", nil, "state.root", &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+func (c *Client) FindStateProof(input *api.FindStateProofInputDTO) (*api.FindStateProofOutputDTO, error) {
+	var res api.FindStateProofOutputDTO
+	if err := c.inspect("state/proof", input, "state.proof", &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
@@ -0,0 +1,62 @@
+package client
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rollmelette/rollmelette"
+)
+
+// TesterTransport runs inputs in process against a rollmelette.Tester.
+type TesterTransport struct {
+	Tester *rollmelette.Tester
+}
+
+func NewTesterTransport(tester *rollmelette.Tester) *TesterTransport {
+	return &TesterTransport{
+		Tester: tester,
+	}
+}
+
+func (t *TesterTransport) Advance(sender common.Address, payload []byte) (*Output, error) {
+	return advanceOutput(t.Tester.Advance(sender, payload)), nil
+}
+
+func (t *TesterTransport) DepositERC20(token common.Address, sender common.Address, amount *big.Int, payload []byte) (*Output, error) {
+	return advanceOutput(t.Tester.DepositERC20(token, sender, amount, payload)), nil
+}
+
+func (t *TesterTransport) Inspect(payload []byte) (*Output, error) {
+	result := t.Tester.Inspect(payload)
+	output := &Output{}
+	for _, report := range result.Reports {
+		output.Reports = append(output.Reports, report.Payload)
+	}
+	return output, nil
+}
+
+func advanceOutput(result rollmelette.TestAdvanceResult) *Output {
+	index := result.Index
+	output := &Output{InputIndex: &index}
+	for _, notice := range result.Notices {
+		output.Notices = append(output.Notices, notice.Payload)
+	}
+	for _, report := range result.Reports {
+		output.Reports = append(output.Reports, report.Payload)
+	}
+	for _, voucher := range result.Vouchers {
+		output.Vouchers = append(output.Vouchers, Voucher{
+			Destination: voucher.Destination,
+			Value:       voucher.Value,
+			Payload:     voucher.Payload,
+		})
+	}
+	for _, voucher := range result.DelegateCallVouchers {
+		output.Vouchers = append(output.Vouchers, Voucher{
+			Destination:  voucher.Destination,
+			Payload:      voucher.Payload,
+			DelegateCall: true,
+		})
+	}
+	return output
+}
@@ -0,0 +1,35 @@
+package client
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Output is the outputs emitted by the application while handling an input.
+type Output struct {
+	// InputIndex is only set for advance inputs
+	InputIndex *int
+	Notices    [][]byte
+	Reports    [][]byte
+	Vouchers   []Voucher
+}
+
+type Voucher struct {
+	Destination  common.Address
+	Value        *big.Int
+	Payload      []byte
+	DelegateCall bool
+}
+
+// Transport delivers inputs to the application, either through a node or in process.
+// Implementations return the outputs even when the application rejects the input, so
+// the client can report the error envelope.
+type Transport interface {
+	// Advance sends payload through the InputBox.
+	Advance(sender common.Address, payload []byte) (*Output, error)
+	// DepositERC20 sends payload as the exec layer data of an ERC20 portal deposit.
+	DepositERC20(token common.Address, sender common.Address, amount *big.Int, payload []byte) (*Output, error)
+	// Inspect sends payload as an inspect request.
+	Inspect(payload []byte) (*Output, error)
+}
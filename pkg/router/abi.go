@@ -0,0 +1,201 @@
+package router
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+
+	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/holiman/uint256"
+)
+
+// ABIInput is implemented by input DTOs that can also be sent as an ABI-encoded call,
+// i.e. the 4-byte selector of ABIMethod followed by the ABI-encoded DTO fields.
+type ABIInput interface {
+	ABIMethod() string
+}
+
+type ABIField struct {
+	Name  string // JSON name of the DTO field
+	Type  string // Solidity type of the argument
+	index int
+}
+
+// ABICodec translates between the ABI encoding of a route input and its JSON DTO.
+// Arguments follow the declaration order of the DTO fields.
+type ABICodec struct {
+	Path      string
+	Method    string
+	Signature string
+	Selector  [4]byte
+	Fields    []ABIField
+	arguments abi.Arguments
+	inputType reflect.Type
+}
+
+var (
+	addressType       = reflect.TypeOf(Address{})
+	commonAddressType = reflect.TypeOf(common.Address{})
+	uint256Type       = reflect.TypeOf(&uint256.Int{})
+)
+
+func NewABICodec(path string, input ABIInput) (*ABICodec, error) {
+	inputType := reflect.TypeOf(input)
+	if inputType.Kind() == reflect.Pointer {
+		inputType = inputType.Elem()
+	}
+	if inputType.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("abi input must be a struct, got %s", inputType)
+	}
+
+	codec := &ABICodec{
+		Path:      strings.Trim(path, "/"),
+		Method:    input.ABIMethod(),
+		inputType: inputType,
+	}
+	types := make([]string, 0, inputType.NumField())
+	for i := 0; i < inputType.NumField(); i++ {
+		field := inputType.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if !field.IsExported() || name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		solidityType, err := abiType(field.Type)
+		if err != nil {
+			return nil, fmt.Errorf("field %s of %s: %w", field.Name, inputType, err)
+		}
+		argumentType, err := abi.NewType(solidityType, "", nil)
+		if err != nil {
+			return nil, fmt.Errorf("field %s of %s: %w", field.Name, inputType, err)
+		}
+		codec.Fields = append(codec.Fields, ABIField{Name: name, Type: solidityType, index: i})
+		codec.arguments = append(codec.arguments, abi.Argument{Name: name, Type: argumentType})
+		types = append(types, solidityType)
+	}
+	codec.Signature = fmt.Sprintf("%s(%s)", codec.Method, strings.Join(types, ","))
+	copy(codec.Selector[:], crypto.Keccak256([]byte(codec.Signature))[:4])
+	return codec, nil
+}
+
+func abiType(t reflect.Type) (string, error) {
+	switch {
+	case t == addressType, t == commonAddressType:
+		return "address", nil
+	case t == uint256Type:
+		return "uint256", nil
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return "string", nil
+	case reflect.Bool:
+		return "bool", nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "uint256", nil
+	case reflect.Int64:
+		return "int64", nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32:
+		return "int256", nil
+	case reflect.Slice:
+		elem, err := abiType(t.Elem())
+		if err != nil || strings.HasSuffix(elem, "[]") {
+			return "", fmt.Errorf("unsupported abi type: %s", t)
+		}
+		return elem + "[]", nil
+	default:
+		return "", fmt.Errorf("unsupported abi type: %s", t)
+	}
+}
+
+// Decode unpacks the ABI-encoded arguments following the selector into the JSON data of the route.
+func (c *ABICodec) Decode(data []byte) (json.RawMessage, error) {
+	values, err := c.arguments.Unpack(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unpack %s arguments: %w", c.Signature, err)
+	}
+	fields := make(map[string]any, len(values))
+	for i, value := range values {
+		fields[c.Fields[i].Name] = value
+	}
+	res, err := json.Marshal(fields)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal %s arguments: %w", c.Signature, err)
+	}
+	return res, nil
+}
+
+// Encode packs input as an ABI call to the route, selector included.
+func (c *ABICodec) Encode(input any) ([]byte, error) {
+	value := reflect.Indirect(reflect.ValueOf(input))
+	if value.Type() != c.inputType {
+		return nil, fmt.Errorf("expected %s input, got %s", c.inputType, value.Type())
+	}
+	args := make([]any, len(c.Fields))
+	for i, field := range c.Fields {
+		arg, err := abiValue(value.Field(field.index))
+		if err != nil {
+			return nil, fmt.Errorf("field %s of %s: %w", field.Name, c.inputType, err)
+		}
+		args[i] = arg
+	}
+	data, err := c.arguments.Pack(args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack %s arguments: %w", c.Signature, err)
+	}
+	return append(c.Selector[:], data...), nil
+}
+
+func abiValue(v reflect.Value) (any, error) {
+	switch {
+	case v.Type() == addressType:
+		return common.Address(v.Interface().(Address)), nil
+	case v.Type() == commonAddressType:
+		return v.Interface(), nil
+	case v.Type() == uint256Type:
+		if v.IsNil() {
+			return new(big.Int), nil
+		}
+		return v.Interface().(*uint256.Int).ToBig(), nil
+	}
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Bool:
+		return v.Bool(), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return new(big.Int).SetUint64(v.Uint()), nil
+	case reflect.Int64:
+		return v.Int(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32:
+		return big.NewInt(v.Int()), nil
+	case reflect.Slice:
+		return abiSlice(v)
+	default:
+		return nil, fmt.Errorf("unsupported abi type: %s", v.Type())
+	}
+}
+
+// abiSlice converts the elements of v into a slice of the Go type the abi package
+// expects for the element, e.g. []string for string[].
+func abiSlice(v reflect.Value) (any, error) {
+	zero, err := abiValue(reflect.Zero(v.Type().Elem()))
+	if err != nil {
+		return nil, err
+	}
+	res := reflect.MakeSlice(reflect.SliceOf(reflect.TypeOf(zero)), v.Len(), v.Len())
+	for i := 0; i < v.Len(); i++ {
+		elem, err := abiValue(v.Index(i))
+		if err != nil {
+			return nil, err
+		}
+		res.Index(i).Set(reflect.ValueOf(elem))
+	}
+	return res.Interface(), nil
+}
@@ -0,0 +1,95 @@
+package router
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/rollmelette/rollmelette"
+)
+
+// EnvelopeVersion is the version of the envelope wrapping every notice and report.
+// It must be bumped whenever the envelope layout changes in a non backwards compatible way.
+const EnvelopeVersion = 1
+
+// ErrorType is the envelope type of every error report.
+const ErrorType = "error"
+
+// Envelope is the structured payload emitted in notices and reports, e.g.
+// {"v":1,"type":"issuance.created","input_index":3,"data":{...}}.
+// InputIndex is only set for outputs produced while handling an advance input.
+type Envelope struct {
+	V          int    `json:"v"`
+	Type       string `json:"type"`
+	InputIndex *int   `json:"input_index,omitempty"`
+	Data       any    `json:"data,omitempty"`
+}
+
+// ErrorEnvelope is the structured payload reported when a handler fails, e.g.
+// {"v":1,"type":"error","input_index":3,"code":"ISSUANCE_NOT_CLOSED","category":"conflict","message":"...","params":{...}}.
+type ErrorEnvelope struct {
+	V          int               `json:"v"`
+	Type       string            `json:"type"`
+	InputIndex *int              `json:"input_index,omitempty"`
+	Code       string            `json:"code"`
+	Category   string            `json:"category"`
+	Message    string            `json:"message"`
+	Params     map[string]string `json:"params,omitempty"`
+}
+
+// Error lets clients return a decoded error envelope as an error.
+func (e *ErrorEnvelope) Error() string {
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+func NewEnvelope(kind string, inputIndex *int, data any) *Envelope {
+	return &Envelope{
+		V:          EnvelopeVersion,
+		Type:       kind,
+		InputIndex: inputIndex,
+		Data:       data,
+	}
+}
+
+func NewErrorEnvelope(inputIndex *int, code string, category string, message string, params map[string]string) *ErrorEnvelope {
+	return &ErrorEnvelope{
+		V:          EnvelopeVersion,
+		Type:       ErrorType,
+		InputIndex: inputIndex,
+		Code:       code,
+		Category:   category,
+		Message:    message,
+		Params:     params,
+	}
+}
+
+// Notice emits data as a notice wrapped in an envelope tagged with the advance input index.
+func Notice(env rollmelette.Env, metadata rollmelette.Metadata, kind string, data any) error {
+	index := metadata.Index
+	payload, err := json.Marshal(NewEnvelope(kind, &index, data))
+	if err != nil {
+		return fmt.Errorf("failed to marshal notice: %w", err)
+	}
+	env.Notice(payload)
+	return nil
+}
+
+// Report emits data as a report wrapped in an envelope.
+func Report(env rollmelette.EnvInspector, kind string, data any) error {
+	payload, err := json.Marshal(NewEnvelope(kind, nil, data))
+	if err != nil {
+		return fmt.Errorf("failed to marshal report: %w", err)
+	}
+	env.Report(payload)
+	return nil
+}
+
+// ReportError emits err as an error envelope, using codes to resolve its stable error code.
+func ReportError(env rollmelette.EnvInspector, inputIndex *int, codes ErrorCodes, err error) {
+	code := codes.Lookup(err)
+	payload, marshalErr := json.Marshal(NewErrorEnvelope(inputIndex, code.Code, code.Category, err.Error(), errorParams(err)))
+	if marshalErr != nil {
+		// An error envelope only holds strings, so this should never happen
+		payload = []byte(fmt.Sprintf(`{"v":%d,"type":%q,"code":%q,"category":%q,"message":%q}`, EnvelopeVersion, ErrorType, ErrorCodeUnknown, ErrorCategoryInternal, err.Error()))
+	}
+	env.Report(payload)
+}
@@ -0,0 +1,89 @@
+package router
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/go-playground/validator/v10"
+)
+
+var (
+	ErrInvalidRequest = errors.New("invalid request")
+	ErrRouteNotFound  = errors.New("no handler found")
+)
+
+// Error codes produced by the router itself. Applications register their own
+// codes for domain errors through ErrorHandlingMiddleware.
+const (
+	ErrorCodeUnknown          = "UNKNOWN"
+	ErrorCodeInvalidRequest   = "INVALID_REQUEST"
+	ErrorCodeRouteNotFound    = "ROUTE_NOT_FOUND"
+	ErrorCodeMalformedPayload = "MALFORMED_PAYLOAD"
+	ErrorCodeValidationFailed = "VALIDATION_FAILED"
+)
+
+// Error categories produced by the router itself, named after the HTTP status a gateway
+// would answer them with.
+const (
+	ErrorCategoryBadRequest = "bad_request"
+	ErrorCategoryNotFound   = "not_found"
+	ErrorCategoryInternal   = "internal"
+)
+
+// CodedError is implemented by errors carrying their own stable code, category and
+// message parameters, so they don't need to be registered through ErrorHandlingMiddleware.
+type CodedError interface {
+	error
+	ErrorCode() string
+	ErrorCategory() string
+	ErrorParams() map[string]string
+}
+
+// ErrorCode binds a sentinel error to the stable code reported to clients.
+type ErrorCode struct {
+	Err      error
+	Code     string
+	Category string
+}
+
+type ErrorCodes []ErrorCode
+
+// Lookup returns the code of the first registered sentinel found in err's chain,
+// falling back to coded errors, to the router codes and finally to ErrorCodeUnknown.
+func (c ErrorCodes) Lookup(err error) ErrorCode {
+	for _, code := range c {
+		if errors.Is(err, code.Err) {
+			return code
+		}
+	}
+
+	var (
+		codedErr       CodedError
+		validationErrs validator.ValidationErrors
+		syntaxErr      *json.SyntaxError
+		typeErr        *json.UnmarshalTypeError
+	)
+	switch {
+	case errors.As(err, &codedErr):
+		return ErrorCode{Err: codedErr, Code: codedErr.ErrorCode(), Category: codedErr.ErrorCategory()}
+	case errors.Is(err, ErrRouteNotFound):
+		return ErrorCode{Err: ErrRouteNotFound, Code: ErrorCodeRouteNotFound, Category: ErrorCategoryNotFound}
+	case errors.Is(err, ErrInvalidRequest):
+		return ErrorCode{Err: ErrInvalidRequest, Code: ErrorCodeInvalidRequest, Category: ErrorCategoryBadRequest}
+	case errors.As(err, &validationErrs):
+		return ErrorCode{Err: validationErrs, Code: ErrorCodeValidationFailed, Category: ErrorCategoryBadRequest}
+	case errors.As(err, &syntaxErr), errors.As(err, &typeErr):
+		return ErrorCode{Err: err, Code: ErrorCodeMalformedPayload, Category: ErrorCategoryBadRequest}
+	default:
+		return ErrorCode{Err: err, Code: ErrorCodeUnknown, Category: ErrorCategoryInternal}
+	}
+}
+
+// errorParams returns the message parameters of the first coded error found in err's chain.
+func errorParams(err error) map[string]string {
+	var codedErr CodedError
+	if errors.As(err, &codedErr) {
+		return codedErr.ErrorParams()
+	}
+	return nil
+}
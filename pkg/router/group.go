@@ -44,23 +44,23 @@ func (g *Group) registerHandler(path string, wrap func(handler any) any, registe
 	register(fullPath, h)
 }
 
-func (g *Group) HandleAdvance(path string, handler AdvanceHandlerFunc) {
+func (g *Group) HandleAdvance(path string, handler AdvanceHandlerFunc, opts ...RouteOption) {
 	g.registerHandler(
 		path,
 		func(h any) any { return h.(AdvanceHandlerFunc) },
 		func(fullPath string, h any) {
-			g.router.HandleAdvance(fullPath, h.(AdvanceHandlerFunc))
+			g.router.HandleAdvance(fullPath, h.(AdvanceHandlerFunc), opts...)
 		},
 		handler,
 	)
 }
 
-func (g *Group) HandleInspect(path string, handler InspectHandlerFunc) {
+func (g *Group) HandleInspect(path string, handler InspectHandlerFunc, opts ...RouteOption) {
 	g.registerHandler(
 		path,
 		func(h any) any { return h.(InspectHandlerFunc) },
 		func(fullPath string, h any) {
-			g.router.HandleInspect(fullPath, h.(InspectHandlerFunc))
+			g.router.HandleInspect(fullPath, h.(InspectHandlerFunc), opts...)
 		},
 		handler,
 	)
@@ -10,6 +10,10 @@ import (
 
 type Middleware func(any) any
 
+// RouteMiddleware builds the middleware of a route from its metadata, or returns nil to
+// leave the route untouched.
+type RouteMiddleware func(route *Route) Middleware
+
 func LoggingMiddleware(handler any) any {
 	switch h := handler.(type) {
 	case AdvanceHandlerFunc:
@@ -31,25 +35,30 @@ func LoggingMiddleware(handler any) any {
 	}
 }
 
-func ErrorHandlingMiddleware(handler any) any {
-	switch h := handler.(type) {
-	case AdvanceHandlerFunc:
-		return AdvanceHandlerFunc(func(env rollmelette.Env, metadata rollmelette.Metadata, deposit rollmelette.Deposit, payload []byte) error {
-			err := h(env, metadata, deposit, payload)
-			if err != nil {
-				env.Report([]byte(fmt.Sprintf("Error: %v", err)))
-			}
-			return err
-		})
-	case InspectHandlerFunc:
-		return InspectHandlerFunc(func(env rollmelette.EnvInspector, payload []byte) error {
-			err := h(env, payload)
-			if err != nil {
-				env.Report([]byte(fmt.Sprintf("Error: %v", err)))
-			}
-			return err
-		})
-	default:
-		return handler
+// ErrorHandlingMiddleware reports handler errors as error envelopes, resolving
+// their stable codes from the given sentinel errors.
+func ErrorHandlingMiddleware(codes ...ErrorCode) Middleware {
+	return func(handler any) any {
+		switch h := handler.(type) {
+		case AdvanceHandlerFunc:
+			return AdvanceHandlerFunc(func(env rollmelette.Env, metadata rollmelette.Metadata, deposit rollmelette.Deposit, payload []byte) error {
+				err := h(env, metadata, deposit, payload)
+				if err != nil {
+					index := metadata.Index
+					ReportError(env, &index, codes, err)
+				}
+				return err
+			})
+		case InspectHandlerFunc:
+			return InspectHandlerFunc(func(env rollmelette.EnvInspector, payload []byte) error {
+				err := h(env, payload)
+				if err != nil {
+					ReportError(env, nil, codes, err)
+				}
+				return err
+			})
+		default:
+			return handler
+		}
 	}
 }
@@ -0,0 +1,125 @@
+package router
+
+import (
+	"reflect"
+	"sort"
+)
+
+type RouteKind string
+
+const (
+	RouteKindAdvance RouteKind = "advance"
+	RouteKindInspect RouteKind = "inspect"
+)
+
+// SchemaPath is the built-in inspect route describing every registered route.
+const SchemaPath = "__schema"
+
+// Route is the metadata kept by the router for each registered handler.
+type Route struct {
+	Kind        RouteKind
+	Path        string
+	Input       reflect.Type
+	Permissions []string
+	Description string
+	middlewares []Middleware
+}
+
+type RouteOption func(*Route)
+
+// Input sets the DTO the route payload is decoded into.
+func Input(dto any) RouteOption {
+	return func(r *Route) {
+		t := reflect.TypeOf(dto)
+		for t != nil && t.Kind() == reflect.Pointer {
+			t = t.Elem()
+		}
+		r.Input = t
+	}
+}
+
+// Permissions records the permissions required to call the route. It is metadata only,
+// the check itself is done by a middleware set with With.
+func Permissions(permissions ...string) RouteOption {
+	return func(r *Route) {
+		r.Permissions = append(r.Permissions, permissions...)
+	}
+}
+
+// With adds middleware applying to the route only. It runs inside the router middleware
+// and outside the group middleware.
+func With(middleware ...Middleware) RouteOption {
+	return func(r *Route) {
+		r.middlewares = append(r.middlewares, middleware...)
+	}
+}
+
+func Description(description string) RouteOption {
+	return func(r *Route) {
+		r.Description = description
+	}
+}
+
+func newRoute(kind RouteKind, path string, opts ...RouteOption) *Route {
+	route := &Route{Kind: kind, Path: path}
+	for _, opt := range opts {
+		opt(route)
+	}
+	return route
+}
+
+type routeKey struct {
+	kind RouteKind
+	path string
+}
+
+// Routes returns the metadata of every registered route sorted by path and kind.
+func (r *Router) Routes() []*Route {
+	routes := make([]*Route, 0, len(r.routes))
+	for _, route := range r.routes {
+		routes = append(routes, route)
+	}
+	sort.Slice(routes, func(i, j int) bool {
+		if routes[i].Path != routes[j].Path {
+			return routes[i].Path < routes[j].Path
+		}
+		return routes[i].Kind < routes[j].Kind
+	})
+	return routes
+}
+
+// RouteSchema is the self-description of a route returned by the schema route.
+type RouteSchema struct {
+	Kind        RouteKind `json:"kind"`
+	Path        string    `json:"path"`
+	Permissions []string  `json:"permissions,omitempty"`
+	Description string    `json:"description,omitempty"`
+	ABI         string    `json:"abi,omitempty"`
+	Input       *Schema   `json:"input,omitempty"`
+}
+
+// Schema describes every registered route, with the JSON Schema of its input DTO.
+func (r *Router) Schema() []*RouteSchema {
+	signatures := make(map[string]string, len(r.abiCodecs))
+	for _, codec := range r.abiCodecs {
+		signatures[codec.Path] = codec.Signature
+	}
+
+	routes := r.Routes()
+	schemas := make([]*RouteSchema, len(routes))
+	for i, route := range routes {
+		schemas[i] = &RouteSchema{
+			Kind:        route.Kind,
+			Path:        route.Path,
+			Permissions: route.Permissions,
+			Description: route.Description,
+		}
+		if route.Kind == RouteKindAdvance {
+			schemas[i].ABI = signatures[route.Path]
+		}
+		if route.Input != nil {
+			schemas[i].Input = NewSchema(route.Input)
+		}
+	}
+	return schemas
+}
@@ -16,21 +16,41 @@ type InspectHandlerFunc func(env rollmelette.EnvInspector, payload []byte) error
 type Router struct {
 	advanceHandlers map[string]AdvanceHandlerFunc
 	inspectHandlers map[string]InspectHandlerFunc
+	abiCodecs       map[[4]byte]*ABICodec
+	routes          map[routeKey]*Route
 	middlewares     []Middleware
+	routeFactories  []RouteMiddleware
+	inputs          []Middleware
 }
 
 func NewRouter() *Router {
-	return &Router{
+	r := &Router{
 		advanceHandlers: make(map[string]AdvanceHandlerFunc),
 		inspectHandlers: make(map[string]InspectHandlerFunc),
+		abiCodecs:       make(map[[4]byte]*ABICodec),
+		routes:          make(map[routeKey]*Route),
 		middlewares:     make([]Middleware, 0),
 	}
+	r.HandleInspect(SchemaPath, r.schemaHandler, Description("Describes every route with the JSON Schema of its input"))
+	return r
 }
 
 func (r *Router) Use(middleware ...Middleware) {
 	r.middlewares = append(r.middlewares, middleware...)
 }
 
+// UseInput adds middleware run around every advance input before it is parsed and routed, so
+// it also sees the inputs rejected for an invalid request or an unknown path.
+func (r *Router) UseInput(middleware ...Middleware) {
+	r.inputs = append(r.inputs, middleware...)
+}
+
+// UseRoute adds middleware built from the metadata of each route registered afterwards.
+// It runs inside the route middleware set with With.
+func (r *Router) UseRoute(factory ...RouteMiddleware) {
+	r.routeFactories = append(r.routeFactories, factory...)
+}
+
 func (r *Router) Group(prefix string) *Group {
 	return &Group{
 		router: r,
@@ -38,20 +58,50 @@ func (r *Router) Group(prefix string) *Group {
 	}
 }
 
-func (r *Router) HandleAdvance(path string, handler AdvanceHandlerFunc) {
-	for i := len(r.middlewares) - 1; i >= 0; i-- {
-		handler = r.middlewares[i](handler).(AdvanceHandlerFunc)
-	}
+func (r *Router) HandleAdvance(path string, handler AdvanceHandlerFunc, opts ...RouteOption) {
+	route := newRoute(RouteKindAdvance, path, opts...)
+	r.advanceHandlers[path] = r.wrap(route, handler).(AdvanceHandlerFunc)
+	r.routes[routeKey{RouteKindAdvance, path}] = route
+}
 
-	r.advanceHandlers[path] = handler
+func (r *Router) HandleInspect(path string, handler InspectHandlerFunc, opts ...RouteOption) {
+	route := newRoute(RouteKindInspect, path, opts...)
+	r.inspectHandlers[path] = r.wrap(route, handler).(InspectHandlerFunc)
+	r.routes[routeKey{RouteKindInspect, path}] = route
 }
 
-func (r *Router) HandleInspect(path string, handler InspectHandlerFunc) {
+// wrap applies the route factories, the route middleware and the router middleware to
+// handler, from the innermost to the outermost.
+func (r *Router) wrap(route *Route, handler any) any {
+	for i := len(r.routeFactories) - 1; i >= 0; i-- {
+		if middleware := r.routeFactories[i](route); middleware != nil {
+			handler = middleware(handler)
+		}
+	}
+	for i := len(route.middlewares) - 1; i >= 0; i-- {
+		handler = route.middlewares[i](handler)
+	}
 	for i := len(r.middlewares) - 1; i >= 0; i-- {
-		handler = r.middlewares[i](handler).(InspectHandlerFunc)
+		handler = r.middlewares[i](handler)
 	}
+	return handler
+}
 
-	r.inspectHandlers[path] = handler
+func (r *Router) schemaHandler(env rollmelette.EnvInspector, payload []byte) error {
+	return Report(env, "schema", r.Schema())
+}
+
+// HandleABI lets the route at path also be called with the ABI encoding of input.
+// It panics if input can't be ABI-encoded or if its selector is already registered.
+func (r *Router) HandleABI(path string, input ABIInput) {
+	codec, err := NewABICodec(path, input)
+	if err != nil {
+		panic(fmt.Sprintf("invalid abi input for path %s: %v", path, err))
+	}
+	if existing, exists := r.abiCodecs[codec.Selector]; exists {
+		panic(fmt.Sprintf("abi selector %x of %s already registered for path %s", codec.Selector, codec.Signature, existing.Path))
+	}
+	r.abiCodecs[codec.Selector] = codec
 }
 
 type Request struct {
@@ -62,42 +112,72 @@ type Request struct {
 func parseRequestRawPayload(payload []byte) (*Request, error) {
 	var req Request
 	if err := json.Unmarshal(payload, &req); err != nil {
-		return nil, fmt.Errorf("invalid request format: %v, payload: %s", err, string(payload))
+		return nil, fmt.Errorf("%w: invalid request format: %v, payload: %s", ErrInvalidRequest, err, string(payload))
 	}
 
 	validator := validator.New()
 	if err := validator.Struct(req); err != nil {
-		return nil, fmt.Errorf("invalid request: %w", err)
+		return nil, fmt.Errorf("%w: %w", ErrInvalidRequest, err)
 	}
 
 	return &req, nil
 }
 
+// parseRequest decodes payloads starting with a registered ABI selector, and JSON requests otherwise.
+func (r *Router) parseRequest(payload []byte) (*Request, error) {
+	if len(payload) >= 4 {
+		if codec, exists := r.abiCodecs[[4]byte(payload[:4])]; exists {
+			data, err := codec.Decode(payload[4:])
+			if err != nil {
+				return nil, fmt.Errorf("%w: %w", ErrInvalidRequest, err)
+			}
+			return &Request{Path: codec.Path, Data: data}, nil
+		}
+	}
+	return parseRequestRawPayload(payload)
+}
+
 func (r *Router) Advance(env rollmelette.Env, metadata rollmelette.Metadata, deposit rollmelette.Deposit, payload []byte) error {
-	req, err := parseRequestRawPayload(payload)
+	var handler any = AdvanceHandlerFunc(r.advance)
+	for i := len(r.inputs) - 1; i >= 0; i-- {
+		handler = r.inputs[i](handler)
+	}
+	return handler.(AdvanceHandlerFunc)(env, metadata, deposit, payload)
+}
+
+func (r *Router) advance(env rollmelette.Env, metadata rollmelette.Metadata, deposit rollmelette.Deposit, payload []byte) error {
+	req, err := r.parseRequest(payload)
 	if err != nil {
+		index := metadata.Index
+		ReportError(env, &index, nil, err)
 		return err
 	}
 
 	path := strings.Trim(req.Path, "/")
 	handler, exists := r.advanceHandlers[path]
 	if !exists {
-		return fmt.Errorf("no handler found for path: %s", path)
+		err := fmt.Errorf("%w for path: %s", ErrRouteNotFound, path)
+		index := metadata.Index
+		ReportError(env, &index, nil, err)
+		return err
 	}
 
 	return handler(env, metadata, deposit, req.Data)
 }
 
 func (r *Router) Inspect(env rollmelette.EnvInspector, payload []byte) error {
-	req, err := parseRequestRawPayload(payload)
+	req, err := r.parseRequest(payload)
 	if err != nil {
+		ReportError(env, nil, nil, err)
 		return err
 	}
 
 	path := strings.Trim(req.Path, "/")
 	handler, exists := r.inspectHandlers[path]
 	if !exists {
-		return fmt.Errorf("no handler found for path: %s", path)
+		err := fmt.Errorf("%w for path: %s", ErrRouteNotFound, path)
+		ReportError(env, nil, nil, err)
+		return err
 	}
 
 	return handler(env, req.Data)
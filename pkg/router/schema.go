@@ -0,0 +1,169 @@
+package router
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// JSONSchemaDialect is the JSON Schema version of the generated schemas.
+const JSONSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+// Schema is the subset of JSON Schema needed to describe the route DTOs.
+type Schema struct {
+	Dialect          string             `json:"$schema,omitempty"`
+	Type             string             `json:"type,omitempty"`
+	Description      string             `json:"description,omitempty"`
+	Properties       map[string]*Schema `json:"properties,omitempty"`
+	Required         []string           `json:"required,omitempty"`
+	Items            *Schema            `json:"items,omitempty"`
+	Enum             []string           `json:"enum,omitempty"`
+	Pattern          string             `json:"pattern,omitempty"`
+	MinLength        *int               `json:"minLength,omitempty"`
+	MaxLength        *int               `json:"maxLength,omitempty"`
+	MinItems         *int               `json:"minItems,omitempty"`
+	MaxItems         *int               `json:"maxItems,omitempty"`
+	Minimum          *float64           `json:"minimum,omitempty"`
+	Maximum          *float64           `json:"maximum,omitempty"`
+	ExclusiveMinimum *float64           `json:"exclusiveMinimum,omitempty"`
+	ExclusiveMaximum *float64           `json:"exclusiveMaximum,omitempty"`
+}
+
+// NewSchema reflects over t, using the json tags for property names and the validate tags
+// for the required properties and the value constraints.
+func NewSchema(t reflect.Type) *Schema {
+	schema := typeSchema(t)
+	schema.Dialect = JSONSchemaDialect
+	return schema
+}
+
+func typeSchema(t reflect.Type) *Schema {
+	switch {
+	case t == addressType, t == commonAddressType:
+		return &Schema{Type: "string", Description: "address", Pattern: "^0x[0-9a-fA-F]{40}$"}
+	case t == uint256Type:
+		return &Schema{Type: "string", Description: "uint256", Pattern: "^[0-9]+$"}
+	}
+	switch t.Kind() {
+	case reflect.Pointer:
+		return typeSchema(t.Elem())
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		zero := 0.0
+		return &Schema{Type: "integer", Minimum: &zero}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return &Schema{Type: "integer"}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	case reflect.Slice, reflect.Array:
+		return &Schema{Type: "array", Items: typeSchema(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object"}
+	case reflect.Struct:
+		return structSchema(t)
+	default:
+		return &Schema{}
+	}
+}
+
+func structSchema(t reflect.Type) *Schema {
+	schema := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if !field.IsExported() || name == "-" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		property := typeSchema(field.Type)
+		if applyValidateTag(property, field.Tag.Get("validate")) {
+			schema.Required = append(schema.Required, name)
+		}
+		schema.Properties[name] = property
+	}
+	return schema
+}
+
+// applyValidateTag maps the validate rules with a JSON Schema equivalent onto schema,
+// and reports whether the field is required.
+func applyValidateTag(schema *Schema, tag string) (required bool) {
+	if tag == "" {
+		return false
+	}
+	for _, rule := range strings.Split(tag, ",") {
+		name, param, _ := strings.Cut(rule, "=")
+		switch name {
+		case "required":
+			required = true
+		case "oneof":
+			schema.Enum = strings.Fields(param)
+		case "len":
+			applyBound(schema, param, true, true, false)
+		case "min":
+			applyBound(schema, param, true, false, false)
+		case "max":
+			applyBound(schema, param, false, true, false)
+		case "gte":
+			applyBound(schema, param, true, false, false)
+		case "lte":
+			applyBound(schema, param, false, true, false)
+		case "gt":
+			applyBound(schema, param, true, false, true)
+		case "lt":
+			applyBound(schema, param, false, true, true)
+		}
+	}
+	return required
+}
+
+// applyBound sets a lower and/or upper bound, which the validator applies to the length
+// of strings, to the size of arrays and to the value of numbers.
+func applyBound(schema *Schema, param string, lower bool, upper bool, exclusive bool) {
+	value, err := strconv.ParseFloat(param, 64)
+	if err != nil {
+		return
+	}
+	switch schema.Type {
+	case "string":
+		length := int(value)
+		if exclusive && lower {
+			length++
+		}
+		if exclusive && upper {
+			length--
+		}
+		if lower {
+			schema.MinLength = &length
+		}
+		if upper {
+			schema.MaxLength = &length
+		}
+	case "array":
+		size := int(value)
+		if lower {
+			schema.MinItems = &size
+		}
+		if upper {
+			schema.MaxItems = &size
+		}
+	case "integer", "number":
+		switch {
+		case exclusive && lower:
+			schema.ExclusiveMinimum = &value
+		case exclusive && upper:
+			schema.ExclusiveMaximum = &value
+		default:
+			if lower {
+				schema.Minimum = &value
+			}
+			if upper {
+				schema.Maximum = &value
+			}
+		}
+	}
+}
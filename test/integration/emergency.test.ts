@@ -8,10 +8,13 @@ import {
 } from "./helpers";
 import { emergencyWithdrawAbi } from "../../contracts";
 
+// default EMERGENCY_WITHDRAW_DELAY, in seconds
+const EMERGENCY_WITHDRAW_DELAY = 172800n;
+
 describe("Emergency Tests", () => {
   const machine = createMachine();
 
-  it("should emergency erc20 withdraw", () => {
+  it("should queue and execute emergency erc20 withdraw", () => {
     const to = getAddress("0x14dC79964da2C08b23698B3D3cc7Ca32193d9955");
     const token = getAddress("0xfafafafafafafafafafafafafafafafafafafafa");
 
@@ -23,15 +26,30 @@ describe("Emergency Tests", () => {
       },
     });
 
-    const { outputs } = machine.advance(
+    const { outputs: queueOutputs } = machine.advance(
       encodeAdvanceInput({
         msgSender: ADMIN_ADDRESS,
         payload: `0x${Buffer.from(emergencyERC20WithdrawInput).toString("hex")}`,
       }),
       { collect: true },
     );
+    // only the pending notice is emitted at queue time
+    expect(queueOutputs.length).toBe(1);
+
+    const executeInput = JSON.stringify({
+      path: "emergency/execute",
+      data: { id: 1 },
+    });
+    const { outputs } = machine.advance(
+      encodeAdvanceInput({
+        msgSender: to,
+        blockTimestamp: EMERGENCY_WITHDRAW_DELAY,
+        payload: `0x${Buffer.from(executeInput).toString("hex")}`,
+      }),
+      { collect: true },
+    );
 
-    expect(outputs.length).toBe(1);
+    expect(outputs.length).toBe(2);
     const delegateCallPayload = encodeFunctionData({
       abi: emergencyWithdrawAbi,
       functionName: "emergencyERC20Withdraw",
@@ -44,7 +62,7 @@ describe("Emergency Tests", () => {
     expect(bytesToHex(outputs[0])).toBe(expectedOutput);
   });
 
-  it("should emergency ether withdraw", () => {
+  it("should queue and execute emergency ether withdraw", () => {
     const to = getAddress("0x14dC79964da2C08b23698B3D3cc7Ca32193d9955");
 
     const emergencyEtherWithdrawInput = JSON.stringify({
@@ -54,15 +72,30 @@ describe("Emergency Tests", () => {
       },
     });
 
-    const { outputs } = machine.advance(
+    const { outputs: queueOutputs } = machine.advance(
       encodeAdvanceInput({
         msgSender: ADMIN_ADDRESS,
         payload: `0x${Buffer.from(emergencyEtherWithdrawInput).toString("hex")}`,
       }),
       { collect: true },
     );
+    // only the pending notice is emitted at queue time
+    expect(queueOutputs.length).toBe(1);
+
+    const executeInput = JSON.stringify({
+      path: "emergency/execute",
+      data: { id: 2 },
+    });
+    const { outputs } = machine.advance(
+      encodeAdvanceInput({
+        msgSender: to,
+        blockTimestamp: EMERGENCY_WITHDRAW_DELAY,
+        payload: `0x${Buffer.from(executeInput).toString("hex")}`,
+      }),
+      { collect: true },
+    );
 
-    expect(outputs.length).toBe(1);
+    expect(outputs.length).toBe(2);
     const delegateCallPayload = encodeFunctionData({
       abi: emergencyWithdrawAbi,
       functionName: "emergencyETHWithdraw",
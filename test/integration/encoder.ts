@@ -1,5 +1,7 @@
 import {
   Address,
+  bytesToHex,
+  decodeFunctionData,
   encodeFunctionData,
   encodePacked,
   erc20Abi,
@@ -117,3 +119,11 @@ export const encodeNoticeOutput = (data: Notice) => {
     args: [data.payload],
   });
 };
+
+export const decodeNoticeOutput = (output: Uint8Array): Notice => {
+  const { args } = decodeFunctionData({
+    abi: outputsAbi,
+    data: bytesToHex(output),
+  });
+  return { payload: args[0] as Hex };
+};
@@ -5,10 +5,14 @@ import {
   getContractAddress,
   keccak256,
   concat,
+  hexToString,
+  stringToHex,
   type Address,
   type Hex,
 } from "viem";
+import { privateKeyToAccount } from "viem/accounts";
 import badgeArtifact from "../../assets/artifacts/Badge.json";
+import { decodeNoticeOutput, encodeAdvanceInput } from "./encoder";
 
 export const BADGE_BYTECODE = badgeArtifact.bytecode as Hex;
 
@@ -33,7 +37,7 @@ export const computeBadgeAddress = (
 export const MACHINE_STORED_DIR = ".cartesi/image";
 export const MACHINE_RUNTIME_CONFIG = { skip_root_hash_check: true };
 
-// These addresses are automatically created in the database by sqlite.go
+// These addresses are listed in configs/genesis.json, applied by the first input
 export const ADMIN_ADDRESS = getAddress(
   "0xD554153658E8D466428Fa48487f5aba18dF5E628",
 );
@@ -44,9 +48,11 @@ export const VERIFIER_ADDRESS = getAddress(
 export const TOKEN_ADDRESS = getAddress(
   "0x0000000000000000000000000000000000000009",
 );
-export const CREATOR_ADDRESS = getAddress(
-  "0x0000000000000000000000000000000000000007",
+// The creator signs social account challenges, so it needs a known private key (anvil account #1)
+export const CREATOR_ACCOUNT = privateKeyToAccount(
+  "0x59c6995e998f97a5a0044966f0945389dc9e86dae88c7a8412f4603b6b78690d",
 );
+export const CREATOR_ADDRESS = getAddress(CREATOR_ACCOUNT.address);
 export const FACTORY_ADDRESS = getAddress(
   "0x0000000000000000000000000000000000000013",
 );
@@ -100,3 +106,49 @@ export const setupTimeValues = (): {
   const maturityAt = baseTime + 10;
   return { baseTime, closesAt, maturityAt };
 };
+
+// createSocialAccount requests a social account challenge from the creator, signs its message
+// and registers the account through the verifier, returning the outputs of the last input.
+export const createSocialAccount = async (
+  machine: RollupsMachine,
+  blockTimestamp: number,
+  username: string,
+  platform: string,
+): Promise<Uint8Array[]> => {
+  const requestInput = JSON.stringify({
+    path: "social/challenge",
+    data: { username, platform },
+  });
+  const { outputs: requestOutputs } = machine.advance(
+    encodeAdvanceInput({
+      msgSender: CREATOR_ADDRESS,
+      blockTimestamp: BigInt(blockTimestamp),
+      payload: stringToHex(requestInput),
+    }),
+    { collect: true },
+  );
+  const challenge = JSON.parse(
+    hexToString(decodeNoticeOutput(requestOutputs[0]).payload),
+  ).data;
+
+  const createInput = JSON.stringify({
+    path: "social/verifier/create",
+    data: {
+      challenge_id: challenge.id,
+      post_id: `post-${challenge.id}`,
+      content_hash: keccak256(stringToHex(challenge.nonce)),
+      signature: await CREATOR_ACCOUNT.signMessage({
+        message: challenge.message,
+      }),
+    },
+  });
+  const { outputs } = machine.advance(
+    encodeAdvanceInput({
+      msgSender: VERIFIER_ADDRESS,
+      blockTimestamp: BigInt(blockTimestamp),
+      payload: stringToHex(createInput),
+    }),
+    { collect: true },
+  );
+  return outputs;
+};
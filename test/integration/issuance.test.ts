@@ -11,7 +11,7 @@ import {
   createMachine,
   computeBadgeAddress,
   ADMIN_ADDRESS,
-  VERIFIER_ADDRESS,
+  createSocialAccount,
   CREATOR_ADDRESS,
   TOKEN_ADDRESS,
   FACTORY_ADDRESS,
@@ -33,7 +33,7 @@ describe("Issuance Tests", () => {
 
   const { baseTime, closesAt, maturityAt } = setupTimeValues();
 
-  it("should create issuance", () => {
+  it("should create issuance", async () => {
     const createUserInput = JSON.stringify({
       path: "user/admin/create",
       data: {
@@ -51,23 +51,7 @@ describe("Issuance Tests", () => {
       { collect: true },
     );
 
-    const createSocialAccountInput = JSON.stringify({
-      path: "social/verifier/create",
-      data: {
-        address: CREATOR_ADDRESS,
-        username: "test",
-        platform: "twitter",
-      },
-    });
-
-    machine.advance(
-      encodeAdvanceInput({
-        msgSender: VERIFIER_ADDRESS,
-        blockTimestamp: BigInt(baseTime),
-        payload: `0x${Buffer.from(createSocialAccountInput).toString("hex")}`,
-      }),
-      { collect: true },
-    );
+    await createSocialAccount(machine, baseTime, "test", "twitter");
 
     const createIssuanceInput = JSON.stringify({
       path: "issuance/creator/create",
@@ -127,7 +111,7 @@ describe("Issuance Tests", () => {
     // Verify notice for issuance creation
     const expectedCreateIssuanceNoticeOutput = encodeNoticeOutput({
       payload: stringToHex(
-        `issuance created - {"id":1,"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"${TOKEN_ADDRESS.toLowerCase()}","creator":{"id":3,"role":"creator","address":"${CREATOR_ADDRESS}","social_accounts":[{"id":1,"user_id":3,"username":"test","platform":"twitter","created_at":${baseTime}}],"created_at":${baseTime},"updated_at":0},"collateral":"${COLLATERAL.toLowerCase()}","collateral_amount":"10000","badge_address":"${badgeAddress}","debt_issued":"100000","max_interest_rate":"1000","state":"ongoing","orders":[],"created_at":${baseTime},"closes_at":${closesAt},"maturity_at":${maturityAt}}`,
+        `{"v":1,"type":"issuance.created","input_index":0,"data":{"id":1,"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"${TOKEN_ADDRESS.toLowerCase()}","creator":{"id":3,"roles":["creator"],"address":"${CREATOR_ADDRESS}","status":"active","social_accounts":[{"id":1,"user_id":3,"username":"test","platform":"twitter","created_at":${baseTime}}],"reputation":{"score":500,"issuances":1,"settled_on_time":0,"settled_late":0,"collateral_executions":0,"cancellations":0,"total_volume":"0","updated_at":${baseTime}},"created_at":${baseTime},"updated_at":0},"collateral":"${COLLATERAL.toLowerCase()}","collateral_amount":"10000","badge_address":"${badgeAddress}","debt_issued":"100000","max_interest_rate":"1000","state":"ongoing","orders":[],"created_at":${baseTime},"closes_at":${closesAt},"maturity_at":${maturityAt}}}`,
       ),
     });
     expect(bytesToHex(outputs[1])).toBe(expectedCreateIssuanceNoticeOutput);
@@ -143,7 +127,7 @@ describe("Issuance Tests", () => {
     });
 
     expect(reports.length).toBe(1);
-    const output = JSON.parse(Buffer.from(reports[0]).toString("utf-8"));
+    const output = JSON.parse(Buffer.from(reports[0]).toString("utf-8")).data;
 
     const metadataIndex = 0n;
     const salt = padHex(`0x${metadataIndex.toString(16)}`, { size: 32 });
@@ -207,7 +191,7 @@ describe("Issuance Tests", () => {
     });
 
     expect(reports.length).toBe(1);
-    const output = JSON.parse(Buffer.from(reports[0]).toString("utf-8"));
+    const output = JSON.parse(Buffer.from(reports[0]).toString("utf-8")).data;
 
     const metadataIndex = 0n;
     const salt = padHex(`0x${metadataIndex.toString(16)}`, { size: 32 });
@@ -269,7 +253,7 @@ describe("Issuance Tests", () => {
     });
 
     expect(reports.length).toBe(1);
-    const output = JSON.parse(Buffer.from(reports[0]).toString("utf-8"));
+    const output = JSON.parse(Buffer.from(reports[0]).toString("utf-8")).data;
 
     const metadataIndex = 0n;
     const salt = padHex(`0x${metadataIndex.toString(16)}`, { size: 32 });
@@ -350,23 +334,7 @@ describe("Issuance Close Tests", () => {
     );
 
     // Setup: create social account
-    const createSocialAccountInput = JSON.stringify({
-      path: "social/verifier/create",
-      data: {
-        address: CREATOR_ADDRESS,
-        username: "test",
-        platform: "twitter",
-      },
-    });
-
-    machine.advance(
-      encodeAdvanceInput({
-        msgSender: VERIFIER_ADDRESS,
-        blockTimestamp: BigInt(baseTime),
-        payload: `0x${Buffer.from(createSocialAccountInput).toString("hex")}`,
-      }),
-      { collect: true },
-    );
+    await createSocialAccount(machine, baseTime, "test", "twitter");
 
     // Setup: create issuance
     const createIssuanceInput = JSON.stringify({
@@ -476,7 +444,7 @@ describe("Issuance Close Tests", () => {
 
     const expectedCloseIssuanceNoticeOutput = encodeNoticeOutput({
       payload: stringToHex(
-        `issuance closed - {"id":1,"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"${TOKEN_ADDRESS.toLowerCase()}","creator":{"id":3,"role":"creator","address":"${CREATOR_ADDRESS}","social_accounts":[{"id":1,"user_id":3,"username":"test","platform":"twitter","created_at":${baseTime}}],"created_at":${baseTime},"updated_at":0},"collateral":"${COLLATERAL.toLowerCase()}","collateral_amount":"10000","badge_address":"${badgeAddress}","debt_issued":"100000","max_interest_rate":"1000","total_obligation":"76300","total_raised":"70000","state":"closed","orders":[{"id":1,"issuance_id":1,"investor":{"id":4,"role":"investor","address":"${INVESTOR_01_ADDRESS}","social_accounts":[],"created_at":${baseTime},"updated_at":0},"amount":"70000","interest_rate":"900","state":"accepted","created_at":${baseTime},"updated_at":1}],"created_at":${baseTime},"closes_at":${closesAt},"maturity_at":${maturityAt},"updated_at":1}`,
+        `{"v":1,"type":"issuance.closed","input_index":0,"data":{"id":1,"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"${TOKEN_ADDRESS.toLowerCase()}","creator":{"id":3,"roles":["creator"],"address":"${CREATOR_ADDRESS}","status":"active","social_accounts":[{"id":1,"user_id":3,"username":"test","platform":"twitter","created_at":${baseTime}}],"reputation":{"score":500,"issuances":1,"settled_on_time":0,"settled_late":0,"collateral_executions":0,"cancellations":0,"total_volume":"70000","updated_at":${baseTime + 6}},"created_at":${baseTime},"updated_at":0},"collateral":"${COLLATERAL.toLowerCase()}","collateral_amount":"10000","badge_address":"${badgeAddress}","debt_issued":"100000","max_interest_rate":"1000","total_obligation":"76300","total_raised":"70000","state":"closed","orders":[{"id":1,"issuance_id":1,"investor":{"id":4,"roles":["investor"],"address":"${INVESTOR_01_ADDRESS}","status":"active","social_accounts":[],"created_at":${baseTime},"updated_at":0},"amount":"70000","interest_rate":"900","state":"accepted","created_at":${baseTime},"updated_at":1}],"created_at":${baseTime},"closes_at":${closesAt},"maturity_at":${maturityAt},"updated_at":1}}`,
       ),
     });
     expect(bytesToHex(outputs[outputs.length - 1])).toBe(
@@ -548,24 +516,7 @@ describe("Issuance Execute Collateral Tests", () => {
     );
 
     // Setup: create social account
-    const createSocialAccountInput = JSON.stringify({
-      path: "social/verifier/create",
-      data: {
-        address: CREATOR_ADDRESS,
-        username: "test",
-        platform: "twitter",
-      },
-    });
-
-    machine.advance(
-      encodeAdvanceInput({
-        appContract: APPLICATION_ADDRESS,
-        msgSender: VERIFIER_ADDRESS,
-        blockTimestamp: BigInt(baseTime),
-        payload: `0x${Buffer.from(createSocialAccountInput).toString("hex")}`,
-      }),
-      { collect: true },
-    );
+    await createSocialAccount(machine, baseTime, "test", "twitter");
 
     // Setup: create issuance
     const createIssuanceInput = JSON.stringify({
@@ -698,7 +649,7 @@ describe("Issuance Execute Collateral Tests", () => {
 
     const expectedExecuteIssuanceCollateralNoticeOutput = encodeNoticeOutput({
       payload: stringToHex(
-        `issuance collateral executed - {"id":1,"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"${TOKEN_ADDRESS.toLowerCase()}","creator":{"id":3,"role":"creator","address":"${CREATOR_ADDRESS}","social_accounts":[{"id":1,"user_id":3,"username":"test","platform":"twitter","created_at":${baseTime}}],"created_at":${baseTime},"updated_at":0},"collateral":"${COLLATERAL.toLowerCase()}","collateral_amount":"10000","badge_address":"${badgeAddress}","debt_issued":"100000","max_interest_rate":"1000","total_obligation":"76300","total_raised":"70000","state":"collateral_executed","orders":[{"id":1,"issuance_id":1,"investor":{"id":4,"role":"investor","address":"${INVESTOR_01_ADDRESS}","social_accounts":[],"created_at":${baseTime},"updated_at":0},"amount":"70000","interest_rate":"900","state":"settled_by_collateral","created_at":${baseTime},"updated_at":1}],"created_at":${baseTime},"closes_at":${closesAt},"maturity_at":${maturityAt},"updated_at":1}`,
+        `{"v":1,"type":"issuance.collateral_executed","input_index":0,"data":{"id":1,"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"${TOKEN_ADDRESS.toLowerCase()}","creator":{"id":3,"roles":["creator"],"address":"${CREATOR_ADDRESS}","status":"active","social_accounts":[{"id":1,"user_id":3,"username":"test","platform":"twitter","created_at":${baseTime}}],"reputation":{"score":250,"issuances":1,"settled_on_time":0,"settled_late":0,"collateral_executions":1,"cancellations":0,"total_volume":"70000","updated_at":${baseTime + 11}},"created_at":${baseTime},"updated_at":0},"collateral":"${COLLATERAL.toLowerCase()}","collateral_amount":"10000","badge_address":"${badgeAddress}","debt_issued":"100000","max_interest_rate":"1000","total_obligation":"76300","total_raised":"70000","state":"collateral_executed","orders":[{"id":1,"issuance_id":1,"investor":{"id":4,"roles":["investor"],"address":"${INVESTOR_01_ADDRESS}","status":"active","social_accounts":[],"created_at":${baseTime},"updated_at":0},"amount":"70000","interest_rate":"900","state":"settled_by_collateral","created_at":${baseTime},"updated_at":1}],"created_at":${baseTime},"closes_at":${closesAt},"maturity_at":${maturityAt},"updated_at":1}}`,
       ),
     });
     expect(bytesToHex(outputs[outputs.length - 1])).toBe(
@@ -736,23 +687,7 @@ describe("Issuance Settle Tests", () => {
     );
 
     // Setup: create social account
-    const createSocialAccountInput = JSON.stringify({
-      path: "social/verifier/create",
-      data: {
-        address: CREATOR_ADDRESS,
-        username: "test",
-        platform: "twitter",
-      },
-    });
-
-    machine.advance(
-      encodeAdvanceInput({
-        msgSender: VERIFIER_ADDRESS,
-        blockTimestamp: BigInt(baseTime),
-        payload: `0x${Buffer.from(createSocialAccountInput).toString("hex")}`,
-      }),
-      { collect: true },
-    );
+    await createSocialAccount(machine, baseTime, "test", "twitter");
 
     // Setup: create issuance
     const createIssuanceInput = JSON.stringify({
@@ -937,7 +872,7 @@ describe("Issuance Settle Tests", () => {
 
     const expectedSettleIssuanceNoticeOutput = encodeNoticeOutput({
       payload: stringToHex(
-        `issuance settled - {"id":1,"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"${TOKEN_ADDRESS.toLowerCase()}","creator":{"id":3,"role":"creator","address":"${CREATOR_ADDRESS}","social_accounts":[{"id":1,"user_id":3,"username":"test","platform":"twitter","created_at":${baseTime}}],"created_at":${baseTime},"updated_at":0},"collateral":"${COLLATERAL.toLowerCase()}","collateral_amount":"10000","badge_address":"${badgeAddress}","debt_issued":"100000","max_interest_rate":"1000","total_obligation":"76300","total_raised":"70000","state":"settled","orders":[{"id":1,"issuance_id":1,"investor":{"id":4,"role":"investor","address":"${INVESTOR_01_ADDRESS}","social_accounts":[],"created_at":${baseTime},"updated_at":0},"amount":"70000","interest_rate":"900","state":"settled","created_at":${baseTime},"updated_at":1}],"created_at":${baseTime},"closes_at":${closesAt},"maturity_at":${maturityAt},"updated_at":1}`,
+        `{"v":1,"type":"issuance.settled","input_index":0,"data":{"id":1,"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"${TOKEN_ADDRESS.toLowerCase()}","creator":{"id":3,"roles":["creator"],"address":"${CREATOR_ADDRESS}","status":"active","social_accounts":[{"id":1,"user_id":3,"username":"test","platform":"twitter","created_at":${baseTime}}],"reputation":{"score":600,"issuances":1,"settled_on_time":1,"settled_late":0,"collateral_executions":0,"cancellations":0,"total_volume":"70000","updated_at":${baseTime + 9}},"created_at":${baseTime},"updated_at":0},"collateral":"${COLLATERAL.toLowerCase()}","collateral_amount":"10000","badge_address":"${badgeAddress}","debt_issued":"100000","max_interest_rate":"1000","total_obligation":"76300","total_raised":"70000","state":"settled","orders":[{"id":1,"issuance_id":1,"investor":{"id":4,"roles":["investor"],"address":"${INVESTOR_01_ADDRESS}","status":"active","social_accounts":[],"created_at":${baseTime},"updated_at":0},"amount":"70000","interest_rate":"900","state":"settled","created_at":${baseTime},"updated_at":1}],"created_at":${baseTime},"closes_at":${closesAt},"maturity_at":${maturityAt},"updated_at":1}}`,
       ),
     });
     expect(bytesToHex(outputs[outputs.length - 1])).toBe(
@@ -975,23 +910,7 @@ describe("Issuance with Multiple Investors Tests", () => {
     );
 
     // Setup: create social account
-    const createSocialAccountInput = JSON.stringify({
-      path: "social/verifier/create",
-      data: {
-        address: CREATOR_ADDRESS,
-        username: "test",
-        platform: "twitter",
-      },
-    });
-
-    machine.advance(
-      encodeAdvanceInput({
-        msgSender: VERIFIER_ADDRESS,
-        blockTimestamp: BigInt(baseTime),
-        payload: `0x${Buffer.from(createSocialAccountInput).toString("hex")}`,
-      }),
-      { collect: true },
-    );
+    await createSocialAccount(machine, baseTime, "test", "twitter");
 
     // Setup: create multiple investor users
     const investors = [
@@ -1092,7 +1011,7 @@ describe("Issuance with Multiple Investors Tests", () => {
     });
 
     expect(reports.length).toBe(1);
-    const output = JSON.parse(Buffer.from(reports[0]).toString("utf-8"));
+    const output = JSON.parse(Buffer.from(reports[0]).toString("utf-8")).data;
 
     // This test has complex nested structures. Verify key fields instead
     expect(output.length).toBe(1);
@@ -8,7 +8,7 @@ import {
 import {
   createMachine,
   ADMIN_ADDRESS,
-  VERIFIER_ADDRESS,
+  createSocialAccount,
   CREATOR_ADDRESS,
   TOKEN_ADDRESS,
   COLLATERAL,
@@ -23,7 +23,7 @@ describe("Order Tests", () => {
 
   const { baseTime, closesAt, maturityAt } = setupTimeValues();
 
-  it("should create order", () => {
+  it("should create order", async () => {
     // Setup: create creator user
     const createUserInput = JSON.stringify({
       path: "user/admin/create",
@@ -43,23 +43,7 @@ describe("Order Tests", () => {
     );
 
     // Setup: create social account
-    const createSocialAccountInput = JSON.stringify({
-      path: "social/verifier/create",
-      data: {
-        address: CREATOR_ADDRESS,
-        username: "test",
-        platform: "twitter",
-      },
-    });
-
-    machine.advance(
-      encodeAdvanceInput({
-        msgSender: VERIFIER_ADDRESS,
-        blockTimestamp: BigInt(baseTime),
-        payload: `0x${Buffer.from(createSocialAccountInput).toString("hex")}`,
-      }),
-      { collect: true },
-    );
+    await createSocialAccount(machine, baseTime, "test", "twitter");
 
     // Setup: create issuance
     const createIssuanceInput = JSON.stringify({
@@ -141,7 +125,7 @@ describe("Order Tests", () => {
 
     const expectedCreateOrderNoticeOutput = encodeNoticeOutput({
       payload: stringToHex(
-        `order created - {"id":1,"issuance_id":1,"investor":{"id":4,"role":"investor","address":"${INVESTOR_01_ADDRESS}","social_accounts":[],"created_at":${baseTime},"updated_at":0},"amount":"10000","interest_rate":"900","state":"pending","created_at":${baseTime}}`,
+        `{"v":1,"type":"order.created","input_index":0,"data":{"id":1,"issuance_id":1,"investor":{"id":4,"roles":["investor"],"address":"${INVESTOR_01_ADDRESS}","status":"active","social_accounts":[],"created_at":${baseTime},"updated_at":0},"amount":"10000","interest_rate":"900","state":"pending","created_at":${baseTime}}}`,
       ),
     });
     expect(bytesToHex(outputs[0])).toBe(expectedCreateOrderNoticeOutput);
@@ -157,7 +141,7 @@ describe("Order Tests", () => {
     });
 
     expect(reports.length).toBe(1);
-    const output = JSON.parse(Buffer.from(reports[0]).toString("utf-8"));
+    const output = JSON.parse(Buffer.from(reports[0]).toString("utf-8")).data;
 
     const expectedFindAllOrdersOutput = [
       {
@@ -194,7 +178,7 @@ describe("Order Tests", () => {
     });
 
     expect(reports.length).toBe(1);
-    const output = JSON.parse(Buffer.from(reports[0]).toString("utf-8"));
+    const output = JSON.parse(Buffer.from(reports[0]).toString("utf-8")).data;
 
     const expectedFindOrderByIdOutput = {
       id: 1,
@@ -229,7 +213,7 @@ describe("Order Tests", () => {
     });
 
     expect(reports.length).toBe(1);
-    const output = JSON.parse(Buffer.from(reports[0]).toString("utf-8"));
+    const output = JSON.parse(Buffer.from(reports[0]).toString("utf-8")).data;
 
     const expectedFindOrdersByIssuanceOutput = [
       {
@@ -266,7 +250,7 @@ describe("Order Tests", () => {
     });
 
     expect(reports.length).toBe(1);
-    const output = JSON.parse(Buffer.from(reports[0]).toString("utf-8"));
+    const output = JSON.parse(Buffer.from(reports[0]).toString("utf-8")).data;
 
     const expectedFindOrdersByInvestorOutput = [
       {
@@ -309,7 +293,7 @@ describe("Order Tests", () => {
 
     expect(outputs.length).toBe(1);
     const noticePayload = Buffer.from(outputs[0]).toString("utf-8");
-    expect(noticePayload).toContain("order canceled");
+    expect(noticePayload).toContain('"type":"order.canceled"');
   });
 
   afterAll(() => {
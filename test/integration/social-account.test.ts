@@ -1,10 +1,15 @@
-import { bytesToHex, stringToHex } from "viem";
+import { bytesToHex, hexToString, keccak256, stringToHex } from "viem";
 import { afterAll, describe, expect, it } from "vitest";
-import { encodeAdvanceInput, encodeNoticeOutput } from "./encoder";
+import {
+  decodeNoticeOutput,
+  encodeAdvanceInput,
+  encodeNoticeOutput,
+} from "./encoder";
 import {
   createMachine,
   ADMIN_ADDRESS,
   VERIFIER_ADDRESS,
+  CREATOR_ACCOUNT,
   CREATOR_ADDRESS,
 } from "./helpers";
 
@@ -13,7 +18,9 @@ describe("Social Account Tests", () => {
 
   const baseTime = Math.floor(Date.now() / 1000);
 
-  it("should create social account", () => {
+  let evidence: Record<string, unknown>;
+
+  it("should create social account", async () => {
     const createUserInput = JSON.stringify({
       path: "user/admin/create",
       data: {
@@ -31,15 +38,52 @@ describe("Social Account Tests", () => {
       { collect: true },
     );
 
-    const createSocialAccountInput = JSON.stringify({
-      path: "social/verifier/create",
+    const requestChallengeInput = JSON.stringify({
+      path: "social/challenge",
       data: {
-        address: CREATOR_ADDRESS,
         username: "test",
         platform: "twitter",
       },
     });
 
+    const { outputs: requestOutputs } = machine.advance(
+      encodeAdvanceInput({
+        msgSender: CREATOR_ADDRESS,
+        blockTimestamp: BigInt(baseTime),
+        payload: `0x${Buffer.from(requestChallengeInput).toString("hex")}`,
+      }),
+      { collect: true },
+    );
+
+    expect(requestOutputs.length).toBe(1);
+    const challenge = JSON.parse(
+      hexToString(decodeNoticeOutput(requestOutputs[0]).payload),
+    ).data;
+    expect(challenge.message).toBe(
+      `I control the twitter account test and the wallet ${CREATOR_ADDRESS}. Nonce: ${challenge.nonce}`,
+    );
+    expect(challenge.expires_at).toBe(baseTime + 86400);
+
+    evidence = {
+      challenge_id: challenge.id,
+      post_id: "1234",
+      content_hash: keccak256(stringToHex(challenge.nonce)),
+      signature: await CREATOR_ACCOUNT.signMessage({
+        message: challenge.message,
+      }),
+      verified_by: VERIFIER_ADDRESS,
+    };
+
+    const createSocialAccountInput = JSON.stringify({
+      path: "social/verifier/create",
+      data: {
+        challenge_id: evidence.challenge_id,
+        post_id: evidence.post_id,
+        content_hash: evidence.content_hash,
+        signature: evidence.signature,
+      },
+    });
+
     const { outputs } = machine.advance(
       encodeAdvanceInput({
         msgSender: VERIFIER_ADDRESS,
@@ -51,7 +95,7 @@ describe("Social Account Tests", () => {
 
     expect(outputs.length).toBe(1);
 
-    const expectedNoticePayload = `social account created - {"id":1,"user_id":3,"username":"test","platform":"twitter","created_at":${baseTime}}`;
+    const expectedNoticePayload = `{"v":1,"type":"social_account.created","input_index":0,"data":{"id":1,"user_id":3,"username":"test","platform":"twitter","evidence":${JSON.stringify(evidence)},"created_at":${baseTime}}}`;
     const expectedOutput = encodeNoticeOutput({
       payload: stringToHex(expectedNoticePayload),
     });
@@ -71,12 +115,13 @@ describe("Social Account Tests", () => {
     });
 
     expect(reports.length).toBe(1);
-    const output = JSON.parse(Buffer.from(reports[0]).toString("utf-8"));
+    const output = JSON.parse(Buffer.from(reports[0]).toString("utf-8")).data;
     const expectedOutput = {
       id: 1,
       user_id: 3,
       username: "test",
       platform: "twitter",
+      evidence,
       created_at: baseTime,
       updated_at: 0,
     };
@@ -96,13 +141,14 @@ describe("Social Account Tests", () => {
     });
 
     expect(reports.length).toBe(1);
-    const output = JSON.parse(Buffer.from(reports[0]).toString("utf-8"));
+    const output = JSON.parse(Buffer.from(reports[0]).toString("utf-8")).data;
     const expectedFindSocialAccountsByUserIdOutput = [
       {
         id: 1,
         user_id: 3,
         username: "test",
         platform: "twitter",
+        evidence,
         created_at: baseTime,
         updated_at: 0,
       },
@@ -129,7 +175,7 @@ describe("Social Account Tests", () => {
 
     expect(outputs.length).toBe(1);
 
-    const expectedNoticePayload = `social account deleted - {"social_account_id":1}`;
+    const expectedNoticePayload = `{"v":1,"type":"social_account.deleted","input_index":0,"data":{"social_account_id":1}}`;
     const expectedOutput = encodeNoticeOutput({
       payload: stringToHex(expectedNoticePayload),
     });
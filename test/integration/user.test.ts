@@ -1,6 +1,10 @@
-import { bytesToHex, stringToHex } from "viem";
+import { bytesToHex, hexToString, stringToHex } from "viem";
 import { afterAll, describe, expect, it } from "vitest";
-import { encodeAdvanceInput, encodeNoticeOutput } from "./encoder";
+import {
+  decodeNoticeOutput,
+  encodeAdvanceInput,
+  encodeNoticeOutput,
+} from "./encoder";
 import {
   createMachine,
   ADMIN_ADDRESS,
@@ -34,7 +38,7 @@ describe("User Tests", () => {
 
     expect(outputs.length).toBe(1);
 
-    const expectedNoticePayload = `user created - {"id":3,"role":"creator","address":"${CREATOR_ADDRESS}","social_accounts":[],"created_at":${baseTime}}`;
+    const expectedNoticePayload = `{"v":1,"type":"user.created","input_index":0,"data":{"id":3,"roles":["creator"],"address":"${CREATOR_ADDRESS}","status":"active","social_accounts":[],"created_at":${baseTime}}}`;
     const expectedOutput = encodeNoticeOutput({
       payload: stringToHex(expectedNoticePayload),
     });
@@ -63,7 +67,7 @@ describe("User Tests", () => {
 
     expect(outputs.length).toBe(1);
 
-    const expectedNoticePayload = `user created - {"id":4,"role":"investor","address":"${INVESTOR_01_ADDRESS}","social_accounts":[],"created_at":${baseTime}}`;
+    const expectedNoticePayload = `{"v":1,"type":"user.created","input_index":0,"data":{"id":4,"roles":["investor"],"address":"${INVESTOR_01_ADDRESS}","status":"active","social_accounts":[],"created_at":${baseTime}}}`;
     const expectedOutput = encodeNoticeOutput({
       payload: stringToHex(expectedNoticePayload),
     });
@@ -82,7 +86,7 @@ describe("User Tests", () => {
     });
 
     expect(reports.length).toBe(1);
-    const output = JSON.parse(Buffer.from(reports[0]).toString("utf-8"));
+    const output = JSON.parse(Buffer.from(reports[0]).toString("utf-8")).data;
     const expectedOutput = [
       {
         id: 1,
@@ -135,7 +139,7 @@ describe("User Tests", () => {
     });
 
     expect(reports.length).toBe(1);
-    const output = JSON.parse(Buffer.from(reports[0]).toString("utf-8"));
+    const output = JSON.parse(Buffer.from(reports[0]).toString("utf-8")).data;
     const expectedOutput = {
       id: 3,
       role: "creator",
@@ -147,7 +151,7 @@ describe("User Tests", () => {
     expect(output).toEqual(expectedOutput);
   });
 
-  it("should delete user", () => {
+  it("should deactivate user", () => {
     const baseTime = Math.floor(Date.now() / 1000);
 
     const deleteUserInput = JSON.stringify({
@@ -168,11 +172,14 @@ describe("User Tests", () => {
 
     expect(outputs.length).toBe(1);
 
-    const expectedNoticePayload = `user deleted - {"address":"${INVESTOR_01_ADDRESS}"}`;
-    const expectedOutput = encodeNoticeOutput({
-      payload: stringToHex(expectedNoticePayload),
-    });
-    expect(bytesToHex(outputs[0])).toBe(expectedOutput);
+    // the user record is kept with a deactivated status
+    const notice = JSON.parse(
+      hexToString(decodeNoticeOutput(outputs[0]).payload),
+    );
+    expect(notice.type).toBe("user.deactivated");
+    expect(notice.data.address).toBe(INVESTOR_01_ADDRESS);
+    expect(notice.data.status).toBe("deactivated");
+    expect(notice.data.deactivated_at).toBe(baseTime);
   });
 
   afterAll(() => {
@@ -0,0 +1,121 @@
+package integration
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"testing"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/issuance"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/user"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/router"
+	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestABISuite(t *testing.T) {
+	suite.Run(t, new(ABISuite))
+}
+
+type ABISuite struct {
+	DCMRollupSuite
+}
+
+func (s *ABISuite) encode(path string, input router.ABIInput) []byte {
+	codec, err := router.NewABICodec(path, input)
+	s.Require().NoError(err)
+	payload, err := codec.Encode(input)
+	s.Require().NoError(err)
+	return payload
+}
+
+func (s *ABISuite) TestABICodecSignature() {
+	codec, err := router.NewABICodec("issuance/creator/create", issuance.CreateIssuanceInputDTO{})
+	s.Require().NoError(err)
+	s.Equal("createIssuance(string,string,string,address,uint256,uint256,int64,int64,string[],string[])", codec.Signature)
+	s.Equal(crypto.Keccak256([]byte(codec.Signature))[:4], codec.Selector[:])
+}
+
+func (s *ABISuite) TestCreateUserWithABI() {
+	admin, _, creator, _, _, _, _, _ := s.setupCommonAddresses()
+	baseTime, _, _ := s.setupTimeValues()
+
+	// create creator user
+	createUserInput := s.encode("user/admin/create", user.CreateUserInputDTO{Role: "creator", Address: Address(creator)})
+	createUserOutput := s.Tester.Advance(admin, createUserInput)
+	s.NoError(createUserOutput.Err)
+	s.Len(createUserOutput.Notices, 2)
+
+	expectedCreateUserOutput := fmt.Sprintf(`{"id":3,"roles":["creator"],"address":"%s","status":"active","social_accounts":[],"created_at":%d}`, creator, baseTime)
+	s.Equal(expectedCreateUserOutput, s.envelopeData("user.created", createUserOutput.Notices[0].Payload))
+}
+
+func (s *ABISuite) TestCreateIssuanceWithABI() {
+	admin, token, creator, factory, verifier, collateral, _, applicationAddress := s.setupCommonAddresses()
+	baseTime, closesAt, maturityAt := s.setupTimeValues()
+
+	// create creator user
+	createUserInput := s.encode("user/admin/create", user.CreateUserInputDTO{Role: "creator", Address: Address(creator)})
+	createUserOutput := s.Tester.Advance(admin, createUserInput)
+	s.Len(createUserOutput.Notices, 2)
+
+	// verify social account
+	createSocialAccountOutput := s.createSocialAccount(verifier, creatorKey, "test", "twitter")
+	s.Len(createSocialAccountOutput.Notices, 2)
+
+	addressType, _ := abi.NewType("address", "", nil)
+	constructorArgs, err := abi.Arguments{
+		{Type: addressType},
+	}.Pack(applicationAddress)
+	s.Require().NoError(err)
+
+	badgeAddress := crypto.CreateAddress2(
+		factory,
+		common.HexToHash(strconv.Itoa(3)),
+		crypto.Keccak256(append(s.Bytecode, constructorArgs...)),
+	)
+
+	// create issuance
+	createIssuanceInput := s.encode("issuance/creator/create", issuance.CreateIssuanceInputDTO{
+		Title:                        "test",
+		Description:                  "testtesttesttesttest",
+		Promotion:                    "testtesttesttesttest",
+		Token:                        Address(token),
+		DebtIssued:                   uint256.NewInt(100000),
+		MaxInterestRate:              uint256.NewInt(1000),
+		ClosesAt:                     closesAt,
+		MaturityAt:                   maturityAt,
+		RequiredInvestorAttestations: []string{string(entity.AttestationTypeKYC)},
+	})
+	createIssuanceOutput := s.Tester.DepositERC20(collateral, creator, big.NewInt(10000), createIssuanceInput)
+	s.NoError(createIssuanceOutput.Err)
+	s.Len(createIssuanceOutput.Notices, 2)
+
+	expectedCreateIssuanceOutput := fmt.Sprintf(`{"id":1,"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"%s","creator":{"id":3,"roles":["creator"],"address":"%s","status":"active","social_accounts":[{"id":1,"user_id":3,"username":"test","platform":"twitter","created_at":%d}],"reputation":%s,"created_at":%d,"updated_at":0},"collateral":"%s","collateral_amount":"10000","badge_address":"%s","debt_issued":"100000","max_interest_rate":"1000","state":"ongoing","required_investor_attestations":["kyc"],"orders":[],"created_at":%d,"closes_at":%d,"maturity_at":%d}`,
+		token.Hex(),
+		creator.Hex(),
+		baseTime,
+		reputationJSON(500, 1, 0, 0, 0, 0, "0", baseTime),
+		baseTime,
+		collateral.Hex(),
+		badgeAddress.Hex(),
+		baseTime, closesAt, maturityAt)
+	s.Equal(expectedCreateIssuanceOutput, s.envelopeData("issuance.created", createIssuanceOutput.Notices[0].Payload))
+}
+
+func (s *ABISuite) TestMalformedABIInput() {
+	admin, _, _, _, _, _, _, _ := s.setupCommonAddresses()
+
+	// selector of createUser followed by truncated arguments
+	createUserInput := s.encode("user/admin/create", user.CreateUserInputDTO{Role: "creator"})
+	createUserOutput := s.Tester.Advance(admin, createUserInput[:36])
+	s.Error(createUserOutput.Err)
+	s.Len(createUserOutput.Notices, 0)
+	s.Len(createUserOutput.Reports, 1)
+	s.Contains(string(createUserOutput.Reports[0].Payload), `"code":"INVALID_REQUEST"`)
+}
@@ -0,0 +1,233 @@
+package integration
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
+	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestAddressRotationSuite(t *testing.T) {
+	suite.Run(t, new(AddressRotationSuite))
+}
+
+type AddressRotationSuite struct {
+	DCMRollupSuite
+}
+
+// setupInvestorWithOrders creates an issuance and two orders of investor01, canceling the
+// first one so that its amount stays in the investor wallet.
+func (s *AddressRotationSuite) setupInvestorWithOrders() {
+	admin, token, creator, _, verifier, collateral, _, _ := s.setupCommonAddresses()
+	investor01, _, _, _, _ := s.setupInvestorAddresses()
+	_, closesAt, maturityAt := s.setupTimeValues()
+
+	createUserInput := []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"creator"}}`, creator))
+	s.Tester.Advance(admin, createUserInput)
+	s.createSocialAccount(verifier, creatorKey, "test", "twitter")
+	createIssuanceInput := []byte(fmt.Sprintf(`{"path":"issuance/creator/create","data":{"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"%s","max_interest_rate":"1000","debt_issued":"100000","closes_at":%d,"maturity_at":%d}}`,
+		token,
+		closesAt,
+		maturityAt,
+	))
+	s.Require().NoError(s.Tester.DepositERC20(collateral, creator, big.NewInt(10000), createIssuanceInput).Err)
+
+	createInvestorInput := []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"investor"}}`, investor01))
+	s.Tester.Advance(admin, createInvestorInput)
+	createOrderInput := []byte(`{"path":"order/create","data":{"issuance_id":1,"interest_rate":"900"}}`)
+	s.Require().NoError(s.Tester.DepositERC20(token, investor01, big.NewInt(10000), createOrderInput).Err)
+	s.Require().NoError(s.Tester.DepositERC20(token, investor01, big.NewInt(20000), createOrderInput).Err)
+	s.Require().NoError(s.Tester.Advance(investor01, []byte(`{"path":"order/cancel","data":{"id":1}}`)).Err)
+}
+
+func (s *AddressRotationSuite) TestRotateAddress() {
+	admin, token, _, _, _, _, _, _ := s.setupCommonAddresses()
+	investor01, investor02, _, _, _ := s.setupInvestorAddresses()
+	baseTime, _, _ := s.setupTimeValues()
+	newAddress := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	s.setupInvestorWithOrders()
+
+	// the new address cannot belong to another user
+	createUserInput := []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"investor"}}`, investor02))
+	s.Tester.Advance(admin, createUserInput)
+	proposeInput := []byte(fmt.Sprintf(`{"path":"user/rotation/propose","data":{"new_address":"%s"}}`, investor02))
+	proposeOutput := s.Tester.Advance(investor01, proposeInput)
+	s.Error(proposeOutput.Err)
+	s.Contains(string(proposeOutput.Reports[0].Payload), `"code":"ADDRESS_IN_USE"`)
+
+	proposeInput = []byte(fmt.Sprintf(`{"path":"user/rotation/propose","data":{"new_address":"%s"}}`, newAddress))
+	proposeOutput = s.Tester.Advance(investor01, proposeInput)
+	s.NoError(proposeOutput.Err)
+	expectedProposeOutput := fmt.Sprintf(`{"id":1,"user_id":4,"old_address":"%s","new_address":"%s","state":"proposed","requires_approval":false,"approved_by":"%s","created_at":%d,"updated_at":0}`,
+		investor01, newAddress, common.Address{}, baseTime)
+	s.Equal(expectedProposeOutput, s.envelopeData("address_rotation.proposed", proposeOutput.Notices[0].Payload))
+
+	// only the new address can accept
+	acceptInput := []byte(`{"path":"user/rotation/accept","data":{"id":1}}`)
+	acceptOutput := s.Tester.Advance(investor01, acceptInput)
+	s.Error(acceptOutput.Err)
+	s.Contains(string(acceptOutput.Reports[0].Payload), `"code":"ADDRESS_ROTATION_FORBIDDEN"`)
+
+	acceptOutput = s.Tester.Advance(newAddress, acceptInput)
+	s.NoError(acceptOutput.Err)
+	s.Contains(s.envelopeData("address_rotation.accepted", acceptOutput.Notices[0].Payload), `"state":"completed"`)
+
+	// the user, its open order and its wallet moved to the new address
+	findUserOutput := s.Tester.Inspect([]byte(fmt.Sprintf(`{"path":"user/address","data":{"address":"%s"}}`, investor01)))
+	s.Error(findUserOutput.Err)
+	findUserOutput = s.Tester.Inspect([]byte(fmt.Sprintf(`{"path":"user/address","data":{"address":"%s"}}`, newAddress)))
+	s.NoError(findUserOutput.Err)
+	s.Contains(s.envelopeData("user", findUserOutput.Reports[0].Payload), `"id":4`)
+
+	findOrderOutput := s.Tester.Inspect([]byte(`{"path":"order/id","data":{"id":2}}`))
+	s.NoError(findOrderOutput.Err)
+	s.Contains(s.envelopeData("order", findOrderOutput.Reports[0].Payload), fmt.Sprintf(`"address":"%s"`, newAddress))
+
+	balanceOutput := s.Tester.Inspect([]byte(fmt.Sprintf(`{"path":"user/balance","data":{"address":"%s","token":"%s"}}`, newAddress, token)))
+	s.Equal(`"10000"`, s.envelopeData("user.balance", balanceOutput.Reports[0].Payload))
+
+	// the new address now owns the order
+	cancelOrderOutput := s.Tester.Advance(newAddress, []byte(`{"path":"order/cancel","data":{"id":2}}`))
+	s.NoError(cancelOrderOutput.Err)
+
+	findRotationOutput := s.Tester.Inspect([]byte(`{"path":"user/rotation/id","data":{"id":1}}`))
+	s.NoError(findRotationOutput.Err)
+	s.Contains(s.envelopeData("address_rotation", findRotationOutput.Reports[0].Payload), fmt.Sprintf(`"completed_at":%d`, baseTime))
+}
+
+func (s *AddressRotationSuite) TestCancelAddressRotation() {
+	admin, _, _, _, _, _, _, _ := s.setupCommonAddresses()
+	investor01, _, _, _, _ := s.setupInvestorAddresses()
+	newAddress := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+
+	createUserInput := []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"investor"}}`, investor01))
+	s.Tester.Advance(admin, createUserInput)
+	proposeInput := []byte(fmt.Sprintf(`{"path":"user/rotation/propose","data":{"new_address":"%s"}}`, newAddress))
+	s.Require().NoError(s.Tester.Advance(investor01, proposeInput).Err)
+
+	// a user has one pending rotation at most
+	otherProposeInput := []byte(`{"path":"user/rotation/propose","data":{"new_address":"0x00000000000000000000000000000000000000bb"}}`)
+	proposeOutput := s.Tester.Advance(investor01, otherProposeInput)
+	s.Error(proposeOutput.Err)
+	s.Contains(string(proposeOutput.Reports[0].Payload), `"code":"ADDRESS_ROTATION_PENDING"`)
+
+	// only the old address can cancel
+	cancelInput := []byte(`{"path":"user/rotation/cancel","data":{"id":1}}`)
+	cancelOutput := s.Tester.Advance(newAddress, cancelInput)
+	s.Error(cancelOutput.Err)
+	s.Contains(string(cancelOutput.Reports[0].Payload), `"code":"ADDRESS_ROTATION_FORBIDDEN"`)
+
+	cancelOutput = s.Tester.Advance(investor01, cancelInput)
+	s.NoError(cancelOutput.Err)
+	s.Contains(s.envelopeData("address_rotation.canceled", cancelOutput.Notices[0].Payload), `"state":"canceled"`)
+
+	acceptOutput := s.Tester.Advance(newAddress, []byte(`{"path":"user/rotation/accept","data":{"id":1}}`))
+	s.Error(acceptOutput.Err)
+	s.Contains(string(acceptOutput.Reports[0].Payload), `"code":"ADDRESS_ROTATION_STATE"`)
+
+	// once canceled, the user can propose again
+	s.NoError(s.Tester.Advance(investor01, otherProposeInput).Err)
+}
+
+// createStaleRotation stores a second rotation of the user at oldAddress, as proposed while
+// the first one was still pending.
+func (s *DCMRollupSuite) createStaleRotation(oldAddress common.Address, newAddress common.Address, requiresApproval bool) {
+	user, err := s.Repo.FindUserByAddress(Address(oldAddress))
+	s.Require().NoError(err)
+	rotation, err := entity.NewAddressRotation(user.Id, user.Address, Address(newAddress), requiresApproval, user.CreatedAt)
+	s.Require().NoError(err)
+	_, err = s.Repo.CreateAddressRotation(rotation)
+	s.Require().NoError(err)
+}
+
+func (s *AddressRotationSuite) TestAcceptStaleAddressRotation() {
+	admin, _, _, _, _, _, _, _ := s.setupCommonAddresses()
+	investor01, _, _, _, _ := s.setupInvestorAddresses()
+	newAddress := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	staleAddress := common.HexToAddress("0x00000000000000000000000000000000000000bb")
+
+	createUserInput := []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"investor"}}`, investor01))
+	s.Tester.Advance(admin, createUserInput)
+	proposeInput := []byte(fmt.Sprintf(`{"path":"user/rotation/propose","data":{"new_address":"%s"}}`, newAddress))
+	s.Require().NoError(s.Tester.Advance(investor01, proposeInput).Err)
+	s.createStaleRotation(investor01, staleAddress, false)
+
+	s.Require().NoError(s.Tester.Advance(newAddress, []byte(`{"path":"user/rotation/accept","data":{"id":1}}`)).Err)
+
+	// the user no longer holds the address the second rotation moves
+	acceptOutput := s.Tester.Advance(staleAddress, []byte(`{"path":"user/rotation/accept","data":{"id":2}}`))
+	s.Error(acceptOutput.Err)
+	s.Contains(string(acceptOutput.Reports[0].Payload), `"code":"ADDRESS_ROTATION_STALE"`)
+	s.NoError(s.Tester.Inspect([]byte(fmt.Sprintf(`{"path":"user/address","data":{"address":"%s"}}`, newAddress))).Err)
+	s.Error(s.Tester.Inspect([]byte(fmt.Sprintf(`{"path":"user/address","data":{"address":"%s"}}`, staleAddress))).Err)
+}
+
+func TestAddressRotationApprovalSuite(t *testing.T) {
+	suite.Run(t, new(AddressRotationApprovalSuite))
+}
+
+type AddressRotationApprovalSuite struct {
+	DCMRollupSuite
+}
+
+func (s *AddressRotationApprovalSuite) SetupTest() {
+	s.T().Setenv("ADDRESS_ROTATION_APPROVAL", "true")
+	s.DCMRollupSuite.SetupTest()
+}
+
+func (s *AddressRotationApprovalSuite) TestRotateAddressWithApproval() {
+	admin, _, creator, _, _, _, _, _ := s.setupCommonAddresses()
+	newAddress := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+
+	createUserInput := []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"creator"}}`, creator))
+	s.Tester.Advance(admin, createUserInput)
+	proposeInput := []byte(fmt.Sprintf(`{"path":"user/rotation/propose","data":{"new_address":"%s"}}`, newAddress))
+	s.Require().NoError(s.Tester.Advance(creator, proposeInput).Err)
+
+	acceptOutput := s.Tester.Advance(newAddress, []byte(`{"path":"user/rotation/accept","data":{"id":1}}`))
+	s.NoError(acceptOutput.Err)
+	s.Contains(s.envelopeData("address_rotation.accepted", acceptOutput.Notices[0].Payload), `"state":"accepted"`)
+
+	// the user keeps the old address until an admin approves
+	findUserInput := []byte(fmt.Sprintf(`{"path":"user/address","data":{"address":"%s"}}`, creator))
+	s.NoError(s.Tester.Inspect(findUserInput).Err)
+
+	approveInput := []byte(`{"path":"user/admin/approve-rotation","data":{"id":1}}`)
+	approveOutput := s.Tester.Advance(creator, approveInput)
+	s.Error(approveOutput.Err)
+	s.Contains(string(approveOutput.Reports[0].Payload), `"code":"PERMISSION_DENIED"`)
+
+	approveOutput = s.Tester.Advance(admin, approveInput)
+	s.NoError(approveOutput.Err)
+	data := s.envelopeData("address_rotation.approved", approveOutput.Notices[0].Payload)
+	s.Contains(data, `"state":"completed"`)
+	s.Contains(data, fmt.Sprintf(`"approved_by":"%s"`, admin))
+
+	s.Error(s.Tester.Inspect(findUserInput).Err)
+	s.NoError(s.Tester.Inspect([]byte(fmt.Sprintf(`{"path":"user/address","data":{"address":"%s"}}`, newAddress))).Err)
+}
+
+func (s *AddressRotationApprovalSuite) TestApproveStaleAddressRotation() {
+	admin, _, creator, _, _, _, _, _ := s.setupCommonAddresses()
+	newAddress := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	staleAddress := common.HexToAddress("0x00000000000000000000000000000000000000bb")
+
+	createUserInput := []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"creator"}}`, creator))
+	s.Tester.Advance(admin, createUserInput)
+	proposeInput := []byte(fmt.Sprintf(`{"path":"user/rotation/propose","data":{"new_address":"%s"}}`, newAddress))
+	s.Require().NoError(s.Tester.Advance(creator, proposeInput).Err)
+	s.createStaleRotation(creator, staleAddress, true)
+	s.Require().NoError(s.Tester.Advance(newAddress, []byte(`{"path":"user/rotation/accept","data":{"id":1}}`)).Err)
+	s.Require().NoError(s.Tester.Advance(staleAddress, []byte(`{"path":"user/rotation/accept","data":{"id":2}}`)).Err)
+
+	s.Require().NoError(s.Tester.Advance(admin, []byte(`{"path":"user/admin/approve-rotation","data":{"id":1}}`)).Err)
+
+	approveOutput := s.Tester.Advance(admin, []byte(`{"path":"user/admin/approve-rotation","data":{"id":2}}`))
+	s.Error(approveOutput.Err)
+	s.Contains(string(approveOutput.Reports[0].Payload), `"code":"ADDRESS_ROTATION_STALE"`)
+	s.NoError(s.Tester.Inspect([]byte(fmt.Sprintf(`{"path":"user/address","data":{"address":"%s"}}`, newAddress))).Err)
+}
@@ -0,0 +1,73 @@
+package integration
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestAnalyticsSuite(t *testing.T) {
+	suite.Run(t, new(AnalyticsSuite))
+}
+
+type AnalyticsSuite struct {
+	DCMRollupSuite
+}
+
+func (s *AnalyticsSuite) TestAnalytics() {
+	admin, token, creator, _, verifier, collateral, _, _ := s.setupCommonAddresses()
+	investor01, investor02, _, _, _ := s.setupInvestorAddresses()
+	baseTime, closesAt, maturityAt := s.setupTimeValues()
+
+	summaryOutput := s.Tester.Inspect([]byte(`{"path":"analytics/summary"}`))
+	s.Require().NoError(summaryOutput.Err)
+	s.Equal(`{"issuances":{},"raised":[],"average_clearing_rate":0,"default_rate":0,"active_investors":0,"investors":0}`,
+		s.envelopeData("analytics.summary", summaryOutput.Reports[0].Payload))
+
+	createUserInput := []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"creator"}}`, creator))
+	s.Require().NoError(s.Tester.Advance(admin, createUserInput).Err)
+	for _, investor := range []common.Address{investor01, investor02} {
+		createUserInput = []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"investor"}}`, investor))
+		s.Require().NoError(s.Tester.Advance(admin, createUserInput).Err)
+	}
+	s.createSocialAccount(verifier, creatorKey, "test", "twitter")
+
+	createIssuanceInput := []byte(fmt.Sprintf(`{"path":"issuance/creator/create","data":{"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"%s","max_interest_rate":"1000","debt_issued":"100000","closes_at":%d,"maturity_at":%d}}`,
+		token, closesAt, maturityAt))
+	s.Require().NoError(s.Tester.DepositERC20(collateral, creator, big.NewInt(10000), createIssuanceInput).Err)
+
+	createOrderInput := []byte(`{"path":"order/create","data":{"issuance_id":1,"interest_rate":"900"}}`)
+	s.Require().NoError(s.Tester.DepositERC20(token, investor01, big.NewInt(60000), createOrderInput).Err)
+	createOrderInput = []byte(`{"path":"order/create","data":{"issuance_id":1,"interest_rate":"800"}}`)
+	s.Require().NoError(s.Tester.DepositERC20(token, investor02, big.NewInt(50000), createOrderInput).Err)
+
+	s.wait(5)
+
+	anyone := common.HexToAddress("0x0000000000000000000000000000000000000001")
+	closeIssuanceInput := []byte(fmt.Sprintf(`{"path":"issuance/close","data":{"creator_address":"%s"}}`, creator))
+	s.Require().NoError(s.Tester.Advance(anyone, closeIssuanceInput).Err)
+
+	// the issuance cleared at 9% and the admin took 5% of what it raised
+	summaryOutput = s.Tester.Inspect([]byte(`{"path":"analytics/summary"}`))
+	s.Require().NoError(summaryOutput.Err)
+	expectedSummaryOutput := fmt.Sprintf(`{"issuances":{"closed":1},"raised":[{"token":"%s","issuances":1,"amount":"100000","fees":"5000"}],"average_clearing_rate":900,"default_rate":0,"active_investors":2,"investors":2}`,
+		token)
+	s.Equal(expectedSummaryOutput, s.envelopeData("analytics.summary", summaryOutput.Reports[0].Payload))
+
+	// the split remainder of the partially accepted order is not counted as an order
+	day := baseTime / 86400 * 86400
+	seriesOutput := s.Tester.Inspect([]byte(fmt.Sprintf(`{"path":"analytics/series","data":{"interval":"daily","from":%d}}`, baseTime)))
+	s.Require().NoError(seriesOutput.Err)
+	expectedSeriesOutput := fmt.Sprintf(`[{"start":%d,"issuances":1,"orders":2,"investors":2,"volume":[{"token":"%s","amount":"110000"}]}]`, day, token)
+	s.Equal(expectedSeriesOutput, s.envelopeData("analytics.series", seriesOutput.Reports[0].Payload))
+
+	seriesOutput = s.Tester.Inspect([]byte(fmt.Sprintf(`{"path":"analytics/series","data":{"interval":"weekly","to":%d}}`, baseTime-1)))
+	s.Require().NoError(seriesOutput.Err)
+	s.Equal(`[]`, s.envelopeData("analytics.series", seriesOutput.Reports[0].Payload))
+
+	seriesOutput = s.Tester.Inspect([]byte(`{"path":"analytics/series","data":{"interval":"monthly"}}`))
+	s.Error(seriesOutput.Err)
+}
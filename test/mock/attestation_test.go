@@ -0,0 +1,123 @@
+package integration
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestAttestationSuite(t *testing.T) {
+	suite.Run(t, new(AttestationSuite))
+}
+
+type AttestationSuite struct {
+	DCMRollupSuite
+}
+
+var evidenceHash = "0x" + strings.Repeat("ab", 32)
+
+func (s *AttestationSuite) issueAttestation(verifier common.Address, subject common.Address, attestationType string, expiresAt int64) {
+	issueInput := []byte(fmt.Sprintf(`{"path":"attestation/verifier/issue","data":{"address":"%s","type":"%s","evidence_hash":"%s","expires_at":%d}}`,
+		subject, attestationType, evidenceHash, expiresAt))
+	s.Require().NoError(s.Tester.Advance(verifier, issueInput).Err)
+}
+
+func (s *AttestationSuite) TestIssueAndRevokeAttestation() {
+	admin, _, creator, _, verifier, _, _, _ := s.setupCommonAddresses()
+	baseTime, _, maturityAt := s.setupTimeValues()
+
+	createUserInput := []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"creator"}}`, creator))
+	s.Tester.Advance(admin, createUserInput)
+
+	// only verifiers can issue attestations
+	issueInput := []byte(fmt.Sprintf(`{"path":"attestation/verifier/issue","data":{"address":"%s","type":"kyc","evidence_hash":"%s","expires_at":%d}}`,
+		creator, evidenceHash, maturityAt))
+	issueOutput := s.Tester.Advance(admin, issueInput)
+	s.Error(issueOutput.Err)
+	s.Contains(string(issueOutput.Reports[0].Payload), `"code":"PERMISSION_DENIED"`)
+
+	// the evidence must be a 32-byte hash
+	invalidInput := []byte(fmt.Sprintf(`{"path":"attestation/verifier/issue","data":{"address":"%s","type":"kyc","evidence_hash":"0x1234","expires_at":%d}}`,
+		creator, maturityAt))
+	invalidOutput := s.Tester.Advance(verifier, invalidInput)
+	s.Error(invalidOutput.Err)
+	s.Contains(string(invalidOutput.Reports[0].Payload), `"code":"INVALID_ATTESTATION"`)
+
+	issueOutput = s.Tester.Advance(verifier, issueInput)
+	s.NoError(issueOutput.Err)
+	expectedIssueOutput := fmt.Sprintf(`{"id":1,"subject_id":3,"type":"kyc","issuer":"%s","evidence_hash":"%s","expires_at":%d,"revoked_by":"%s","created_at":%d,"updated_at":0}`,
+		verifier, evidenceHash, maturityAt, common.Address{}, baseTime)
+	s.Equal(expectedIssueOutput, s.envelopeData("attestation.issued", issueOutput.Notices[0].Payload))
+
+	findOutput := s.Tester.Inspect([]byte(fmt.Sprintf(`{"path":"attestation/user","data":{"address":"%s"}}`, creator)))
+	s.NoError(findOutput.Err)
+	s.Equal("["+expectedIssueOutput+"]", s.envelopeData("attestation.list", findOutput.Reports[0].Payload))
+
+	revokeInput := []byte(`{"path":"attestation/verifier/revoke","data":{"id":1}}`)
+	revokeOutput := s.Tester.Advance(verifier, revokeInput)
+	s.NoError(revokeOutput.Err)
+	expectedRevokeOutput := fmt.Sprintf(`{"id":1,"subject_id":3,"type":"kyc","issuer":"%s","evidence_hash":"%s","expires_at":%d,"revoked_by":"%s","revoked_at":%d,"created_at":%d,"updated_at":%d}`,
+		verifier, evidenceHash, maturityAt, verifier, baseTime, baseTime, baseTime)
+	s.Equal(expectedRevokeOutput, s.envelopeData("attestation.revoked", revokeOutput.Notices[0].Payload))
+
+	revokeOutput = s.Tester.Advance(verifier, revokeInput)
+	s.Error(revokeOutput.Err)
+	s.Contains(string(revokeOutput.Reports[0].Payload), `"code":"ATTESTATION_REVOKED"`)
+
+	findOutput = s.Tester.Inspect([]byte(`{"path":"attestation/id","data":{"id":1}}`))
+	s.NoError(findOutput.Err)
+	s.Equal(expectedRevokeOutput, s.envelopeData("attestation", findOutput.Reports[0].Payload))
+}
+
+func (s *AttestationSuite) TestIssuanceAttestationRequirements() {
+	admin, token, creator, _, verifier, collateral, _, _ := s.setupCommonAddresses()
+	investor01, _, _, _, _ := s.setupInvestorAddresses()
+	_, closesAt, maturityAt := s.setupTimeValues()
+
+	createUserInput := []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"creator"}}`, creator))
+	s.Tester.Advance(admin, createUserInput)
+	s.createSocialAccount(verifier, creatorKey, "test", "twitter")
+	createInvestorInput := []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"investor"}}`, investor01))
+	s.Tester.Advance(admin, createInvestorInput)
+
+	createIssuanceInput := []byte(fmt.Sprintf(`{"path":"issuance/creator/create","data":{"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"%s","max_interest_rate":"1000","debt_issued":"100000","closes_at":%d,"maturity_at":%d,"required_creator_attestations":["kyc"],"required_investor_attestations":["kyc","accredited_investor"]}}`,
+		token,
+		closesAt,
+		maturityAt,
+	))
+
+	// the creator must hold the attestations it requires from itself
+	createIssuanceOutput := s.Tester.DepositERC20(collateral, creator, big.NewInt(10000), createIssuanceInput)
+	s.Error(createIssuanceOutput.Err)
+	expectedErr := fmt.Sprintf(`"code":"ATTESTATION_REQUIRED","category":"forbidden","message":"failed to create issuance: creator %s lacks a valid kyc attestation"`, creator)
+	s.Contains(string(createIssuanceOutput.Reports[0].Payload), expectedErr)
+
+	s.issueAttestation(verifier, creator, "kyc", maturityAt)
+	createIssuanceOutput = s.Tester.DepositERC20(collateral, creator, big.NewInt(10000), createIssuanceInput)
+	s.NoError(createIssuanceOutput.Err)
+	s.Contains(s.envelopeData("issuance.created", createIssuanceOutput.Notices[0].Payload),
+		`"required_creator_attestations":["kyc"],"required_investor_attestations":["kyc","accredited_investor"]`)
+
+	// the investor must hold every required attestation
+	createOrderInput := []byte(`{"path":"order/create","data":{"issuance_id":1,"interest_rate":"900"}}`)
+	s.issueAttestation(verifier, investor01, "kyc", maturityAt)
+	createOrderOutput := s.Tester.DepositERC20(token, investor01, big.NewInt(10000), createOrderInput)
+	s.Error(createOrderOutput.Err)
+	expectedErr = fmt.Sprintf(`"params":{"party":"investor","type":"accredited_investor","user":"%s"}`, investor01)
+	s.Contains(string(createOrderOutput.Reports[0].Payload), expectedErr)
+
+	s.issueAttestation(verifier, investor01, "accredited_investor", maturityAt)
+	createOrderOutput = s.Tester.DepositERC20(token, investor01, big.NewInt(10000), createOrderInput)
+	s.NoError(createOrderOutput.Err)
+
+	// orders stop once the creator attestation is revoked
+	s.Require().NoError(s.Tester.Advance(verifier, []byte(`{"path":"attestation/verifier/revoke","data":{"id":1}}`)).Err)
+	createOrderOutput = s.Tester.DepositERC20(token, investor01, big.NewInt(10000), createOrderInput)
+	s.Error(createOrderOutput.Err)
+	expectedErr = fmt.Sprintf(`"params":{"party":"creator","type":"kyc","user":"%s"}`, creator)
+	s.Contains(string(createOrderOutput.Reports[0].Payload), expectedErr)
+}
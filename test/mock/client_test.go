@@ -0,0 +1,104 @@
+package integration
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/api"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/client"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/router"
+	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestClientSuite(t *testing.T) {
+	suite.Run(t, new(ClientSuite))
+}
+
+type ClientSuite struct {
+	DCMRollupSuite
+	Client *client.Client
+}
+
+func (s *ClientSuite) SetupTest() {
+	s.DCMRollupSuite.SetupTest()
+	s.Client = client.New(client.NewTesterTransport(s.Tester))
+}
+
+func (s *ClientSuite) TestIssuanceLifecycle() {
+	admin, token, creator, _, verifier, collateral, _, _ := s.setupCommonAddresses()
+	investor01, _, _, _, _ := s.setupInvestorAddresses()
+	_, closesAt, maturityAt := s.setupTimeValues()
+
+	createdCreator, err := s.Client.CreateUser(admin, &api.CreateUserInputDTO{Role: "creator", Address: Address(creator)})
+	s.Require().NoError(err)
+	s.Equal([]string{"creator"}, createdCreator.Roles)
+	s.Equal(Address(creator), createdCreator.Address)
+
+	applied, err := s.Client.FindGenesis()
+	s.Require().NoError(err)
+	s.Equal(uint(0), applied.InputIndex)
+	s.Empty(applied.AllowedTokens)
+
+	challenge, err := s.Client.RequestSocialAccountChallenge(creator, &api.RequestSocialAccountChallengeInputDTO{
+		Username: "test",
+		Platform: "twitter",
+	})
+	s.Require().NoError(err)
+
+	socialAccount, err := s.Client.CreateSocialAccount(verifier, &api.CreateSocialAccountInputDTO{
+		ChallengeId: challenge.Id,
+		PostId:      "1234",
+		ContentHash: crypto.Keccak256Hash([]byte(challenge.Nonce)).Hex(),
+		Signature:   hexutil.Encode(s.signMessage(creatorKey, challenge.Message)),
+	})
+	s.Require().NoError(err)
+	s.Equal(createdCreator.Id, socialAccount.UserId)
+
+	createdIssuance, err := s.Client.CreateIssuance(creator, collateral, big.NewInt(10000), &api.CreateIssuanceInputDTO{
+		Title:           "test",
+		Description:     "testtesttesttesttest",
+		Promotion:       "testtesttesttesttest",
+		Token:           Address(token),
+		DebtIssued:      uint256.NewInt(100000),
+		MaxInterestRate: uint256.NewInt(1000),
+		ClosesAt:        closesAt,
+		MaturityAt:      maturityAt,
+	})
+	s.Require().NoError(err)
+	s.Equal("ongoing", createdIssuance.State)
+
+	_, err = s.Client.CreateUser(admin, &api.CreateUserInputDTO{Role: "investor", Address: Address(investor01)})
+	s.Require().NoError(err)
+
+	placedOrder, err := s.Client.PlaceOrder(investor01, token, big.NewInt(10000), &api.CreateOrderInputDTO{
+		IssuanceId:   createdIssuance.Id,
+		InterestRate: uint256.NewInt(900),
+	})
+	s.Require().NoError(err)
+	s.Equal(createdIssuance.Id, placedOrder.IssuanceId)
+	s.Equal(uint256.NewInt(10000), placedOrder.Amount)
+
+	foundIssuance, err := s.Client.FindIssuanceById(&api.FindIssuanceByIdInputDTO{Id: createdIssuance.Id})
+	s.Require().NoError(err)
+	s.Equal(createdIssuance.Id, foundIssuance.Id)
+	s.Len(foundIssuance.Orders, 1)
+
+	foundOrder, err := s.Client.FindOrderById(&api.FindOrderByIdInputDTO{Id: placedOrder.Id})
+	s.Require().NoError(err)
+	s.Equal(uint256.NewInt(900), foundOrder.InterestRate)
+}
+
+func (s *ClientSuite) TestErrorEnvelope() {
+	_, err := s.Client.FindIssuanceById(&api.FindIssuanceByIdInputDTO{Id: 42})
+	s.Require().Error(err)
+
+	var errorEnvelope *router.ErrorEnvelope
+	s.Require().True(errors.As(err, &errorEnvelope))
+	s.Equal("ISSUANCE_NOT_FOUND", errorEnvelope.Code)
+	s.Equal("not_found", errorEnvelope.Category)
+}
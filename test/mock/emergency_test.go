@@ -5,6 +5,8 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
+	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/suite"
@@ -18,15 +20,42 @@ type EmergencySuite struct {
 	DCMRollupSuite
 }
 
+func (s *EmergencySuite) SetupTest() {
+	s.T().Setenv("EMERGENCY_WITHDRAW_DELAY", "2")
+	s.DCMRollupSuite.SetupTest()
+}
+
 func (s *EmergencySuite) TestEmergencyERC20Withdraw() {
 	admin := common.HexToAddress("0x976EA74026E726554dB657fA54763abd0C3a0aa9")
 	token := common.HexToAddress("0xfafafafafafafafafafafafafafafafafafafafa")
 	to := common.HexToAddress("0x14dC79964da2C08b23698B3D3cc7Ca32193d9955")
+	baseTime, _, _ := s.setupTimeValues()
 
-	// Emergency ERC20 withdraw
+	// Emergency ERC20 withdraw is queued
 	emergencyERC20WithdrawInput := []byte(fmt.Sprintf(`{"path":"user/admin/emergency-erc20-withdraw","data":{"to":"%s","token":"%s"}}`, to.Hex(), token.Hex()))
 	emergencyERC20WithdrawOutput := s.Tester.Advance(admin, emergencyERC20WithdrawInput)
-	s.Len(emergencyERC20WithdrawOutput.DelegateCallVouchers, 1)
+	s.NoError(emergencyERC20WithdrawOutput.Err)
+	s.Len(emergencyERC20WithdrawOutput.DelegateCallVouchers, 0)
+	s.Len(emergencyERC20WithdrawOutput.Notices, 2)
+
+	expectedPending := fmt.Sprintf(`{"id":1,"kind":"erc20","token":"%s","to":"%s","state":"pending","requested_by":"%s","canceled_by":"%s","executed_by":"%s","executable_at":%d,"created_at":%d,"updated_at":0}`,
+		token, to, admin, common.Address{}, common.Address{}, baseTime+2, baseTime)
+	s.Equal(expectedPending, s.envelopeData("emergency_withdrawal.pending", emergencyERC20WithdrawOutput.Notices[0].Payload))
+
+	// Executing before the delay fails
+	executeInput := []byte(`{"path":"emergency/execute","data":{"id":1}}`)
+	executeOutput := s.Tester.Advance(admin, executeInput)
+	s.Error(executeOutput.Err)
+	s.Contains(string(executeOutput.Reports[0].Payload), `"code":"EMERGENCY_WITHDRAWAL_LOCKED"`)
+
+	s.wait(3)
+
+	// Anyone can execute once the delay has passed
+	executeOutput = s.Tester.Advance(to, executeInput)
+	s.NoError(executeOutput.Err)
+	s.Len(executeOutput.DelegateCallVouchers, 1)
+	s.Len(executeOutput.Notices, 2)
+	s.Contains(s.envelopeData("emergency_withdrawal.executed", executeOutput.Notices[0].Payload), `"state":"executed"`)
 
 	// Verify the delegate call voucher payload
 	abiJSON := `[{
@@ -40,20 +69,33 @@ func (s *EmergencySuite) TestEmergencyERC20Withdraw() {
 	abiInterface, err := abi.JSON(strings.NewReader(abiJSON))
 	s.Require().NoError(err)
 
-	unpacked, err := abiInterface.Methods["emergencyERC20Withdraw"].Inputs.Unpack(emergencyERC20WithdrawOutput.DelegateCallVouchers[0].Payload[4:])
+	unpacked, err := abiInterface.Methods["emergencyERC20Withdraw"].Inputs.Unpack(executeOutput.DelegateCallVouchers[0].Payload[4:])
 	s.Require().NoError(err)
 	s.Equal(token, unpacked[0].(common.Address))
 	s.Equal(to, unpacked[1].(common.Address))
+
+	// A withdrawal is executed only once
+	executeOutput = s.Tester.Advance(admin, executeInput)
+	s.Error(executeOutput.Err)
+	s.Contains(string(executeOutput.Reports[0].Payload), `"code":"EMERGENCY_WITHDRAWAL_NOT_PENDING"`)
 }
 
 func (s *EmergencySuite) TestEmergencyEtherWithdraw() {
 	admin := common.HexToAddress("0x976EA74026E726554dB657fA54763abd0C3a0aa9")
 	to := common.HexToAddress("0x14dC79964da2C08b23698B3D3cc7Ca32193d9955")
 
-	// Emergency ETH withdraw
+	// Emergency ETH withdraw is queued
 	emergencyEtherWithdrawInput := []byte(fmt.Sprintf(`{"path":"user/admin/emergency-ether-withdraw","data":{"to":"%s"}}`, to.Hex()))
 	emergencyEtherWithdrawOutput := s.Tester.Advance(admin, emergencyEtherWithdrawInput)
-	s.Len(emergencyEtherWithdrawOutput.DelegateCallVouchers, 1)
+	s.NoError(emergencyEtherWithdrawOutput.Err)
+	s.Len(emergencyEtherWithdrawOutput.DelegateCallVouchers, 0)
+
+	s.wait(3)
+
+	executeInput := []byte(`{"path":"emergency/execute","data":{"id":1}}`)
+	executeOutput := s.Tester.Advance(admin, executeInput)
+	s.NoError(executeOutput.Err)
+	s.Len(executeOutput.DelegateCallVouchers, 1)
 
 	// Verify the delegate call voucher payload
 	abiJSON := `[{
@@ -66,7 +108,99 @@ func (s *EmergencySuite) TestEmergencyEtherWithdraw() {
 	abiInterface, err := abi.JSON(strings.NewReader(abiJSON))
 	s.Require().NoError(err)
 
-	unpacked, err := abiInterface.Methods["emergencyETHWithdraw"].Inputs.Unpack(emergencyEtherWithdrawOutput.DelegateCallVouchers[0].Payload[4:])
+	unpacked, err := abiInterface.Methods["emergencyETHWithdraw"].Inputs.Unpack(executeOutput.DelegateCallVouchers[0].Payload[4:])
 	s.Require().NoError(err)
 	s.Equal(to, unpacked[0].(common.Address))
 }
+
+func (s *EmergencySuite) TestCancelEmergencyWithdrawal() {
+	admin := common.HexToAddress("0x976EA74026E726554dB657fA54763abd0C3a0aa9")
+	to := common.HexToAddress("0x14dC79964da2C08b23698B3D3cc7Ca32193d9955")
+
+	emergencyEtherWithdrawInput := []byte(fmt.Sprintf(`{"path":"user/admin/emergency-ether-withdraw","data":{"to":"%s"}}`, to.Hex()))
+	s.Require().NoError(s.Tester.Advance(admin, emergencyEtherWithdrawInput).Err)
+
+	// Only admins can cancel
+	cancelInput := []byte(`{"path":"emergency/admin/cancel","data":{"id":1}}`)
+	cancelOutput := s.Tester.Advance(to, cancelInput)
+	s.Error(cancelOutput.Err)
+
+	cancelOutput = s.Tester.Advance(admin, cancelInput)
+	s.NoError(cancelOutput.Err)
+	s.Len(cancelOutput.Notices, 2)
+	data := s.envelopeData("emergency_withdrawal.canceled", cancelOutput.Notices[0].Payload)
+	s.Contains(data, `"state":"canceled"`)
+	s.Contains(data, fmt.Sprintf(`"canceled_by":"%s"`, admin))
+
+	s.wait(3)
+
+	// A canceled withdrawal can no longer be executed
+	executeInput := []byte(`{"path":"emergency/execute","data":{"id":1}}`)
+	executeOutput := s.Tester.Advance(admin, executeInput)
+	s.Error(executeOutput.Err)
+	s.Len(executeOutput.DelegateCallVouchers, 0)
+	s.Contains(string(executeOutput.Reports[0].Payload), `"code":"EMERGENCY_WITHDRAWAL_NOT_PENDING"`)
+}
+
+func (s *EmergencySuite) TestCancelEmergencyWithdrawalAfterDelay() {
+	admin := common.HexToAddress("0x976EA74026E726554dB657fA54763abd0C3a0aa9")
+	to := common.HexToAddress("0x14dC79964da2C08b23698B3D3cc7Ca32193d9955")
+
+	emergencyEtherWithdrawInput := []byte(fmt.Sprintf(`{"path":"user/admin/emergency-ether-withdraw","data":{"to":"%s"}}`, to.Hex()))
+	s.Require().NoError(s.Tester.Advance(admin, emergencyEtherWithdrawInput).Err)
+
+	s.wait(3)
+
+	// Once the delay has passed the withdrawal can no longer be canceled
+	cancelOutput := s.Tester.Advance(admin, []byte(`{"path":"emergency/admin/cancel","data":{"id":1}}`))
+	s.Error(cancelOutput.Err)
+	s.Contains(string(cancelOutput.Reports[0].Payload), `"code":"EMERGENCY_WITHDRAWAL_UNLOCKED"`)
+
+	executeOutput := s.Tester.Advance(admin, []byte(`{"path":"emergency/execute","data":{"id":1}}`))
+	s.NoError(executeOutput.Err)
+	s.Len(executeOutput.DelegateCallVouchers, 1)
+}
+
+func (s *EmergencySuite) TestCancelEmergencyWithdrawalBoundary() {
+	admin := Address(common.HexToAddress("0x976EA74026E726554dB657fA54763abd0C3a0aa9"))
+	to := Address(common.HexToAddress("0x14dC79964da2C08b23698B3D3cc7Ca32193d9955"))
+	newWithdrawal := func() *entity.EmergencyWithdrawal {
+		withdrawal, err := entity.NewEmergencyWithdrawal(entity.EmergencyWithdrawalKindEther, Address{}, to, admin, 1100, 1000)
+		s.Require().NoError(err)
+		return withdrawal
+	}
+
+	// The last second of the delay still cancels
+	withdrawal := newWithdrawal()
+	s.NoError(withdrawal.Cancel(admin, 1099))
+	s.Equal(entity.EmergencyWithdrawalStateCanceled, withdrawal.State)
+
+	// From ExecutableAt on the withdrawal stays pending
+	withdrawal = newWithdrawal()
+	s.ErrorIs(withdrawal.Cancel(admin, 1100), entity.ErrEmergencyWithdrawalUnlocked)
+	s.Equal(entity.EmergencyWithdrawalStatePending, withdrawal.State)
+}
+
+func (s *EmergencySuite) TestFindEmergencyWithdrawals() {
+	admin := common.HexToAddress("0x976EA74026E726554dB657fA54763abd0C3a0aa9")
+	to := common.HexToAddress("0x14dC79964da2C08b23698B3D3cc7Ca32193d9955")
+
+	emergencyEtherWithdrawInput := []byte(fmt.Sprintf(`{"path":"user/admin/emergency-ether-withdraw","data":{"to":"%s"}}`, to.Hex()))
+	s.Require().NoError(s.Tester.Advance(admin, emergencyEtherWithdrawInput).Err)
+	s.Require().NoError(s.Tester.Advance(admin, emergencyEtherWithdrawInput).Err)
+	s.Require().NoError(s.Tester.Advance(admin, []byte(`{"path":"emergency/admin/cancel","data":{"id":2}}`)).Err)
+
+	findByIdOutput := s.Tester.Inspect([]byte(`{"path":"emergency/id","data":{"id":2}}`))
+	s.NoError(findByIdOutput.Err)
+	s.Contains(s.envelopeData("emergency_withdrawal", findByIdOutput.Reports[0].Payload), `"state":"canceled"`)
+
+	findAllOutput := s.Tester.Inspect([]byte(`{"path":"emergency"}`))
+	s.NoError(findAllOutput.Err)
+	data := s.envelopeData("emergency_withdrawal.list", findAllOutput.Reports[0].Payload)
+	s.Contains(data, `"id":1,"kind":"ether"`)
+	s.Contains(data, `"id":2,"kind":"ether"`)
+
+	notFoundOutput := s.Tester.Inspect([]byte(`{"path":"emergency/id","data":{"id":3}}`))
+	s.Error(notFoundOutput.Err)
+	s.Contains(string(notFoundOutput.Reports[0].Payload), `"code":"EMERGENCY_WITHDRAWAL_NOT_FOUND"`)
+}
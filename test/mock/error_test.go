@@ -0,0 +1,64 @@
+package integration
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestErrorSuite(t *testing.T) {
+	suite.Run(t, new(ErrorSuite))
+}
+
+type ErrorSuite struct {
+	DCMRollupSuite
+}
+
+func (s *ErrorSuite) TestFindErrorCatalog() {
+	findErrorCatalogOutput := s.Tester.Inspect([]byte(`{"path":"error/catalog"}`))
+	s.NoError(findErrorCatalogOutput.Err)
+	s.Len(findErrorCatalogOutput.Reports, 1)
+
+	var catalog []map[string]any
+	s.NoError(json.Unmarshal([]byte(s.envelopeData("error.catalog", findErrorCatalogOutput.Reports[0].Payload)), &catalog))
+	s.NotEmpty(catalog)
+
+	codes := make(map[string]map[string]any, len(catalog))
+	for _, def := range catalog {
+		codes[def["code"].(string)] = def
+	}
+	s.Equal(map[string]any{
+		"code":     "ISSUANCE_NOT_CLOSED",
+		"category": "conflict",
+		"status":   float64(409),
+		"message":  "issuance {issuance_id} is not closed",
+		"params":   []any{"issuance_id"},
+	}, codes["ISSUANCE_NOT_CLOSED"])
+	s.Contains(codes, "PERMISSION_DENIED")
+	s.Contains(codes, "USER_NOT_FOUND")
+}
+
+func (s *ErrorSuite) TestPermissionDeniedError() {
+	_, _, _, _, verifier, _, _, _ := s.setupCommonAddresses()
+	investor01, _, _, _, _ := s.setupInvestorAddresses()
+
+	// create user without admin role
+	createUserInput := []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"investor"}}`, investor01))
+	createUserOutput := s.Tester.Advance(verifier, createUserInput)
+	s.Error(createUserOutput.Err)
+	s.Len(createUserOutput.Reports, 1)
+
+	expectedCreateUserOutput := fmt.Sprintf(`{"v":1,"type":"error","input_index":%d,"code":"PERMISSION_DENIED","category":"forbidden","message":"user %s lacks required permissions: user.manage","params":{"permissions":"user.manage","user":"%s"}}`, createUserOutput.Index, verifier, verifier)
+	s.Equal(expectedCreateUserOutput, string(createUserOutput.Reports[0].Payload))
+}
+
+func (s *ErrorSuite) TestErrorMessageFollowsParamOrder() {
+	// a value holding a placeholder is filled in by the params declared after it, on every run
+	for range 20 {
+		err := domain.ErrPermissionDenied.New("{permissions}", "user.manage")
+		s.Equal("user user.manage lacks required permissions: user.manage", err.Error())
+	}
+}
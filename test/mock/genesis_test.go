@@ -0,0 +1,170 @@
+package integration
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/genesis"
+	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestGenesisSuite(t *testing.T) {
+	suite.Run(t, new(GenesisSuite))
+}
+
+type GenesisSuite struct {
+	DCMRollupSuite
+	file *genesis.File
+}
+
+// useGenesis restarts the rollup with another genesis file. The inherited tests keep the
+// default one.
+func (s *GenesisSuite) useGenesis(path string) {
+	s.T().Setenv("GENESIS_FILE", path)
+	s.DCMRollupSuite.SetupTest()
+
+	var err error
+	s.file, err = genesis.Load(path)
+	s.Require().NoError(err)
+}
+
+func (s *GenesisSuite) inspectGenesis() *genesis.FindGenesisOutputDTO {
+	output := s.Tester.Inspect([]byte(`{"path":"genesis"}`))
+	s.Require().NoError(output.Err)
+	var res genesis.FindGenesisOutputDTO
+	s.Require().NoError(json.Unmarshal([]byte(s.envelopeData("genesis", output.Reports[0].Payload)), &res))
+	return &res
+}
+
+func (s *GenesisSuite) TestAppliedByFirstInput() {
+	s.useGenesis("testdata/genesis_full.json")
+	admin, token, creator, _, _, collateral, _, _ := s.setupCommonAddresses()
+	investor01, _, _, _, _ := s.setupInvestorAddresses()
+
+	output := s.Tester.Inspect([]byte(`{"path":"genesis"}`))
+	s.Error(output.Err)
+	s.Contains(string(output.Reports[0].Payload), `"code":"GENESIS_NOT_FOUND"`)
+	users, err := s.Repo.FindAllUsers()
+	s.Require().NoError(err)
+	s.Empty(users)
+
+	createUserInput := []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"investor"}}`, investor01))
+	createUserOutput := s.Tester.Advance(admin, createUserInput)
+	s.Require().NoError(createUserOutput.Err)
+	appliedAt := s.Now
+
+	applied := s.inspectGenesis()
+	s.Equal(s.file.Hash, applied.Hash)
+	s.Equal(uint(0), applied.InputIndex)
+	s.Equal(appliedAt, applied.AppliedAt)
+	s.Equal([]string{"0x0000000000000000000000000000000000000008", "0x0000000000000000000000000000000000000009"},
+		[]string{applied.AllowedTokens[0].Hex(), applied.AllowedTokens[1].Hex()})
+
+	users, err = s.Repo.FindAllUsers()
+	s.Require().NoError(err)
+	s.Len(users, 4)
+
+	user, err := s.Repo.FindUserByAddress(Address(creator))
+	s.Require().NoError(err)
+	s.Equal([]string{"creator", "investor"}, user.RoleNames())
+	s.Equal(appliedAt, user.CreatedAt)
+	s.Require().Len(user.SocialAccounts, 1)
+	s.Equal("creator", user.SocialAccounts[0].Username)
+	s.Equal(entity.PlatformTwitter, user.SocialAccounts[0].Platform)
+
+	settings, err := s.Repo.FindAllPlatformSettings()
+	s.Require().NoError(err)
+	s.Require().Len(settings, 1)
+	s.Equal(entity.PlatformTikTok, settings[0].Platform)
+	s.False(settings[0].Enabled)
+
+	// the genesis creator can issue right away, with an allowed token only
+	_, closesAt, maturityAt := s.setupTimeValues()
+	otherToken := common.HexToAddress("0x0000000000000000000000000000000000000010")
+	createIssuanceInput := []byte(fmt.Sprintf(`{"path":"issuance/creator/create","data":{"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"%s","max_interest_rate":"1000","debt_issued":"100000","closes_at":%d,"maturity_at":%d}}`,
+		otherToken, closesAt, maturityAt))
+	createIssuanceOutput := s.Tester.DepositERC20(collateral, creator, big.NewInt(10000), createIssuanceInput)
+	s.Error(createIssuanceOutput.Err)
+	s.Contains(string(createIssuanceOutput.Reports[0].Payload), `"code":"TOKEN_NOT_ALLOWED"`)
+
+	createIssuanceInput = []byte(fmt.Sprintf(`{"path":"issuance/creator/create","data":{"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"%s","max_interest_rate":"1000","debt_issued":"100000","closes_at":%d,"maturity_at":%d}}`,
+		token, closesAt, maturityAt))
+	createIssuanceOutput = s.Tester.DepositERC20(otherToken, creator, big.NewInt(10000), createIssuanceInput)
+	s.Error(createIssuanceOutput.Err)
+	s.Contains(string(createIssuanceOutput.Reports[0].Payload), `"code":"TOKEN_NOT_ALLOWED"`)
+
+	s.Require().NoError(s.Tester.DepositERC20(collateral, creator, big.NewInt(10000), createIssuanceInput).Err)
+
+	// later inputs leave the genesis alone
+	s.Equal(*applied, *s.inspectGenesis())
+}
+
+func (s *GenesisSuite) TestAppliedByRejectedFirstInput() {
+	admin, _, _, _, _, _, _, _ := s.setupCommonAddresses()
+	investor01, _, _, _, _ := s.setupInvestorAddresses()
+
+	inputs := map[string][]byte{
+		"invalid request": []byte(`not a request`),
+		"unknown path":    []byte(`{"path":"unknown","data":{}}`),
+		"rejected input":  []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"unknown"}}`, investor01)),
+	}
+	for name, input := range inputs {
+		s.useGenesis("testdata/genesis_full.json")
+
+		output := s.Tester.Advance(admin, input)
+		s.Error(output.Err, name)
+
+		applied := s.inspectGenesis()
+		s.Equal(s.file.Hash, applied.Hash, name)
+		s.Equal(uint(0), applied.InputIndex, name)
+		s.Equal(s.Now, applied.AppliedAt, name)
+		users, err := s.Repo.FindAllUsers()
+		s.Require().NoError(err)
+		s.Len(users, 3, name)
+
+		// the next input finds the genesis already applied
+		createUserInput := []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"investor"}}`, investor01))
+		s.Require().NoError(s.Tester.Advance(admin, createUserInput).Err, name)
+		s.Equal(*applied, *s.inspectGenesis(), name)
+	}
+}
+
+func (s *GenesisSuite) TestValidate() {
+	admin := `{"address":"0x976EA74026E726554dB657fA54763abd0C3a0aa9","roles":["admin"]}`
+	cases := map[string]string{
+		"no users":                      `{"users":[]}`,
+		"no user has the admin role":    `{"users":[{"address":"0x976EA74026E726554dB657fA54763abd0C3a0aa9","roles":["verifier"]}]}`,
+		"is listed twice":               fmt.Sprintf(`{"users":[%s,%s]}`, admin, admin),
+		"already has role":              `{"users":[{"address":"0x976EA74026E726554dB657fA54763abd0C3a0aa9","roles":["admin","admin"]}]}`,
+		"invalid role":                  `{"users":[{"address":"0x976EA74026E726554dB657fA54763abd0C3a0aa9","roles":["owner"]}]}`,
+		"address cannot be empty":       `{"users":[{"address":"0x0000000000000000000000000000000000000000","roles":["admin"]}]}`,
+		"social account test on github": fmt.Sprintf(`{"users":[%s,{"address":"0x0000000000000000000000000000000000000001","roles":["creator"],"social_accounts":[{"username":"test","platform":"github"},{"username":"Test","platform":"github"}]}]}`, admin),
+		"platform myspace":              fmt.Sprintf(`{"users":[%s],"platforms":[{"platform":"myspace","enabled":true}]}`, admin),
+		"allowed token 0x0000000000000000000000000000000000000009 is listed twice": fmt.Sprintf(`{"users":[%s],"allowed_tokens":["0x0000000000000000000000000000000000000009","0x0000000000000000000000000000000000000009"]}`, admin),
+		"exceeds 10000 basis points": fmt.Sprintf(`{"users":[%s],"issuance_fee":10001}`, admin),
+		"unknown field":              fmt.Sprintf(`{"users":[%s],"fee":1}`, admin),
+	}
+	for reason, content := range cases {
+		_, err := genesis.Decode([]byte(content))
+		s.ErrorIs(err, entity.ErrInvalidGenesis, reason)
+		s.ErrorContains(err, reason)
+	}
+
+	file, err := genesis.Decode([]byte(fmt.Sprintf(`{"users":[%s]}`, admin)))
+	s.Require().NoError(err)
+	s.Nil(file.IssuanceFee)
+	s.Len(file.Hash, 64)
+
+	// the default genesis shipped with the machine is valid too
+	content, err := os.ReadFile(filepath.Join("..", "..", "configs", "genesis.json"))
+	s.Require().NoError(err)
+	_, err = genesis.Decode(content)
+	s.NoError(err)
+}
@@ -0,0 +1,226 @@
+package integration
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestGovernanceSuite(t *testing.T) {
+	suite.Run(t, new(GovernanceSuite))
+}
+
+type GovernanceSuite struct {
+	DCMRollupSuite
+}
+
+func (s *GovernanceSuite) SetupTest() {
+	s.T().Setenv("GOVERNANCE_THRESHOLD", "2")
+	s.T().Setenv("GOVERNANCE_PROPOSAL_TTL", "1")
+	s.DCMRollupSuite.SetupTest()
+}
+
+// setupSecondAdmin creates a user and grants it the admin role
+func (s *GovernanceSuite) setupSecondAdmin() {
+	admin, _, _, _, _, _, _, _ := s.setupCommonAddresses()
+	_, investor02, _, _, _ := s.setupInvestorAddresses()
+
+	createUserInput := []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"investor"}}`, investor02))
+	s.Require().NoError(s.Tester.Advance(admin, createUserInput).Err)
+	grantRoleInput := []byte(fmt.Sprintf(`{"path":"user/admin/grant-role","data":{"address":"%s","role":"admin"}}`, investor02))
+	s.Require().NoError(s.Tester.Advance(admin, grantRoleInput).Err)
+}
+
+func (s *GovernanceSuite) TestProposalExecutesAtThreshold() {
+	admin, _, _, _, _, _, _, _ := s.setupCommonAddresses()
+	investor01, investor02, _, _, _ := s.setupInvestorAddresses()
+	baseTime, _, _ := s.setupTimeValues()
+	s.setupSecondAdmin()
+
+	createUserInput := []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"investor"}}`, investor01))
+	s.Tester.Advance(admin, createUserInput)
+
+	// deleting a user creates a proposal instead
+	deleteUserInput := []byte(fmt.Sprintf(`{"path":"user/admin/delete","data":{"address":"%s"}}`, investor01))
+	deleteUserOutput := s.Tester.Advance(admin, deleteUserInput)
+	s.NoError(deleteUserOutput.Err)
+	s.Len(deleteUserOutput.Notices, 2)
+
+	expectedProposal := fmt.Sprintf(`{"id":1,"path":"user/admin/delete","data":{"address":"%s"},"proposer":"%s","threshold":2,"state":"pending","approvals":[{"proposal_id":1,"approver":"%s","created_at":%d}],"expires_at":%d,"created_at":%d,"updated_at":0}`,
+		investor01, admin, admin, baseTime, baseTime+1, baseTime)
+	s.Equal(expectedProposal, s.envelopeData("proposal.created", deleteUserOutput.Notices[0].Payload))
+
+	findUserInput := []byte(fmt.Sprintf(`{"path":"user/address","data":{"address":"%s"}}`, investor01))
+	s.NoError(s.Tester.Inspect(findUserInput).Err)
+
+	// the proposer cannot approve twice
+	approveInput := []byte(`{"path":"governance/admin/approve","data":{"proposal_id":1}}`)
+	approveOutput := s.Tester.Advance(admin, approveInput)
+	s.Error(approveOutput.Err)
+	s.Contains(string(approveOutput.Reports[0].Payload), `"code":"PROPOSAL_ALREADY_APPROVED"`)
+
+	// the second admin reaches the threshold and runs the input
+	approveOutput = s.Tester.Advance(investor02, approveInput)
+	s.NoError(approveOutput.Err)
+	s.Require().Len(approveOutput.Notices, 3)
+	s.Contains(s.envelopeData("user.deactivated", approveOutput.Notices[0].Payload), `"status":"deactivated"`)
+	s.Contains(s.envelopeData("proposal.executed", approveOutput.Notices[1].Payload), `"state":"executed"`)
+
+	findUserOutput := s.Tester.Inspect(findUserInput)
+	s.NoError(findUserOutput.Err)
+	s.Contains(s.envelopeData("user", findUserOutput.Reports[0].Payload), `"status":"deactivated"`)
+
+	// an executed proposal cannot be approved again
+	approveOutput = s.Tester.Advance(investor02, approveInput)
+	s.Error(approveOutput.Err)
+	s.Contains(string(approveOutput.Reports[0].Payload), `"code":"PROPOSAL_NOT_PENDING"`)
+}
+
+func (s *GovernanceSuite) TestExpiredProposal() {
+	admin, _, _, _, _, _, _, _ := s.setupCommonAddresses()
+	investor01, investor02, _, _, _ := s.setupInvestorAddresses()
+	s.setupSecondAdmin()
+
+	createUserInput := []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"investor"}}`, investor01))
+	s.Tester.Advance(admin, createUserInput)
+
+	deleteUserInput := []byte(fmt.Sprintf(`{"path":"user/admin/delete","data":{"address":"%s"}}`, investor01))
+	s.NoError(s.Tester.Advance(admin, deleteUserInput).Err)
+
+	s.wait(2)
+
+	approveInput := []byte(`{"path":"governance/admin/approve","data":{"proposal_id":1}}`)
+	approveOutput := s.Tester.Advance(investor02, approveInput)
+	s.Error(approveOutput.Err)
+	s.Contains(string(approveOutput.Reports[0].Payload), `"code":"PROPOSAL_EXPIRED"`)
+}
+
+func (s *GovernanceSuite) TestProposerMustKeepPermissions() {
+	admin, _, _, _, _, _, _, _ := s.setupCommonAddresses()
+	investor01, investor02, _, _, _ := s.setupInvestorAddresses()
+	s.setupSecondAdmin()
+
+	createUserInput := []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"investor"}}`, investor01))
+	s.Tester.Advance(admin, createUserInput)
+	deleteUserInput := []byte(fmt.Sprintf(`{"path":"user/admin/delete","data":{"address":"%s"}}`, investor01))
+	s.Require().NoError(s.Tester.Advance(investor02, deleteUserInput).Err)
+
+	revokeRoleInput := []byte(fmt.Sprintf(`{"path":"user/admin/revoke-role","data":{"address":"%s","role":"admin"}}`, investor02))
+	s.Require().NoError(s.Tester.Advance(admin, revokeRoleInput).Err)
+
+	// the proposer is no longer an admin, so its approval does not count
+	approveOutput := s.Tester.Advance(admin, []byte(`{"path":"governance/admin/approve","data":{"proposal_id":1}}`))
+	s.NoError(approveOutput.Err)
+	s.Contains(s.envelopeData("proposal.approved", approveOutput.Notices[0].Payload), `"state":"pending"`)
+
+	findUserOutput := s.Tester.Inspect([]byte(fmt.Sprintf(`{"path":"user/address","data":{"address":"%s"}}`, investor01)))
+	s.NoError(findUserOutput.Err)
+	s.Contains(s.envelopeData("user", findUserOutput.Reports[0].Payload), `"status":"active"`)
+}
+
+func (s *GovernanceSuite) TestRejectsProposalBelowAdminThreshold() {
+	admin, _, _, _, _, _, _, _ := s.setupCommonAddresses()
+	_, investor02, _, _, _ := s.setupInvestorAddresses()
+	s.setupSecondAdmin()
+
+	// deactivating the second admin would leave a single admin to approve proposals
+	deleteUserInput := []byte(fmt.Sprintf(`{"path":"user/admin/delete","data":{"address":"%s"}}`, investor02))
+	s.Require().NoError(s.Tester.Advance(admin, deleteUserInput).Err)
+
+	approveOutput := s.Tester.Advance(investor02, []byte(`{"path":"governance/admin/approve","data":{"proposal_id":1}}`))
+	s.Error(approveOutput.Err)
+	s.Contains(string(approveOutput.Reports[0].Payload), `"code":"ADMINS_BELOW_THRESHOLD"`)
+
+	findUserOutput := s.Tester.Inspect([]byte(fmt.Sprintf(`{"path":"user/address","data":{"address":"%s"}}`, investor02)))
+	s.NoError(findUserOutput.Err)
+	s.Contains(s.envelopeData("user", findUserOutput.Reports[0].Payload), `"status":"active"`)
+	findProposalOutput := s.Tester.Inspect([]byte(`{"path":"governance/proposal/id","data":{"id":1}}`))
+	s.NoError(findProposalOutput.Err)
+	s.Contains(s.envelopeData("proposal", findProposalOutput.Reports[0].Payload), `"state":"pending"`)
+}
+
+func (s *GovernanceSuite) TestApproveRequiresPermission() {
+	admin, _, _, _, _, _, _, _ := s.setupCommonAddresses()
+	investor01, _, _, _, _ := s.setupInvestorAddresses()
+
+	createUserInput := []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"investor"}}`, investor01))
+	s.Tester.Advance(admin, createUserInput)
+
+	approveInput := []byte(`{"path":"governance/admin/approve","data":{"proposal_id":1}}`)
+	approveOutput := s.Tester.Advance(investor01, approveInput)
+	s.Error(approveOutput.Err)
+	s.Contains(string(approveOutput.Reports[0].Payload), `"params":{"permissions":"proposal.approve"`)
+}
+
+func (s *GovernanceSuite) TestFindProposals() {
+	admin, _, _, _, _, _, _, _ := s.setupCommonAddresses()
+
+	emergencyInput := []byte(`{"path":"user/admin/emergency-ether-withdraw","data":{"to":"0x0000000000000000000000000000000000000006"}}`)
+	s.NoError(s.Tester.Advance(admin, emergencyInput).Err)
+
+	findProposalsOutput := s.Tester.Inspect([]byte(`{"path":"governance/proposal"}`))
+	s.NoError(findProposalsOutput.Err)
+	s.Contains(s.envelopeData("proposal.list", findProposalsOutput.Reports[0].Payload), `"path":"user/admin/emergency-ether-withdraw"`)
+
+	findProposalOutput := s.Tester.Inspect([]byte(`{"path":"governance/proposal/id","data":{"id":1}}`))
+	s.NoError(findProposalOutput.Err)
+	s.Contains(s.envelopeData("proposal", findProposalOutput.Reports[0].Payload), `"state":"pending"`)
+}
+
+func TestGovernanceQuorumSuite(t *testing.T) {
+	suite.Run(t, new(GovernanceQuorumSuite))
+}
+
+type GovernanceQuorumSuite struct {
+	DCMRollupSuite
+}
+
+func (s *GovernanceQuorumSuite) SetupTest() {
+	s.T().Setenv("GOVERNANCE_THRESHOLD", "3")
+	s.T().Setenv("GOVERNANCE_PROPOSAL_TTL", "60")
+	s.DCMRollupSuite.SetupTest()
+}
+
+func (s *GovernanceQuorumSuite) TestRevokedApprovalsDoNotCount() {
+	admin, _, _, _, _, _, _, _ := s.setupCommonAddresses()
+	investor01, investor02, investor03, investor04, _ := s.setupInvestorAddresses()
+
+	for _, investor := range []common.Address{investor01, investor02, investor03, investor04} {
+		createUserInput := []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"investor"}}`, investor))
+		s.Require().NoError(s.Tester.Advance(admin, createUserInput).Err)
+	}
+	for _, investor := range []common.Address{investor02, investor03, investor04} {
+		grantRoleInput := []byte(fmt.Sprintf(`{"path":"user/admin/grant-role","data":{"address":"%s","role":"admin"}}`, investor))
+		s.Require().NoError(s.Tester.Advance(admin, grantRoleInput).Err)
+	}
+
+	deleteUserInput := []byte(fmt.Sprintf(`{"path":"user/admin/delete","data":{"address":"%s"}}`, investor01))
+	s.Require().NoError(s.Tester.Advance(admin, deleteUserInput).Err)
+	approveInput := []byte(`{"path":"governance/admin/approve","data":{"proposal_id":1}}`)
+	s.Require().NoError(s.Tester.Advance(investor02, approveInput).Err)
+
+	// the approval of investor02 is withdrawn with its admin role
+	revokeRoleInput := []byte(fmt.Sprintf(`{"path":"user/admin/revoke-role","data":{"address":"%s","role":"admin"}}`, investor02))
+	s.Require().NoError(s.Tester.Advance(admin, revokeRoleInput).Err)
+
+	approveOutput := s.Tester.Advance(investor03, approveInput)
+	s.NoError(approveOutput.Err)
+	s.Contains(s.envelopeData("proposal.approved", approveOutput.Notices[0].Payload), `"state":"pending"`)
+
+	findUserInput := []byte(fmt.Sprintf(`{"path":"user/address","data":{"address":"%s"}}`, investor01))
+	findUserOutput := s.Tester.Inspect(findUserInput)
+	s.NoError(findUserOutput.Err)
+	s.Contains(s.envelopeData("user", findUserOutput.Reports[0].Payload), `"status":"active"`)
+
+	// a third active admin reaches the threshold
+	approveOutput = s.Tester.Advance(investor04, approveInput)
+	s.NoError(approveOutput.Err)
+	s.Require().Len(approveOutput.Notices, 3)
+	s.Contains(s.envelopeData("proposal.executed", approveOutput.Notices[1].Payload), `"state":"executed"`)
+
+	findUserOutput = s.Tester.Inspect(findUserInput)
+	s.NoError(findUserOutput.Err)
+	s.Contains(s.envelopeData("user", findUserOutput.Reports[0].Payload), `"status":"deactivated"`)
+}
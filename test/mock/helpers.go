@@ -2,28 +2,60 @@ package integration
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
 	"log/slog"
 	"os"
 	"time"
 
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/assets"
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/configs"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository/factory"
 	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/rollup"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/genesis"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/router"
+	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/rollmelette/rollmelette"
 	"github.com/stretchr/testify/suite"
 )
 
+// creatorKey is the private key of the creator address, which signs social account challenges
+var creatorKey, _ = crypto.HexToECDSA("59c6995e998f97a5a0044966f0945389dc9e86dae88c7a8412f4603b6b78690d")
+
 // DCMRollupSuite is the base suite for all integration tests
 type DCMRollupSuite struct {
 	suite.Suite
 	Bytecode []byte
 	Tester   *rollmelette.Tester
+	// Repo lets tests reach state no input can produce
+	Repo repository.Repository
+	// Now is the block timestamp of the next advance inputs. It only moves with wait, so the
+	// expected timestamps do not depend on how long the inputs take.
+	Now int64
+}
+
+// clockedApplication dates every advance input with the clock of the suite instead of the wall
+// clock the Tester uses.
+type clockedApplication struct {
+	rollmelette.Application
+	now *int64
 }
 
-// SetupTest initializes the test environment
+func (a *clockedApplication) Advance(env rollmelette.Env, metadata rollmelette.Metadata, deposit rollmelette.Deposit, payload []byte) error {
+	metadata.BlockTimestamp = *a.now
+	return a.Application.Advance(env, metadata, deposit, payload)
+}
+
+// SetupTest initializes the test environment. The genesis lists the test admin and verifier
+// unless the suite sets GENESIS_FILE before.
 func (s *DCMRollupSuite) SetupTest() {
+	if os.Getenv("GENESIS_FILE") == "" {
+		s.T().Setenv("GENESIS_FILE", "testdata/genesis.json")
+	}
 	cfg, err := configs.LoadRollupConfig()
 	if err != nil {
 		slog.Error("Failed to load rollup config", "error", err)
@@ -44,13 +76,27 @@ func (s *DCMRollupSuite) SetupTest() {
 		os.Exit(1)
 	}
 
+	genesisFile, err := genesis.Load(cfg.GenesisFile)
+	if err != nil {
+		slog.Error("Failed to load genesis file", "error", err)
+		os.Exit(1)
+	}
+
 	createInfo := rollup.CreateInfo{
-		Repo:   repo,
-		Config: cfg,
+		Repo:    repo,
+		Config:  cfg,
+		Genesis: genesisFile,
 	}
 
+	s.Repo = repo
+	s.Now = time.Now().Unix()
 	dapp := rollup.Create(&createInfo)
-	s.Tester = rollmelette.NewTester(dapp)
+	s.Tester = rollmelette.NewTester(&clockedApplication{Application: dapp, now: &s.Now})
+}
+
+// wait moves the clock of the suite the given seconds forward.
+func (s *DCMRollupSuite) wait(seconds int64) {
+	s.Now += seconds
 }
 
 // setupCommonAddresses returns common addresses used in tests
@@ -66,7 +112,7 @@ func (s *DCMRollupSuite) setupCommonAddresses() (
 ) {
 	admin = common.HexToAddress("0x976EA74026E726554dB657fA54763abd0C3a0aa9")
 	token = common.HexToAddress("0x0000000000000000000000000000000000000009")
-	creator = common.HexToAddress("0x0000000000000000000000000000000000000007")
+	creator = crypto.PubkeyToAddress(creatorKey.PublicKey)
 	factory = common.HexToAddress("0x0000000000000000000000000000000000000013")
 	verifier = common.HexToAddress("0x0000000000000000000000000000000000000025")
 	safeERC1155MintAddress = common.HexToAddress("0x0000000000000000000000000000000000000007")
@@ -93,8 +139,126 @@ func (s *DCMRollupSuite) setupInvestorAddresses() (
 
 // setupTimeValues returns common time values for tests
 func (s *DCMRollupSuite) setupTimeValues() (baseTime int64, closesAt int64, maturityAt int64) {
-	baseTime = time.Now().Unix()
+	baseTime = s.Now
 	closesAt = baseTime + 5
 	maturityAt = baseTime + 10
 	return
 }
+
+// envelopeData asserts that payload is an output envelope of the given type and returns its raw data
+func (s *DCMRollupSuite) envelopeData(kind string, payload []byte) string {
+	var envelope struct {
+		V    int             `json:"v"`
+		Type string          `json:"type"`
+		Data json.RawMessage `json:"data"`
+	}
+	s.Require().NoError(json.Unmarshal(payload, &envelope), string(payload))
+	s.Equal(router.EnvelopeVersion, envelope.V)
+	s.Equal(kind, envelope.Type)
+	return string(envelope.Data)
+}
+
+// signMessage returns the EIP-191 signature of message by key, as produced by wallets
+func (s *DCMRollupSuite) signMessage(key *ecdsa.PrivateKey, message string) []byte {
+	signature, err := crypto.Sign(accounts.TextHash([]byte(message)), key)
+	s.Require().NoError(err)
+	signature[crypto.RecoveryIDOffset] += 27
+	return signature
+}
+
+// createSocialAccount requests a social account challenge from the owner of key, signs its
+// message and registers the account through the verifier
+func (s *DCMRollupSuite) createSocialAccount(verifier common.Address, key *ecdsa.PrivateKey, username string, platform string) rollmelette.TestAdvanceResult {
+	requestInput := []byte(fmt.Sprintf(`{"path":"social/challenge","data":{"username":"%s","platform":"%s"}}`, username, platform))
+	requestOutput := s.Tester.Advance(crypto.PubkeyToAddress(key.PublicKey), requestInput)
+	s.Require().NoError(requestOutput.Err)
+
+	var challenge struct {
+		Id      uint   `json:"id"`
+		Nonce   string `json:"nonce"`
+		Message string `json:"message"`
+	}
+	s.Require().NoError(json.Unmarshal([]byte(s.envelopeData("social_account.challenge_requested", requestOutput.Notices[0].Payload)), &challenge))
+
+	signature := s.signMessage(key, challenge.Message)
+	createInput := []byte(fmt.Sprintf(`{"path":"social/verifier/create","data":{"challenge_id":%d,"post_id":"post-%d","content_hash":"%s","signature":"0x%x"}}`,
+		challenge.Id, challenge.Id, crypto.Keccak256Hash([]byte(challenge.Nonce)).Hex(), signature))
+	return s.Tester.Advance(verifier, createInput)
+}
+
+// evidenceOf returns the raw evidence of the social account created by output
+func (s *DCMRollupSuite) evidenceOf(output rollmelette.TestAdvanceResult) string {
+	var created struct {
+		Evidence json.RawMessage `json:"evidence"`
+	}
+	s.Require().NoError(json.Unmarshal([]byte(s.envelopeData("social_account.created", output.Notices[0].Payload)), &created))
+	return string(created.Evidence)
+}
+
+func (s *DCMRollupSuite) TestFindSocialAccountById() {
+	admin, _, creator, _, verifier, _, _, _ := s.setupCommonAddresses()
+	baseTime, _, _ := s.setupTimeValues()
+
+	// create creator user first
+	createUserInput := []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"creator"}}`, creator))
+	s.Tester.Advance(admin, createUserInput)
+
+	// create social account
+	evidence := s.evidenceOf(s.createSocialAccount(verifier, creatorKey, "test", "twitter"))
+
+	// find social account by id
+	findSocialAccountByIdInput := []byte(`{"path":"social/id","data":{"social_account_id":1}}`)
+	findSocialAccountByIdOutput := s.Tester.Inspect(findSocialAccountByIdInput)
+	s.Len(findSocialAccountByIdOutput.Reports, 1)
+
+	expectedFindSocialAccountByIdOutput := fmt.Sprintf(`{"id":1,"user_id":3,"username":"test","platform":"twitter","evidence":%s,"created_at":%d,"updated_at":0}`, evidence, baseTime)
+	s.Equal(expectedFindSocialAccountByIdOutput, s.envelopeData("social_account", findSocialAccountByIdOutput.Reports[0].Payload))
+}
+
+func (s *DCMRollupSuite) TestFindSocialAccountsByUserId() {
+	admin, _, creator, _, verifier, _, _, _ := s.setupCommonAddresses()
+	baseTime, _, _ := s.setupTimeValues()
+
+	// create creator user first
+	createUserInput := []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"creator"}}`, creator))
+	s.Tester.Advance(admin, createUserInput)
+
+	// create social account
+	evidence := s.evidenceOf(s.createSocialAccount(verifier, creatorKey, "test", "twitter"))
+
+	// create another social account for the same user
+	otherEvidence := s.evidenceOf(s.createSocialAccount(verifier, creatorKey, "test2", "instagram"))
+
+	// find social accounts by user id
+	findSocialAccountsByUserIdInput := []byte(`{"path":"social/user/id","data":{"user_id":3}}`)
+	findSocialAccountsByUserIdOutput := s.Tester.Inspect(findSocialAccountsByUserIdInput)
+	s.Len(findSocialAccountsByUserIdOutput.Reports, 1)
+
+	expectedFindSocialAccountsByUserIdOutput := fmt.Sprintf(`[{"id":1,"user_id":3,"username":"test","platform":"twitter","evidence":%s,"created_at":%d,"updated_at":0},{"id":2,"user_id":3,"username":"test2","platform":"instagram","evidence":%s,"created_at":%d,"updated_at":0}]`, evidence, baseTime, otherEvidence, baseTime)
+	s.Equal(expectedFindSocialAccountsByUserIdOutput, s.envelopeData("social_account.list", findSocialAccountsByUserIdOutput.Reports[0].Payload))
+}
+
+func (s *DCMRollupSuite) TestDeleteSocialAccount() {
+	admin, _, creator, _, verifier, _, _, _ := s.setupCommonAddresses()
+
+	// create creator user first
+	createUserInput := []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"creator"}}`, creator))
+	s.Tester.Advance(admin, createUserInput)
+
+	// create social account
+	s.createSocialAccount(verifier, creatorKey, "test", "twitter")
+
+	// delete social account
+	deleteSocialAccountInput := []byte(`{"path":"social/admin/delete","data":{"social_account_id":1}}`)
+	deleteSocialAccountOutput := s.Tester.Advance(admin, deleteSocialAccountInput)
+	s.Len(deleteSocialAccountOutput.Notices, 2)
+
+	expectedDeleteSocialAccountOutput := `{"social_account_id":1}`
+	s.Equal(expectedDeleteSocialAccountOutput, s.envelopeData("social_account.deleted", deleteSocialAccountOutput.Notices[0].Payload))
+}
+
+// reputationJSON renders the reputation embedded in creator outputs.
+func reputationJSON(score, issuances, settledOnTime, settledLate, collateralExecutions, cancellations uint64, totalVolume string, updatedAt int64) string {
+	return fmt.Sprintf(`{"score":%d,"issuances":%d,"settled_on_time":%d,"settled_late":%d,"collateral_executions":%d,"cancellations":%d,"total_volume":"%s","updated_at":%d}`,
+		score, issuances, settledOnTime, settledLate, collateralExecutions, cancellations, totalVolume, updatedAt)
+}
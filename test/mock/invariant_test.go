@@ -0,0 +1,135 @@
+package integration
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
+	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+	"github.com/holiman/uint256"
+	"github.com/rollmelette/rollmelette"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestInvariantSuite(t *testing.T) {
+	suite.Run(t, new(InvariantSuite))
+}
+
+type InvariantSuite struct {
+	DCMRollupSuite
+}
+
+// setupIssuanceWithOrder creates an issuance with 10000 of collateral and an order of 60000
+// at 900 from investor01
+func (s *InvariantSuite) setupIssuanceWithOrder() {
+	admin, token, creator, _, verifier, collateral, _, _ := s.setupCommonAddresses()
+	investor01, _, _, _, _ := s.setupInvestorAddresses()
+	_, closesAt, maturityAt := s.setupTimeValues()
+
+	createUserInput := []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"creator"}}`, creator))
+	s.Require().NoError(s.Tester.Advance(admin, createUserInput).Err)
+	createUserInput = []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"investor"}}`, investor01))
+	s.Require().NoError(s.Tester.Advance(admin, createUserInput).Err)
+	s.createSocialAccount(verifier, creatorKey, "test", "twitter")
+
+	createIssuanceInput := []byte(fmt.Sprintf(`{"path":"issuance/creator/create","data":{"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"%s","max_interest_rate":"1000","debt_issued":"100000","closes_at":%d,"maturity_at":%d}}`,
+		token, closesAt, maturityAt))
+	s.Require().NoError(s.Tester.DepositERC20(collateral, creator, big.NewInt(10000), createIssuanceInput).Err)
+
+	createOrderInput := []byte(`{"path":"order/create","data":{"issuance_id":1,"interest_rate":"900"}}`)
+	s.Require().NoError(s.Tester.DepositERC20(token, investor01, big.NewInt(60000), createOrderInput).Err)
+}
+
+// advanceAnything sends an input that is valid on its own and writes no issuance
+func (s *InvariantSuite) advanceAnything() (string, error) {
+	admin, _, _, _, _, _, _, _ := s.setupCommonAddresses()
+	_, _, investor03, _, _ := s.setupInvestorAddresses()
+	createUserInput := []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"investor"}}`, investor03))
+	return s.violations(s.Tester.Advance(admin, createUserInput))
+}
+
+// advanceOnIssuance sends an input that is valid on its own and writes issuance 1, by
+// canceling the order of investor01
+func (s *InvariantSuite) advanceOnIssuance() (string, error) {
+	investor01, _, _, _, _ := s.setupInvestorAddresses()
+	return s.violations(s.Tester.Advance(investor01, []byte(`{"path":"order/cancel","data":{"id":1}}`)))
+}
+
+func (s *InvariantSuite) violations(output rollmelette.TestAdvanceResult) (string, error) {
+	if output.Err == nil {
+		return "", nil
+	}
+	s.Require().Len(output.Reports, 2)
+	s.Contains(string(output.Reports[1].Payload), `"code":"INVARIANT_VIOLATED"`)
+	return s.envelopeData("invariant.violations", output.Reports[0].Payload), output.Err
+}
+
+func (s *InvariantSuite) TestRejectsUncoveredEscrow() {
+	_, token, _, _, _, _, _, _ := s.setupCommonAddresses()
+	investor01, _, _, _, _ := s.setupInvestorAddresses()
+	s.setupIssuanceWithOrder()
+
+	// an order the application never received funds for
+	order, err := entity.NewOrder(1, Address(investor01), uint256.NewInt(5000), uint256.NewInt(800), entity.OrderStatePending, 1)
+	s.Require().NoError(err)
+	_, err = s.Repo.CreateOrder(order)
+	s.Require().NoError(err)
+
+	violations, err := s.advanceAnything()
+	s.Error(err)
+	s.Equal(fmt.Sprintf(`[{"invariant":"escrow_coverage","token":"%s","expected":"65000","actual":"60000"}]`, token), violations)
+}
+
+func (s *InvariantSuite) TestRejectsMismatchedCollateral() {
+	_, _, _, _, _, collateral, _, _ := s.setupCommonAddresses()
+	s.setupIssuanceWithOrder()
+
+	issuance, err := s.Repo.FindIssuanceById(1)
+	s.Require().NoError(err)
+	issuance.CollateralAmount = uint256.NewInt(20000)
+	_, err = s.Repo.UpdateIssuance(issuance)
+	s.Require().NoError(err)
+
+	violations, err := s.advanceOnIssuance()
+	s.Error(err)
+	s.Equal(fmt.Sprintf(`[{"invariant":"collateral_held","token":"%s","issuance_id":1,"expected":"20000","actual":"10000"},`+
+		`{"invariant":"escrow_coverage","token":"%s","expected":"20000","actual":"10000"}]`, collateral, collateral), violations)
+}
+
+func (s *InvariantSuite) TestRejectsUnbackedTotalRaised() {
+	_, token, _, _, _, _, _, _ := s.setupCommonAddresses()
+	s.setupIssuanceWithOrder()
+
+	// a settled issuance whose total raised is not backed by accepted orders
+	issuance, err := s.Repo.FindIssuanceById(1)
+	s.Require().NoError(err)
+	issuance.State = entity.IssuanceStateSettled
+	issuance.TotalRaised = uint256.NewInt(60000)
+	_, err = s.Repo.UpdateIssuance(issuance)
+	s.Require().NoError(err)
+
+	violations, err := s.advanceOnIssuance()
+	s.Error(err)
+	s.Equal(fmt.Sprintf(`[{"invariant":"total_raised","token":"%s","issuance_id":1,"expected":"60000","actual":"0"}]`, token), violations)
+}
+
+func (s *InvariantSuite) TestChecksOnlyWrittenIssuances() {
+	_, _, _, _, _, collateral, _, _ := s.setupCommonAddresses()
+	s.setupIssuanceWithOrder()
+
+	issuance, err := s.Repo.FindIssuanceById(1)
+	s.Require().NoError(err)
+	issuance.CollateralAmount = uint256.NewInt(5000)
+	_, err = s.Repo.UpdateIssuance(issuance)
+	s.Require().NoError(err)
+
+	// an input that does not write the issuance leaves it unchecked
+	violations, err := s.advanceAnything()
+	s.NoError(err)
+	s.Empty(violations)
+
+	violations, err = s.advanceOnIssuance()
+	s.Error(err)
+	s.Equal(fmt.Sprintf(`[{"invariant":"collateral_held","token":"%s","issuance_id":1,"expected":"5000","actual":"10000"}]`, collateral), violations)
+}
@@ -6,7 +6,6 @@ import (
 	"strconv"
 	"strings"
 	"testing"
-	"time"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
@@ -29,18 +28,18 @@ func (s *IssuanceSuite) TestCreateIssuance() {
 	// create creator user
 	createUserInput := []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"creator"}}`, creator))
 	createUserOutput := s.Tester.Advance(admin, createUserInput)
-	s.Len(createUserOutput.Notices, 1)
+	s.Len(createUserOutput.Notices, 2)
 
-	expectedCreateUserOutput := fmt.Sprintf(`user created - {"id":3,"role":"creator","address":"%s","social_accounts":[],"created_at":%d}`, creator, baseTime)
-	s.Equal(expectedCreateUserOutput, string(createUserOutput.Notices[0].Payload))
+	expectedCreateUserOutput := fmt.Sprintf(`{"id":3,"roles":["creator"],"address":"%s","status":"active","social_accounts":[],"created_at":%d}`, creator, baseTime)
+	s.Equal(expectedCreateUserOutput, s.envelopeData("user.created", createUserOutput.Notices[0].Payload))
 
 	// verify social account
-	createSocialAccountInput := []byte(fmt.Sprintf(`{"path":"social/verifier/create","data":{"address":"%s","username":"test","platform":"twitter"}}`, creator))
-	createSocialAccountOutput := s.Tester.Advance(verifier, createSocialAccountInput)
-	s.Len(createSocialAccountOutput.Notices, 1)
+	createSocialAccountOutput := s.createSocialAccount(verifier, creatorKey, "test", "twitter")
+	s.Len(createSocialAccountOutput.Notices, 2)
 
-	expectedCreateSocialAccountOutput := fmt.Sprintf(`social account created - {"id":1,"user_id":3,"username":"test","platform":"twitter","created_at":%d}`, baseTime)
-	s.Equal(expectedCreateSocialAccountOutput, string(createSocialAccountOutput.Notices[0].Payload))
+	expectedCreateSocialAccountOutput := fmt.Sprintf(`{"id":1,"user_id":3,"username":"test","platform":"twitter","evidence":%s,"created_at":%d}`,
+		s.evidenceOf(createSocialAccountOutput), baseTime)
+	s.Equal(expectedCreateSocialAccountOutput, s.envelopeData("social_account.created", createSocialAccountOutput.Notices[0].Payload))
 
 	addressType, _ := abi.NewType("address", "", nil)
 	constructorArgs, err := abi.Arguments{
@@ -50,7 +49,7 @@ func (s *IssuanceSuite) TestCreateIssuance() {
 
 	badgeAddress := crypto.CreateAddress2(
 		factory,
-		common.HexToHash(strconv.Itoa(2)),
+		common.HexToHash(strconv.Itoa(3)),
 		crypto.Keccak256(append(s.Bytecode, constructorArgs...)),
 	)
 
@@ -61,17 +60,18 @@ func (s *IssuanceSuite) TestCreateIssuance() {
 		maturityAt,
 	))
 	createIssuanceOutput := s.Tester.DepositERC20(collateral, creator, big.NewInt(10000), createIssuanceInput)
-	s.Len(createIssuanceOutput.Notices, 1)
+	s.Len(createIssuanceOutput.Notices, 2)
 
-	expectedCreateIssuanceOutput := fmt.Sprintf(`issuance created - {"id":1,"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"%s","creator":{"id":3,"role":"creator","address":"%s","social_accounts":[{"id":1,"user_id":3,"username":"test","platform":"twitter","created_at":%d}],"created_at":%d,"updated_at":0},"collateral":"%s","collateral_amount":"10000","badge_address":"%s","debt_issued":"100000","max_interest_rate":"1000","state":"ongoing","orders":[],"created_at":%d,"closes_at":%d,"maturity_at":%d}`,
+	expectedCreateIssuanceOutput := fmt.Sprintf(`{"id":1,"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"%s","creator":{"id":3,"roles":["creator"],"address":"%s","status":"active","social_accounts":[{"id":1,"user_id":3,"username":"test","platform":"twitter","created_at":%d}],"reputation":%s,"created_at":%d,"updated_at":0},"collateral":"%s","collateral_amount":"10000","badge_address":"%s","debt_issued":"100000","max_interest_rate":"1000","state":"ongoing","orders":[],"created_at":%d,"closes_at":%d,"maturity_at":%d}`,
 		token.Hex(),
 		creator.Hex(),
 		baseTime,
+		reputationJSON(500, 1, 0, 0, 0, 0, "0", baseTime),
 		baseTime,
 		collateral.Hex(),
 		badgeAddress.Hex(),
 		baseTime, closesAt, maturityAt)
-	s.Equal(expectedCreateIssuanceOutput, string(createIssuanceOutput.Notices[0].Payload))
+	s.Equal(expectedCreateIssuanceOutput, s.envelopeData("issuance.created", createIssuanceOutput.Notices[0].Payload))
 
 	s.Len(createIssuanceOutput.Vouchers, 1)
 	s.Equal(factory, createIssuanceOutput.Vouchers[0].Destination)
@@ -92,7 +92,7 @@ func (s *IssuanceSuite) TestCreateIssuance() {
 	s.Require().NoError(err)
 	s.Equal(applicationAddress, unpacked[0])
 	saltBytes := unpacked[1].([32]byte)
-	s.Equal(common.HexToHash(strconv.Itoa(2)), common.BytesToHash(saltBytes[:]))
+	s.Equal(common.HexToHash(strconv.Itoa(3)), common.BytesToHash(saltBytes[:]))
 }
 
 func (s *IssuanceSuite) TestFindAllIssuances() {
@@ -102,18 +102,18 @@ func (s *IssuanceSuite) TestFindAllIssuances() {
 	// create creator user
 	createUserInput := []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"creator"}}`, creator))
 	createUserOutput := s.Tester.Advance(admin, createUserInput)
-	s.Len(createUserOutput.Notices, 1)
+	s.Len(createUserOutput.Notices, 2)
 
-	expectedCreateUserOutput := fmt.Sprintf(`user created - {"id":3,"role":"creator","address":"%s","social_accounts":[],"created_at":%d}`, creator, baseTime)
-	s.Equal(expectedCreateUserOutput, string(createUserOutput.Notices[0].Payload))
+	expectedCreateUserOutput := fmt.Sprintf(`{"id":3,"roles":["creator"],"address":"%s","status":"active","social_accounts":[],"created_at":%d}`, creator, baseTime)
+	s.Equal(expectedCreateUserOutput, s.envelopeData("user.created", createUserOutput.Notices[0].Payload))
 
 	// verify social account
-	createSocialAccountInput := []byte(fmt.Sprintf(`{"path":"social/verifier/create","data":{"address":"%s","username":"test","platform":"twitter"}}`, creator))
-	createSocialAccountOutput := s.Tester.Advance(verifier, createSocialAccountInput)
-	s.Len(createSocialAccountOutput.Notices, 1)
+	createSocialAccountOutput := s.createSocialAccount(verifier, creatorKey, "test", "twitter")
+	s.Len(createSocialAccountOutput.Notices, 2)
 
-	expectedCreateSocialAccountOutput := fmt.Sprintf(`social account created - {"id":1,"user_id":3,"username":"test","platform":"twitter","created_at":%d}`, baseTime)
-	s.Equal(expectedCreateSocialAccountOutput, string(createSocialAccountOutput.Notices[0].Payload))
+	expectedCreateSocialAccountOutput := fmt.Sprintf(`{"id":1,"user_id":3,"username":"test","platform":"twitter","evidence":%s,"created_at":%d}`,
+		s.evidenceOf(createSocialAccountOutput), baseTime)
+	s.Equal(expectedCreateSocialAccountOutput, s.envelopeData("social_account.created", createSocialAccountOutput.Notices[0].Payload))
 
 	addressType, _ := abi.NewType("address", "", nil)
 	constructorArgs, err := abi.Arguments{
@@ -123,7 +123,7 @@ func (s *IssuanceSuite) TestFindAllIssuances() {
 
 	badgeAddress := crypto.CreateAddress2(
 		factory,
-		common.HexToHash(strconv.Itoa(2)),
+		common.HexToHash(strconv.Itoa(3)),
 		crypto.Keccak256(append(s.Bytecode, constructorArgs...)),
 	)
 
@@ -134,17 +134,18 @@ func (s *IssuanceSuite) TestFindAllIssuances() {
 		maturityAt,
 	))
 	createIssuanceOutput := s.Tester.DepositERC20(collateral, creator, big.NewInt(10000), createIssuanceInput)
-	s.Len(createIssuanceOutput.Notices, 1)
+	s.Len(createIssuanceOutput.Notices, 2)
 
-	expectedCreateIssuanceOutput := fmt.Sprintf(`issuance created - {"id":1,"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"%s","creator":{"id":3,"role":"creator","address":"%s","social_accounts":[{"id":1,"user_id":3,"username":"test","platform":"twitter","created_at":%d}],"created_at":%d,"updated_at":0},"collateral":"%s","collateral_amount":"10000","badge_address":"%s","debt_issued":"100000","max_interest_rate":"1000","state":"ongoing","orders":[],"created_at":%d,"closes_at":%d,"maturity_at":%d}`,
+	expectedCreateIssuanceOutput := fmt.Sprintf(`{"id":1,"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"%s","creator":{"id":3,"roles":["creator"],"address":"%s","status":"active","social_accounts":[{"id":1,"user_id":3,"username":"test","platform":"twitter","created_at":%d}],"reputation":%s,"created_at":%d,"updated_at":0},"collateral":"%s","collateral_amount":"10000","badge_address":"%s","debt_issued":"100000","max_interest_rate":"1000","state":"ongoing","orders":[],"created_at":%d,"closes_at":%d,"maturity_at":%d}`,
 		token.Hex(),
 		creator.Hex(),
 		baseTime,
+		reputationJSON(500, 1, 0, 0, 0, 0, "0", baseTime),
 		baseTime,
 		collateral.Hex(),
 		badgeAddress.Hex(),
 		baseTime, closesAt, maturityAt)
-	s.Equal(expectedCreateIssuanceOutput, string(createIssuanceOutput.Notices[0].Payload))
+	s.Equal(expectedCreateIssuanceOutput, s.envelopeData("issuance.created", createIssuanceOutput.Notices[0].Payload))
 
 	s.Len(createIssuanceOutput.Vouchers, 1)
 	s.Equal(factory, createIssuanceOutput.Vouchers[0].Destination)
@@ -165,17 +166,18 @@ func (s *IssuanceSuite) TestFindAllIssuances() {
 	s.Require().NoError(err)
 	s.Equal(applicationAddress, unpacked[0])
 	saltBytes := unpacked[1].([32]byte)
-	s.Equal(common.HexToHash(strconv.Itoa(2)), common.BytesToHash(saltBytes[:]))
+	s.Equal(common.HexToHash(strconv.Itoa(3)), common.BytesToHash(saltBytes[:]))
 
 	findAllIssuancesInput := []byte(`{"path":"issuance"}`)
 
 	findAllIssuancesOutput := s.Tester.Inspect(findAllIssuancesInput)
 	s.Len(findAllIssuancesOutput.Reports, 1)
 
-	expectedFindAllIssuancesOutput := fmt.Sprintf(`[{"id":1,"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"%s","creator":{"id":3,"role":"creator","address":"%s","social_accounts":[{"id":1,"user_id":3,"username":"test","platform":"twitter","created_at":%d}],"created_at":%d,"updated_at":0},"collateral":"%s","collateral_amount":"10000","badge_address":"%s","debt_issued":"100000","max_interest_rate":"1000","total_obligation":"0","total_raised":"0","state":"ongoing","orders":[],"created_at":%d,"closes_at":%d,"maturity_at":%d,"updated_at":0}]`,
+	expectedFindAllIssuancesOutput := fmt.Sprintf(`[{"id":1,"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"%s","creator":{"id":3,"roles":["creator"],"address":"%s","status":"active","social_accounts":[{"id":1,"user_id":3,"username":"test","platform":"twitter","created_at":%d}],"reputation":%s,"created_at":%d,"updated_at":0},"collateral":"%s","collateral_amount":"10000","badge_address":"%s","debt_issued":"100000","max_interest_rate":"1000","total_obligation":"0","total_raised":"0","state":"ongoing","orders":[],"created_at":%d,"closes_at":%d,"maturity_at":%d,"updated_at":0}]`,
 		token.Hex(),
 		creator.Hex(),
 		baseTime,
+		reputationJSON(500, 1, 0, 0, 0, 0, "0", baseTime),
 		baseTime,
 		collateral.Hex(),
 		badgeAddress.Hex(),
@@ -183,7 +185,7 @@ func (s *IssuanceSuite) TestFindAllIssuances() {
 		closesAt,
 		maturityAt,
 	)
-	s.Equal(expectedFindAllIssuancesOutput, string(findAllIssuancesOutput.Reports[0].Payload))
+	s.Equal(expectedFindAllIssuancesOutput, s.envelopeData("issuance.list", findAllIssuancesOutput.Reports[0].Payload))
 }
 
 func (s *IssuanceSuite) TestFindIssuanceById() {
@@ -193,18 +195,18 @@ func (s *IssuanceSuite) TestFindIssuanceById() {
 	// create creator user
 	createUserInput := []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"creator"}}`, creator))
 	createUserOutput := s.Tester.Advance(admin, createUserInput)
-	s.Len(createUserOutput.Notices, 1)
+	s.Len(createUserOutput.Notices, 2)
 
-	expectedCreateUserOutput := fmt.Sprintf(`user created - {"id":3,"role":"creator","address":"%s","social_accounts":[],"created_at":%d}`, creator, baseTime)
-	s.Equal(expectedCreateUserOutput, string(createUserOutput.Notices[0].Payload))
+	expectedCreateUserOutput := fmt.Sprintf(`{"id":3,"roles":["creator"],"address":"%s","status":"active","social_accounts":[],"created_at":%d}`, creator, baseTime)
+	s.Equal(expectedCreateUserOutput, s.envelopeData("user.created", createUserOutput.Notices[0].Payload))
 
 	// verify social account
-	createSocialAccountInput := []byte(fmt.Sprintf(`{"path":"social/verifier/create","data":{"address":"%s","username":"test","platform":"twitter"}}`, creator))
-	createSocialAccountOutput := s.Tester.Advance(verifier, createSocialAccountInput)
-	s.Len(createSocialAccountOutput.Notices, 1)
+	createSocialAccountOutput := s.createSocialAccount(verifier, creatorKey, "test", "twitter")
+	s.Len(createSocialAccountOutput.Notices, 2)
 
-	expectedCreateSocialAccountOutput := fmt.Sprintf(`social account created - {"id":1,"user_id":3,"username":"test","platform":"twitter","created_at":%d}`, baseTime)
-	s.Equal(expectedCreateSocialAccountOutput, string(createSocialAccountOutput.Notices[0].Payload))
+	expectedCreateSocialAccountOutput := fmt.Sprintf(`{"id":1,"user_id":3,"username":"test","platform":"twitter","evidence":%s,"created_at":%d}`,
+		s.evidenceOf(createSocialAccountOutput), baseTime)
+	s.Equal(expectedCreateSocialAccountOutput, s.envelopeData("social_account.created", createSocialAccountOutput.Notices[0].Payload))
 
 	addressType, _ := abi.NewType("address", "", nil)
 	constructorArgs, err := abi.Arguments{
@@ -214,7 +216,7 @@ func (s *IssuanceSuite) TestFindIssuanceById() {
 
 	badgeAddress := crypto.CreateAddress2(
 		factory,
-		common.HexToHash(strconv.Itoa(2)),
+		common.HexToHash(strconv.Itoa(3)),
 		crypto.Keccak256(append(s.Bytecode, constructorArgs...)),
 	)
 
@@ -225,17 +227,18 @@ func (s *IssuanceSuite) TestFindIssuanceById() {
 		maturityAt,
 	))
 	createIssuanceOutput := s.Tester.DepositERC20(collateral, creator, big.NewInt(10000), createIssuanceInput)
-	s.Len(createIssuanceOutput.Notices, 1)
+	s.Len(createIssuanceOutput.Notices, 2)
 
-	expectedCreateIssuanceOutput := fmt.Sprintf(`issuance created - {"id":1,"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"%s","creator":{"id":3,"role":"creator","address":"%s","social_accounts":[{"id":1,"user_id":3,"username":"test","platform":"twitter","created_at":%d}],"created_at":%d,"updated_at":0},"collateral":"%s","collateral_amount":"10000","badge_address":"%s","debt_issued":"100000","max_interest_rate":"1000","state":"ongoing","orders":[],"created_at":%d,"closes_at":%d,"maturity_at":%d}`,
+	expectedCreateIssuanceOutput := fmt.Sprintf(`{"id":1,"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"%s","creator":{"id":3,"roles":["creator"],"address":"%s","status":"active","social_accounts":[{"id":1,"user_id":3,"username":"test","platform":"twitter","created_at":%d}],"reputation":%s,"created_at":%d,"updated_at":0},"collateral":"%s","collateral_amount":"10000","badge_address":"%s","debt_issued":"100000","max_interest_rate":"1000","state":"ongoing","orders":[],"created_at":%d,"closes_at":%d,"maturity_at":%d}`,
 		token.Hex(),
 		creator.Hex(),
 		baseTime,
+		reputationJSON(500, 1, 0, 0, 0, 0, "0", baseTime),
 		baseTime,
 		collateral.Hex(),
 		badgeAddress.Hex(),
 		baseTime, closesAt, maturityAt)
-	s.Equal(expectedCreateIssuanceOutput, string(createIssuanceOutput.Notices[0].Payload))
+	s.Equal(expectedCreateIssuanceOutput, s.envelopeData("issuance.created", createIssuanceOutput.Notices[0].Payload))
 
 	s.Len(createIssuanceOutput.Vouchers, 1)
 	s.Equal(factory, createIssuanceOutput.Vouchers[0].Destination)
@@ -256,22 +259,23 @@ func (s *IssuanceSuite) TestFindIssuanceById() {
 	s.Require().NoError(err)
 	s.Equal(applicationAddress, unpacked[0])
 	saltBytes := unpacked[1].([32]byte)
-	s.Equal(common.HexToHash(strconv.Itoa(2)), common.BytesToHash(saltBytes[:]))
+	s.Equal(common.HexToHash(strconv.Itoa(3)), common.BytesToHash(saltBytes[:]))
 
 	findIssuanceByIdInput := []byte(`{"path":"issuance/id", "data":{"id":1}}`)
 
 	findIssuanceByIdOutput := s.Tester.Inspect(findIssuanceByIdInput)
 	s.Len(findIssuanceByIdOutput.Reports, 1)
 
-	expectedFindIssuanceByIdOutput := fmt.Sprintf(`{"id":1,"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"%s","creator":{"id":3,"role":"creator","address":"%s","social_accounts":[{"id":1,"user_id":3,"username":"test","platform":"twitter","created_at":%d}],"created_at":%d,"updated_at":0},"collateral":"%s","collateral_amount":"10000","badge_address":"%s","debt_issued":"100000","max_interest_rate":"1000","total_obligation":"0","total_raised":"0","state":"ongoing","orders":[],"created_at":%d,"closes_at":%d,"maturity_at":%d,"updated_at":0}`,
+	expectedFindIssuanceByIdOutput := fmt.Sprintf(`{"id":1,"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"%s","creator":{"id":3,"roles":["creator"],"address":"%s","status":"active","social_accounts":[{"id":1,"user_id":3,"username":"test","platform":"twitter","created_at":%d}],"reputation":%s,"created_at":%d,"updated_at":0},"collateral":"%s","collateral_amount":"10000","badge_address":"%s","debt_issued":"100000","max_interest_rate":"1000","total_obligation":"0","total_raised":"0","state":"ongoing","orders":[],"created_at":%d,"closes_at":%d,"maturity_at":%d,"updated_at":0}`,
 		token.Hex(),
 		creator.Hex(),
 		baseTime,
+		reputationJSON(500, 1, 0, 0, 0, 0, "0", baseTime),
 		baseTime,
 		collateral.Hex(),
 		badgeAddress.Hex(),
 		baseTime, closesAt, maturityAt)
-	s.Equal(expectedFindIssuanceByIdOutput, string(findIssuanceByIdOutput.Reports[0].Payload))
+	s.Equal(expectedFindIssuanceByIdOutput, s.envelopeData("issuance", findIssuanceByIdOutput.Reports[0].Payload))
 }
 
 func (s *IssuanceSuite) TestFindIssuancesByCreatorAddress() {
@@ -281,18 +285,18 @@ func (s *IssuanceSuite) TestFindIssuancesByCreatorAddress() {
 	// create creator user
 	createUserInput := []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"creator"}}`, creator))
 	createUserOutput := s.Tester.Advance(admin, createUserInput)
-	s.Len(createUserOutput.Notices, 1)
+	s.Len(createUserOutput.Notices, 2)
 
-	expectedCreateUserOutput := fmt.Sprintf(`user created - {"id":3,"role":"creator","address":"%s","social_accounts":[],"created_at":%d}`, creator, baseTime)
-	s.Equal(expectedCreateUserOutput, string(createUserOutput.Notices[0].Payload))
+	expectedCreateUserOutput := fmt.Sprintf(`{"id":3,"roles":["creator"],"address":"%s","status":"active","social_accounts":[],"created_at":%d}`, creator, baseTime)
+	s.Equal(expectedCreateUserOutput, s.envelopeData("user.created", createUserOutput.Notices[0].Payload))
 
 	// verify social account
-	createSocialAccountInput := []byte(fmt.Sprintf(`{"path":"social/verifier/create","data":{"address":"%s","username":"test","platform":"twitter"}}`, creator))
-	createSocialAccountOutput := s.Tester.Advance(verifier, createSocialAccountInput)
-	s.Len(createSocialAccountOutput.Notices, 1)
+	createSocialAccountOutput := s.createSocialAccount(verifier, creatorKey, "test", "twitter")
+	s.Len(createSocialAccountOutput.Notices, 2)
 
-	expectedCreateSocialAccountOutput := fmt.Sprintf(`social account created - {"id":1,"user_id":3,"username":"test","platform":"twitter","created_at":%d}`, baseTime)
-	s.Equal(expectedCreateSocialAccountOutput, string(createSocialAccountOutput.Notices[0].Payload))
+	expectedCreateSocialAccountOutput := fmt.Sprintf(`{"id":1,"user_id":3,"username":"test","platform":"twitter","evidence":%s,"created_at":%d}`,
+		s.evidenceOf(createSocialAccountOutput), baseTime)
+	s.Equal(expectedCreateSocialAccountOutput, s.envelopeData("social_account.created", createSocialAccountOutput.Notices[0].Payload))
 
 	addressType, _ := abi.NewType("address", "", nil)
 	constructorArgs, err := abi.Arguments{
@@ -302,7 +306,7 @@ func (s *IssuanceSuite) TestFindIssuancesByCreatorAddress() {
 
 	badgeAddress := crypto.CreateAddress2(
 		factory,
-		common.HexToHash(strconv.Itoa(2)),
+		common.HexToHash(strconv.Itoa(3)),
 		crypto.Keccak256(append(s.Bytecode, constructorArgs...)),
 	)
 
@@ -313,17 +317,18 @@ func (s *IssuanceSuite) TestFindIssuancesByCreatorAddress() {
 		maturityAt,
 	))
 	createIssuanceOutput := s.Tester.DepositERC20(collateral, creator, big.NewInt(10000), createIssuanceInput)
-	s.Len(createIssuanceOutput.Notices, 1)
+	s.Len(createIssuanceOutput.Notices, 2)
 
-	expectedCreateIssuanceOutput := fmt.Sprintf(`issuance created - {"id":1,"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"%s","creator":{"id":3,"role":"creator","address":"%s","social_accounts":[{"id":1,"user_id":3,"username":"test","platform":"twitter","created_at":%d}],"created_at":%d,"updated_at":0},"collateral":"%s","collateral_amount":"10000","badge_address":"%s","debt_issued":"100000","max_interest_rate":"1000","state":"ongoing","orders":[],"created_at":%d,"closes_at":%d,"maturity_at":%d}`,
+	expectedCreateIssuanceOutput := fmt.Sprintf(`{"id":1,"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"%s","creator":{"id":3,"roles":["creator"],"address":"%s","status":"active","social_accounts":[{"id":1,"user_id":3,"username":"test","platform":"twitter","created_at":%d}],"reputation":%s,"created_at":%d,"updated_at":0},"collateral":"%s","collateral_amount":"10000","badge_address":"%s","debt_issued":"100000","max_interest_rate":"1000","state":"ongoing","orders":[],"created_at":%d,"closes_at":%d,"maturity_at":%d}`,
 		token.Hex(),
 		creator.Hex(),
 		baseTime,
+		reputationJSON(500, 1, 0, 0, 0, 0, "0", baseTime),
 		baseTime,
 		collateral.Hex(),
 		badgeAddress.Hex(),
 		baseTime, closesAt, maturityAt)
-	s.Equal(expectedCreateIssuanceOutput, string(createIssuanceOutput.Notices[0].Payload))
+	s.Equal(expectedCreateIssuanceOutput, s.envelopeData("issuance.created", createIssuanceOutput.Notices[0].Payload))
 
 	s.Len(createIssuanceOutput.Vouchers, 1)
 	s.Equal(factory, createIssuanceOutput.Vouchers[0].Destination)
@@ -344,17 +349,18 @@ func (s *IssuanceSuite) TestFindIssuancesByCreatorAddress() {
 	s.Require().NoError(err)
 	s.Equal(applicationAddress, unpacked[0])
 	saltBytes := unpacked[1].([32]byte)
-	s.Equal(common.HexToHash(strconv.Itoa(2)), common.BytesToHash(saltBytes[:]))
+	s.Equal(common.HexToHash(strconv.Itoa(3)), common.BytesToHash(saltBytes[:]))
 
 	findIssuancesByCreatorInput := []byte(fmt.Sprintf(`{"path":"issuance/creator", "data":{"creator":"%s"}}`, creator))
 
 	findIssuancesByCreatorOutput := s.Tester.Inspect(findIssuancesByCreatorInput)
 	s.Len(findIssuancesByCreatorOutput.Reports, 1)
 
-	expectedFindIssuancesByCreatorAddressOutput := fmt.Sprintf(`[{"id":1,"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"%s","creator":{"id":3,"role":"creator","address":"%s","social_accounts":[{"id":1,"user_id":3,"username":"test","platform":"twitter","created_at":%d}],"created_at":%d,"updated_at":0},"collateral":"%s","collateral_amount":"10000","badge_address":"%s","debt_issued":"100000","max_interest_rate":"1000","total_obligation":"0","total_raised":"0","state":"ongoing","orders":[],"created_at":%d,"closes_at":%d,"maturity_at":%d,"updated_at":0}]`,
+	expectedFindIssuancesByCreatorAddressOutput := fmt.Sprintf(`[{"id":1,"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"%s","creator":{"id":3,"roles":["creator"],"address":"%s","status":"active","social_accounts":[{"id":1,"user_id":3,"username":"test","platform":"twitter","created_at":%d}],"reputation":%s,"created_at":%d,"updated_at":0},"collateral":"%s","collateral_amount":"10000","badge_address":"%s","debt_issued":"100000","max_interest_rate":"1000","total_obligation":"0","total_raised":"0","state":"ongoing","orders":[],"created_at":%d,"closes_at":%d,"maturity_at":%d,"updated_at":0}]`,
 		token.Hex(),
 		creator.Hex(),
 		baseTime,
+		reputationJSON(500, 1, 0, 0, 0, 0, "0", baseTime),
 		baseTime,
 		collateral.Hex(),
 		badgeAddress.Hex(),
@@ -362,7 +368,7 @@ func (s *IssuanceSuite) TestFindIssuancesByCreatorAddress() {
 		closesAt,
 		maturityAt,
 	)
-	s.Equal(expectedFindIssuancesByCreatorAddressOutput, string(findIssuancesByCreatorOutput.Reports[0].Payload))
+	s.Equal(expectedFindIssuancesByCreatorAddressOutput, s.envelopeData("issuance.list", findIssuancesByCreatorOutput.Reports[0].Payload))
 }
 func (s *IssuanceSuite) TestFindIssuancesByInvestorAddress() {
 	admin, token, creator, factory, verifier, collateral, safeERC1155MintAddress, applicationAddress := s.setupCommonAddresses()
@@ -372,54 +378,54 @@ func (s *IssuanceSuite) TestFindIssuancesByInvestorAddress() {
 	// create creator user
 	createUserInput := []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"creator"}}`, creator))
 	createUserOutput := s.Tester.Advance(admin, createUserInput)
-	s.Len(createUserOutput.Notices, 1)
+	s.Len(createUserOutput.Notices, 2)
 
-	expectedCreateUserOutput := fmt.Sprintf(`user created - {"id":3,"role":"creator","address":"%s","social_accounts":[],"created_at":%d}`, creator, baseTime)
-	s.Equal(expectedCreateUserOutput, string(createUserOutput.Notices[0].Payload))
+	expectedCreateUserOutput := fmt.Sprintf(`{"id":3,"roles":["creator"],"address":"%s","status":"active","social_accounts":[],"created_at":%d}`, creator, baseTime)
+	s.Equal(expectedCreateUserOutput, s.envelopeData("user.created", createUserOutput.Notices[0].Payload))
 
 	// verify social account
-	createSocialAccountInput := []byte(fmt.Sprintf(`{"path":"social/verifier/create","data":{"address":"%s","username":"test","platform":"twitter"}}`, creator))
-	createSocialAccountOutput := s.Tester.Advance(verifier, createSocialAccountInput)
-	s.Len(createSocialAccountOutput.Notices, 1)
+	createSocialAccountOutput := s.createSocialAccount(verifier, creatorKey, "test", "twitter")
+	s.Len(createSocialAccountOutput.Notices, 2)
 
-	expectedCreateSocialAccountOutput := fmt.Sprintf(`social account created - {"id":1,"user_id":3,"username":"test","platform":"twitter","created_at":%d}`, baseTime)
-	s.Equal(expectedCreateSocialAccountOutput, string(createSocialAccountOutput.Notices[0].Payload))
+	expectedCreateSocialAccountOutput := fmt.Sprintf(`{"id":1,"user_id":3,"username":"test","platform":"twitter","evidence":%s,"created_at":%d}`,
+		s.evidenceOf(createSocialAccountOutput), baseTime)
+	s.Equal(expectedCreateSocialAccountOutput, s.envelopeData("social_account.created", createSocialAccountOutput.Notices[0].Payload))
 
 	// create investors users
 	createUserInput = []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"investor"}}`, investor01))
 	createUserOutput = s.Tester.Advance(admin, createUserInput)
-	s.Len(createUserOutput.Notices, 1)
+	s.Len(createUserOutput.Notices, 2)
 
-	expectedCreateUserOutput = fmt.Sprintf(`user created - {"id":4,"role":"investor","address":"%s","social_accounts":[],"created_at":%d}`, investor01, baseTime)
-	s.Equal(expectedCreateUserOutput, string(createUserOutput.Notices[0].Payload))
+	expectedCreateUserOutput = fmt.Sprintf(`{"id":4,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d}`, investor01, baseTime)
+	s.Equal(expectedCreateUserOutput, s.envelopeData("user.created", createUserOutput.Notices[0].Payload))
 
 	createUserInput = []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"investor"}}`, investor02))
 	createUserOutput = s.Tester.Advance(admin, createUserInput)
-	s.Len(createUserOutput.Notices, 1)
+	s.Len(createUserOutput.Notices, 2)
 
-	expectedCreateUserOutput = fmt.Sprintf(`user created - {"id":5,"role":"investor","address":"%s","social_accounts":[],"created_at":%d}`, investor02, baseTime)
-	s.Equal(expectedCreateUserOutput, string(createUserOutput.Notices[0].Payload))
+	expectedCreateUserOutput = fmt.Sprintf(`{"id":5,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d}`, investor02, baseTime)
+	s.Equal(expectedCreateUserOutput, s.envelopeData("user.created", createUserOutput.Notices[0].Payload))
 
 	createUserInput = []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"investor"}}`, investor03))
 	createUserOutput = s.Tester.Advance(admin, createUserInput)
-	s.Len(createUserOutput.Notices, 1)
+	s.Len(createUserOutput.Notices, 2)
 
-	expectedCreateUserOutput = fmt.Sprintf(`user created - {"id":6,"role":"investor","address":"%s","social_accounts":[],"created_at":%d}`, investor03, baseTime)
-	s.Equal(expectedCreateUserOutput, string(createUserOutput.Notices[0].Payload))
+	expectedCreateUserOutput = fmt.Sprintf(`{"id":6,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d}`, investor03, baseTime)
+	s.Equal(expectedCreateUserOutput, s.envelopeData("user.created", createUserOutput.Notices[0].Payload))
 
 	createUserInput = []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"investor"}}`, investor04))
 	createUserOutput = s.Tester.Advance(admin, createUserInput)
-	s.Len(createUserOutput.Notices, 1)
+	s.Len(createUserOutput.Notices, 2)
 
-	expectedCreateUserOutput = fmt.Sprintf(`user created - {"id":7,"role":"investor","address":"%s","social_accounts":[],"created_at":%d}`, investor04, baseTime)
-	s.Equal(expectedCreateUserOutput, string(createUserOutput.Notices[0].Payload))
+	expectedCreateUserOutput = fmt.Sprintf(`{"id":7,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d}`, investor04, baseTime)
+	s.Equal(expectedCreateUserOutput, s.envelopeData("user.created", createUserOutput.Notices[0].Payload))
 
 	createUserInput = []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"investor"}}`, investor05))
 	createUserOutput = s.Tester.Advance(admin, createUserInput)
-	s.Len(createUserOutput.Notices, 1)
+	s.Len(createUserOutput.Notices, 2)
 
-	expectedCreateUserOutput = fmt.Sprintf(`user created - {"id":8,"role":"investor","address":"%s","social_accounts":[],"created_at":%d}`, investor05, baseTime)
-	s.Equal(expectedCreateUserOutput, string(createUserOutput.Notices[0].Payload))
+	expectedCreateUserOutput = fmt.Sprintf(`{"id":8,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d}`, investor05, baseTime)
+	s.Equal(expectedCreateUserOutput, s.envelopeData("user.created", createUserOutput.Notices[0].Payload))
 
 	addressType, _ := abi.NewType("address", "", nil)
 	constructorArgs, err := abi.Arguments{
@@ -429,7 +435,7 @@ func (s *IssuanceSuite) TestFindIssuancesByInvestorAddress() {
 
 	badgeAddress := crypto.CreateAddress2(
 		factory,
-		common.HexToHash(strconv.Itoa(7)),
+		common.HexToHash(strconv.Itoa(8)),
 		crypto.Keccak256(append(s.Bytecode, constructorArgs...)),
 	)
 
@@ -440,17 +446,18 @@ func (s *IssuanceSuite) TestFindIssuancesByInvestorAddress() {
 		maturityAt,
 	))
 	createIssuanceOutput := s.Tester.DepositERC20(collateral, creator, big.NewInt(10000), createIssuanceInput)
-	s.Len(createIssuanceOutput.Notices, 1)
+	s.Len(createIssuanceOutput.Notices, 2)
 
-	expectedCreateIssuanceOutput := fmt.Sprintf(`issuance created - {"id":1,"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"%s","creator":{"id":3,"role":"creator","address":"%s","social_accounts":[{"id":1,"user_id":3,"username":"test","platform":"twitter","created_at":%d}],"created_at":%d,"updated_at":0},"collateral":"%s","collateral_amount":"10000","badge_address":"%s","debt_issued":"100000","max_interest_rate":"1000","state":"ongoing","orders":[],"created_at":%d,"closes_at":%d,"maturity_at":%d}`,
+	expectedCreateIssuanceOutput := fmt.Sprintf(`{"id":1,"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"%s","creator":{"id":3,"roles":["creator"],"address":"%s","status":"active","social_accounts":[{"id":1,"user_id":3,"username":"test","platform":"twitter","created_at":%d}],"reputation":%s,"created_at":%d,"updated_at":0},"collateral":"%s","collateral_amount":"10000","badge_address":"%s","debt_issued":"100000","max_interest_rate":"1000","state":"ongoing","orders":[],"created_at":%d,"closes_at":%d,"maturity_at":%d}`,
 		token.Hex(),
 		creator.Hex(),
 		baseTime,
+		reputationJSON(500, 1, 0, 0, 0, 0, "0", baseTime),
 		baseTime,
 		collateral.Hex(),
 		badgeAddress.Hex(),
 		baseTime, closesAt, maturityAt)
-	s.Equal(expectedCreateIssuanceOutput, string(createIssuanceOutput.Notices[0].Payload))
+	s.Equal(expectedCreateIssuanceOutput, s.envelopeData("issuance.created", createIssuanceOutput.Notices[0].Payload))
 
 	s.Len(createIssuanceOutput.Vouchers, 1)
 	s.Equal(factory, createIssuanceOutput.Vouchers[0].Destination)
@@ -471,46 +478,47 @@ func (s *IssuanceSuite) TestFindIssuancesByInvestorAddress() {
 	s.Require().NoError(err)
 	s.Equal(applicationAddress, unpacked[0])
 	saltBytes := unpacked[1].([32]byte)
-	s.Equal(common.HexToHash(strconv.Itoa(7)), common.BytesToHash(saltBytes[:]))
+	s.Equal(common.HexToHash(strconv.Itoa(8)), common.BytesToHash(saltBytes[:]))
 
 	createOrderInput := []byte(`{"path": "order/create", "data": {"issuance_id":1,"interest_rate":"900"}}`)
 	createOrderOutput := s.Tester.DepositERC20(token, investor01, big.NewInt(60000), createOrderInput)
-	s.Len(createOrderOutput.Notices, 1)
+	s.Len(createOrderOutput.Notices, 2)
 
 	createOrderInput = []byte(`{"path": "order/create", "data": {"issuance_id":1,"interest_rate":"800"}}`)
 	createOrderOutput = s.Tester.DepositERC20(token, investor02, big.NewInt(28000), createOrderInput)
-	s.Len(createOrderOutput.Notices, 1)
+	s.Len(createOrderOutput.Notices, 2)
 
 	createOrderInput = []byte(`{"path": "order/create", "data": {"issuance_id":1,"interest_rate":"400"}}`)
 	createOrderOutput = s.Tester.DepositERC20(token, investor03, big.NewInt(2000), createOrderInput)
-	s.Len(createOrderOutput.Notices, 1)
+	s.Len(createOrderOutput.Notices, 2)
 
 	createOrderInput = []byte(`{"path": "order/create", "data": {"issuance_id":1,"interest_rate":"600"}}`)
 	createOrderOutput = s.Tester.DepositERC20(token, investor04, big.NewInt(5000), createOrderInput)
-	s.Len(createOrderOutput.Notices, 1)
+	s.Len(createOrderOutput.Notices, 2)
 
 	createOrderInput = []byte(`{"path": "order/create", "data": {"issuance_id":1,"interest_rate":"400"}}`)
 	createOrderOutput = s.Tester.DepositERC20(token, investor05, big.NewInt(5500), createOrderInput)
-	s.Len(createOrderOutput.Notices, 1)
+	s.Len(createOrderOutput.Notices, 2)
 
-	time.Sleep(5 * time.Second)
+	s.wait(5)
 
 	anyone := common.HexToAddress("0x0000000000000000000000000000000000000001")
 	closeIssuanceInput := []byte(fmt.Sprintf(`{"path":"issuance/close", "data":{"creator_address":"%s"}}`, creator))
 	closeIssuanceOutput := s.Tester.Advance(anyone, closeIssuanceInput)
-	s.Len(closeIssuanceOutput.Notices, 1)
-
-	expectedCloseIssuanceOutput := fmt.Sprintf(`issuance closed - {"id":1,"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"%s","creator":{"id":3,"role":"creator","address":"%s","social_accounts":[{"id":1,"user_id":3,"username":"test","platform":"twitter","created_at":%d}],"created_at":%d,"updated_at":0},"collateral":"%s","collateral_amount":"10000","badge_address":"%s","debt_issued":"100000","max_interest_rate":"1000","total_obligation":"108195","total_raised":"100000","state":"closed","orders":[`+
-		`{"id":1,"issuance_id":1,"investor":{"id":4,"role":"investor","address":"%s","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"59500","interest_rate":"900","state":"partially_accepted","created_at":%d,"updated_at":%d},`+
-		`{"id":2,"issuance_id":1,"investor":{"id":5,"role":"investor","address":"%s","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"28000","interest_rate":"800","state":"accepted","created_at":%d,"updated_at":%d},`+
-		`{"id":3,"issuance_id":1,"investor":{"id":6,"role":"investor","address":"%s","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"2000","interest_rate":"400","state":"accepted","created_at":%d,"updated_at":%d},`+
-		`{"id":4,"issuance_id":1,"investor":{"id":7,"role":"investor","address":"%s","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"5000","interest_rate":"600","state":"accepted","created_at":%d,"updated_at":%d},`+
-		`{"id":5,"issuance_id":1,"investor":{"id":8,"role":"investor","address":"%s","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"5500","interest_rate":"400","state":"accepted","created_at":%d,"updated_at":%d},`+
-		`{"id":6,"issuance_id":1,"investor":{"id":4,"role":"investor","address":"%s","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"500","interest_rate":"900","state":"rejected","created_at":%d,"updated_at":%d}],`+
+	s.Len(closeIssuanceOutput.Notices, 2)
+
+	expectedCloseIssuanceOutput := fmt.Sprintf(`{"id":1,"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"%s","creator":{"id":3,"roles":["creator"],"address":"%s","status":"active","social_accounts":[{"id":1,"user_id":3,"username":"test","platform":"twitter","created_at":%d}],"reputation":%s,"created_at":%d,"updated_at":0},"collateral":"%s","collateral_amount":"10000","badge_address":"%s","debt_issued":"100000","max_interest_rate":"1000","total_obligation":"108195","total_raised":"100000","state":"closed","orders":[`+
+		`{"id":1,"issuance_id":1,"investor":{"id":4,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"59500","interest_rate":"900","state":"partially_accepted","created_at":%d,"updated_at":%d},`+
+		`{"id":2,"issuance_id":1,"investor":{"id":5,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"28000","interest_rate":"800","state":"accepted","created_at":%d,"updated_at":%d},`+
+		`{"id":3,"issuance_id":1,"investor":{"id":6,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"2000","interest_rate":"400","state":"accepted","created_at":%d,"updated_at":%d},`+
+		`{"id":4,"issuance_id":1,"investor":{"id":7,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"5000","interest_rate":"600","state":"accepted","created_at":%d,"updated_at":%d},`+
+		`{"id":5,"issuance_id":1,"investor":{"id":8,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"5500","interest_rate":"400","state":"accepted","created_at":%d,"updated_at":%d},`+
+		`{"id":6,"issuance_id":1,"investor":{"id":4,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"500","interest_rate":"900","state":"rejected","created_at":%d,"updated_at":%d}],`+
 		`"created_at":%d,"closes_at":%d,"maturity_at":%d,"updated_at":%d}`,
 		token.Hex(),
 		creator.Hex(),
 		baseTime,
+		reputationJSON(500, 1, 0, 0, 0, 0, "100000", closesAt),
 		baseTime,
 		collateral.Hex(),
 		badgeAddress.Hex(),
@@ -521,27 +529,28 @@ func (s *IssuanceSuite) TestFindIssuancesByInvestorAddress() {
 		investor05.Hex(), baseTime, baseTime, closesAt, // Order 5
 		investor01.Hex(), baseTime, baseTime, closesAt, // Order 6 (rejected portion)
 		baseTime, closesAt, maturityAt, closesAt)
-	s.Equal(expectedCloseIssuanceOutput, string(closeIssuanceOutput.Notices[0].Payload))
+	s.Equal(expectedCloseIssuanceOutput, s.envelopeData("issuance.closed", closeIssuanceOutput.Notices[0].Payload))
 
 	// Withdraw raised amount (creator receives 95% of total raised = 95000, 5% goes to admin as fee)
 	withdrawRaisedAmountInput := []byte(fmt.Sprintf(`{"path":"user/withdraw","data":{"token":"%s","amount":"95000"}}`, token.Hex()))
 	withdrawRaisedAmountOutput := s.Tester.Advance(creator, withdrawRaisedAmountInput)
-	s.Len(withdrawRaisedAmountOutput.Notices, 1)
-
-	expectedWithdrawRaisedAmountOutput := fmt.Sprintf(`ERC20 withdrawn - token: %s, amount: 95000, user: %s`, token.Hex(), creator.Hex())
-	s.Equal(expectedWithdrawRaisedAmountOutput, string(withdrawRaisedAmountOutput.Notices[0].Payload))
-
-	expectedFindIssuanceByCreatorOutput := fmt.Sprintf(`[{"id":1,"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"%s","creator":{"id":3,"role":"creator","address":"%s","social_accounts":[{"id":1,"user_id":3,"username":"test","platform":"twitter","created_at":%d}],"created_at":%d,"updated_at":0},"collateral":"%s","collateral_amount":"10000","badge_address":"%s","debt_issued":"100000","max_interest_rate":"1000","total_obligation":"108195","total_raised":"100000","state":"closed","orders":[`+
-		`{"id":1,"issuance_id":1,"investor":{"id":4,"role":"investor","address":"%s","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"59500","interest_rate":"900","state":"partially_accepted","created_at":%d,"updated_at":%d},`+
-		`{"id":2,"issuance_id":1,"investor":{"id":5,"role":"investor","address":"%s","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"28000","interest_rate":"800","state":"accepted","created_at":%d,"updated_at":%d},`+
-		`{"id":3,"issuance_id":1,"investor":{"id":6,"role":"investor","address":"%s","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"2000","interest_rate":"400","state":"accepted","created_at":%d,"updated_at":%d},`+
-		`{"id":4,"issuance_id":1,"investor":{"id":7,"role":"investor","address":"%s","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"5000","interest_rate":"600","state":"accepted","created_at":%d,"updated_at":%d},`+
-		`{"id":5,"issuance_id":1,"investor":{"id":8,"role":"investor","address":"%s","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"5500","interest_rate":"400","state":"accepted","created_at":%d,"updated_at":%d},`+
-		`{"id":6,"issuance_id":1,"investor":{"id":4,"role":"investor","address":"%s","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"500","interest_rate":"900","state":"rejected","created_at":%d,"updated_at":%d}],`+
+	s.Len(withdrawRaisedAmountOutput.Notices, 2)
+
+	expectedWithdrawRaisedAmountOutput := fmt.Sprintf(`{"token":"%s","amount":"95000","user":"%s"}`, token.Hex(), creator.Hex())
+	s.Equal(expectedWithdrawRaisedAmountOutput, s.envelopeData("user.erc20_withdrawn", withdrawRaisedAmountOutput.Notices[0].Payload))
+
+	expectedFindIssuanceByCreatorOutput := fmt.Sprintf(`[{"id":1,"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"%s","creator":{"id":3,"roles":["creator"],"address":"%s","status":"active","social_accounts":[{"id":1,"user_id":3,"username":"test","platform":"twitter","created_at":%d}],"reputation":%s,"created_at":%d,"updated_at":0},"collateral":"%s","collateral_amount":"10000","badge_address":"%s","debt_issued":"100000","max_interest_rate":"1000","total_obligation":"108195","total_raised":"100000","state":"closed","orders":[`+
+		`{"id":1,"issuance_id":1,"investor":{"id":4,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"59500","interest_rate":"900","state":"partially_accepted","created_at":%d,"updated_at":%d},`+
+		`{"id":2,"issuance_id":1,"investor":{"id":5,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"28000","interest_rate":"800","state":"accepted","created_at":%d,"updated_at":%d},`+
+		`{"id":3,"issuance_id":1,"investor":{"id":6,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"2000","interest_rate":"400","state":"accepted","created_at":%d,"updated_at":%d},`+
+		`{"id":4,"issuance_id":1,"investor":{"id":7,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"5000","interest_rate":"600","state":"accepted","created_at":%d,"updated_at":%d},`+
+		`{"id":5,"issuance_id":1,"investor":{"id":8,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"5500","interest_rate":"400","state":"accepted","created_at":%d,"updated_at":%d},`+
+		`{"id":6,"issuance_id":1,"investor":{"id":4,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"500","interest_rate":"900","state":"rejected","created_at":%d,"updated_at":%d}],`+
 		`"created_at":%d,"closes_at":%d,"maturity_at":%d,"updated_at":%d}]`,
 		token.Hex(),
 		creator.Hex(),
 		baseTime,
+		reputationJSON(500, 1, 0, 0, 0, 0, "100000", closesAt),
 		baseTime,
 		collateral.Hex(),
 		badgeAddress.Hex(),
@@ -557,7 +566,7 @@ func (s *IssuanceSuite) TestFindIssuancesByInvestorAddress() {
 
 	findIssuancesByCreatorOutput := s.Tester.Inspect(findIssuancesByCreatorInput)
 	s.Len(findIssuancesByCreatorOutput.Reports, 1)
-	s.Equal(expectedFindIssuanceByCreatorOutput, string(findIssuancesByCreatorOutput.Reports[0].Payload))
+	s.Equal(expectedFindIssuanceByCreatorOutput, s.envelopeData("issuance.list", findIssuancesByCreatorOutput.Reports[0].Payload))
 
 	// Verify that delegate call vouchers were created for badge minting
 	s.Len(closeIssuanceOutput.DelegateCallVouchers, 5)
@@ -578,54 +587,54 @@ func (s *IssuanceSuite) TestCloseIssuance() {
 	// create creator user
 	createUserInput := []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"creator"}}`, creator))
 	createUserOutput := s.Tester.Advance(admin, createUserInput)
-	s.Len(createUserOutput.Notices, 1)
+	s.Len(createUserOutput.Notices, 2)
 
-	expectedCreateUserOutput := fmt.Sprintf(`user created - {"id":3,"role":"creator","address":"%s","social_accounts":[],"created_at":%d}`, creator, baseTime)
-	s.Equal(expectedCreateUserOutput, string(createUserOutput.Notices[0].Payload))
+	expectedCreateUserOutput := fmt.Sprintf(`{"id":3,"roles":["creator"],"address":"%s","status":"active","social_accounts":[],"created_at":%d}`, creator, baseTime)
+	s.Equal(expectedCreateUserOutput, s.envelopeData("user.created", createUserOutput.Notices[0].Payload))
 
 	// verify social account
-	createSocialAccountInput := []byte(fmt.Sprintf(`{"path":"social/verifier/create","data":{"address":"%s","username":"test","platform":"twitter"}}`, creator))
-	createSocialAccountOutput := s.Tester.Advance(verifier, createSocialAccountInput)
-	s.Len(createSocialAccountOutput.Notices, 1)
+	createSocialAccountOutput := s.createSocialAccount(verifier, creatorKey, "test", "twitter")
+	s.Len(createSocialAccountOutput.Notices, 2)
 
-	expectedCreateSocialAccountOutput := fmt.Sprintf(`social account created - {"id":1,"user_id":3,"username":"test","platform":"twitter","created_at":%d}`, baseTime)
-	s.Equal(expectedCreateSocialAccountOutput, string(createSocialAccountOutput.Notices[0].Payload))
+	expectedCreateSocialAccountOutput := fmt.Sprintf(`{"id":1,"user_id":3,"username":"test","platform":"twitter","evidence":%s,"created_at":%d}`,
+		s.evidenceOf(createSocialAccountOutput), baseTime)
+	s.Equal(expectedCreateSocialAccountOutput, s.envelopeData("social_account.created", createSocialAccountOutput.Notices[0].Payload))
 
 	// create investors users
 	createUserInput = []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"investor"}}`, investor01))
 	createUserOutput = s.Tester.Advance(admin, createUserInput)
-	s.Len(createUserOutput.Notices, 1)
+	s.Len(createUserOutput.Notices, 2)
 
-	expectedCreateUserOutput = fmt.Sprintf(`user created - {"id":4,"role":"investor","address":"%s","social_accounts":[],"created_at":%d}`, investor01, baseTime)
-	s.Equal(expectedCreateUserOutput, string(createUserOutput.Notices[0].Payload))
+	expectedCreateUserOutput = fmt.Sprintf(`{"id":4,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d}`, investor01, baseTime)
+	s.Equal(expectedCreateUserOutput, s.envelopeData("user.created", createUserOutput.Notices[0].Payload))
 
 	createUserInput = []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"investor"}}`, investor02))
 	createUserOutput = s.Tester.Advance(admin, createUserInput)
-	s.Len(createUserOutput.Notices, 1)
+	s.Len(createUserOutput.Notices, 2)
 
-	expectedCreateUserOutput = fmt.Sprintf(`user created - {"id":5,"role":"investor","address":"%s","social_accounts":[],"created_at":%d}`, investor02, baseTime)
-	s.Equal(expectedCreateUserOutput, string(createUserOutput.Notices[0].Payload))
+	expectedCreateUserOutput = fmt.Sprintf(`{"id":5,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d}`, investor02, baseTime)
+	s.Equal(expectedCreateUserOutput, s.envelopeData("user.created", createUserOutput.Notices[0].Payload))
 
 	createUserInput = []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"investor"}}`, investor03))
 	createUserOutput = s.Tester.Advance(admin, createUserInput)
-	s.Len(createUserOutput.Notices, 1)
+	s.Len(createUserOutput.Notices, 2)
 
-	expectedCreateUserOutput = fmt.Sprintf(`user created - {"id":6,"role":"investor","address":"%s","social_accounts":[],"created_at":%d}`, investor03, baseTime)
-	s.Equal(expectedCreateUserOutput, string(createUserOutput.Notices[0].Payload))
+	expectedCreateUserOutput = fmt.Sprintf(`{"id":6,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d}`, investor03, baseTime)
+	s.Equal(expectedCreateUserOutput, s.envelopeData("user.created", createUserOutput.Notices[0].Payload))
 
 	createUserInput = []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"investor"}}`, investor04))
 	createUserOutput = s.Tester.Advance(admin, createUserInput)
-	s.Len(createUserOutput.Notices, 1)
+	s.Len(createUserOutput.Notices, 2)
 
-	expectedCreateUserOutput = fmt.Sprintf(`user created - {"id":7,"role":"investor","address":"%s","social_accounts":[],"created_at":%d}`, investor04, baseTime)
-	s.Equal(expectedCreateUserOutput, string(createUserOutput.Notices[0].Payload))
+	expectedCreateUserOutput = fmt.Sprintf(`{"id":7,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d}`, investor04, baseTime)
+	s.Equal(expectedCreateUserOutput, s.envelopeData("user.created", createUserOutput.Notices[0].Payload))
 
 	createUserInput = []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"investor"}}`, investor05))
 	createUserOutput = s.Tester.Advance(admin, createUserInput)
-	s.Len(createUserOutput.Notices, 1)
+	s.Len(createUserOutput.Notices, 2)
 
-	expectedCreateUserOutput = fmt.Sprintf(`user created - {"id":8,"role":"investor","address":"%s","social_accounts":[],"created_at":%d}`, investor05, baseTime)
-	s.Equal(expectedCreateUserOutput, string(createUserOutput.Notices[0].Payload))
+	expectedCreateUserOutput = fmt.Sprintf(`{"id":8,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d}`, investor05, baseTime)
+	s.Equal(expectedCreateUserOutput, s.envelopeData("user.created", createUserOutput.Notices[0].Payload))
 
 	addressType, _ := abi.NewType("address", "", nil)
 	constructorArgs, err := abi.Arguments{
@@ -635,7 +644,7 @@ func (s *IssuanceSuite) TestCloseIssuance() {
 
 	badgeAddress := crypto.CreateAddress2(
 		factory,
-		common.HexToHash(strconv.Itoa(7)),
+		common.HexToHash(strconv.Itoa(8)),
 		crypto.Keccak256(append(s.Bytecode, constructorArgs...)),
 	)
 
@@ -646,17 +655,18 @@ func (s *IssuanceSuite) TestCloseIssuance() {
 		maturityAt,
 	))
 	createIssuanceOutput := s.Tester.DepositERC20(collateral, creator, big.NewInt(10000), createIssuanceInput)
-	s.Len(createIssuanceOutput.Notices, 1)
+	s.Len(createIssuanceOutput.Notices, 2)
 
-	expectedCreateIssuanceOutput := fmt.Sprintf(`issuance created - {"id":1,"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"%s","creator":{"id":3,"role":"creator","address":"%s","social_accounts":[{"id":1,"user_id":3,"username":"test","platform":"twitter","created_at":%d}],"created_at":%d,"updated_at":0},"collateral":"%s","collateral_amount":"10000","badge_address":"%s","debt_issued":"100000","max_interest_rate":"1000","state":"ongoing","orders":[],"created_at":%d,"closes_at":%d,"maturity_at":%d}`,
+	expectedCreateIssuanceOutput := fmt.Sprintf(`{"id":1,"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"%s","creator":{"id":3,"roles":["creator"],"address":"%s","status":"active","social_accounts":[{"id":1,"user_id":3,"username":"test","platform":"twitter","created_at":%d}],"reputation":%s,"created_at":%d,"updated_at":0},"collateral":"%s","collateral_amount":"10000","badge_address":"%s","debt_issued":"100000","max_interest_rate":"1000","state":"ongoing","orders":[],"created_at":%d,"closes_at":%d,"maturity_at":%d}`,
 		token.Hex(),
 		creator.Hex(),
 		baseTime,
+		reputationJSON(500, 1, 0, 0, 0, 0, "0", baseTime),
 		baseTime,
 		collateral.Hex(),
 		badgeAddress.Hex(),
 		baseTime, closesAt, maturityAt)
-	s.Equal(expectedCreateIssuanceOutput, string(createIssuanceOutput.Notices[0].Payload))
+	s.Equal(expectedCreateIssuanceOutput, s.envelopeData("issuance.created", createIssuanceOutput.Notices[0].Payload))
 
 	s.Len(createIssuanceOutput.Vouchers, 1)
 	s.Equal(factory, createIssuanceOutput.Vouchers[0].Destination)
@@ -677,46 +687,47 @@ func (s *IssuanceSuite) TestCloseIssuance() {
 	s.Require().NoError(err)
 	s.Equal(applicationAddress, unpacked[0])
 	saltBytes := unpacked[1].([32]byte)
-	s.Equal(common.HexToHash(strconv.Itoa(7)), common.BytesToHash(saltBytes[:]))
+	s.Equal(common.HexToHash(strconv.Itoa(8)), common.BytesToHash(saltBytes[:]))
 
 	createOrderInput := []byte(`{"path": "order/create", "data": {"issuance_id":1,"interest_rate":"900"}}`)
 	createOrderOutput := s.Tester.DepositERC20(token, investor01, big.NewInt(60000), createOrderInput)
-	s.Len(createOrderOutput.Notices, 1)
+	s.Len(createOrderOutput.Notices, 2)
 
 	createOrderInput = []byte(`{"path": "order/create", "data": {"issuance_id":1,"interest_rate":"800"}}`)
 	createOrderOutput = s.Tester.DepositERC20(token, investor02, big.NewInt(28000), createOrderInput)
-	s.Len(createOrderOutput.Notices, 1)
+	s.Len(createOrderOutput.Notices, 2)
 
 	createOrderInput = []byte(`{"path": "order/create", "data": {"issuance_id":1,"interest_rate":"400"}}`)
 	createOrderOutput = s.Tester.DepositERC20(token, investor03, big.NewInt(2000), createOrderInput)
-	s.Len(createOrderOutput.Notices, 1)
+	s.Len(createOrderOutput.Notices, 2)
 
 	createOrderInput = []byte(`{"path": "order/create", "data": {"issuance_id":1,"interest_rate":"600"}}`)
 	createOrderOutput = s.Tester.DepositERC20(token, investor04, big.NewInt(5000), createOrderInput)
-	s.Len(createOrderOutput.Notices, 1)
+	s.Len(createOrderOutput.Notices, 2)
 
 	createOrderInput = []byte(`{"path": "order/create", "data": {"issuance_id":1,"interest_rate":"400"}}`)
 	createOrderOutput = s.Tester.DepositERC20(token, investor05, big.NewInt(5500), createOrderInput)
-	s.Len(createOrderOutput.Notices, 1)
+	s.Len(createOrderOutput.Notices, 2)
 
-	time.Sleep(5 * time.Second)
+	s.wait(5)
 
 	anyone := common.HexToAddress("0x0000000000000000000000000000000000000001")
 	closeIssuanceInput := []byte(fmt.Sprintf(`{"path":"issuance/close", "data":{"creator_address":"%s"}}`, creator))
 	closeIssuanceOutput := s.Tester.Advance(anyone, closeIssuanceInput)
-	s.Len(closeIssuanceOutput.Notices, 1)
-
-	expectedCloseIssuanceOutput := fmt.Sprintf(`issuance closed - {"id":1,"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"%s","creator":{"id":3,"role":"creator","address":"%s","social_accounts":[{"id":1,"user_id":3,"username":"test","platform":"twitter","created_at":%d}],"created_at":%d,"updated_at":0},"collateral":"%s","collateral_amount":"10000","badge_address":"%s","debt_issued":"100000","max_interest_rate":"1000","total_obligation":"108195","total_raised":"100000","state":"closed","orders":[`+
-		`{"id":1,"issuance_id":1,"investor":{"id":4,"role":"investor","address":"%s","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"59500","interest_rate":"900","state":"partially_accepted","created_at":%d,"updated_at":%d},`+
-		`{"id":2,"issuance_id":1,"investor":{"id":5,"role":"investor","address":"%s","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"28000","interest_rate":"800","state":"accepted","created_at":%d,"updated_at":%d},`+
-		`{"id":3,"issuance_id":1,"investor":{"id":6,"role":"investor","address":"%s","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"2000","interest_rate":"400","state":"accepted","created_at":%d,"updated_at":%d},`+
-		`{"id":4,"issuance_id":1,"investor":{"id":7,"role":"investor","address":"%s","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"5000","interest_rate":"600","state":"accepted","created_at":%d,"updated_at":%d},`+
-		`{"id":5,"issuance_id":1,"investor":{"id":8,"role":"investor","address":"%s","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"5500","interest_rate":"400","state":"accepted","created_at":%d,"updated_at":%d},`+
-		`{"id":6,"issuance_id":1,"investor":{"id":4,"role":"investor","address":"%s","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"500","interest_rate":"900","state":"rejected","created_at":%d,"updated_at":%d}],`+
+	s.Len(closeIssuanceOutput.Notices, 2)
+
+	expectedCloseIssuanceOutput := fmt.Sprintf(`{"id":1,"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"%s","creator":{"id":3,"roles":["creator"],"address":"%s","status":"active","social_accounts":[{"id":1,"user_id":3,"username":"test","platform":"twitter","created_at":%d}],"reputation":%s,"created_at":%d,"updated_at":0},"collateral":"%s","collateral_amount":"10000","badge_address":"%s","debt_issued":"100000","max_interest_rate":"1000","total_obligation":"108195","total_raised":"100000","state":"closed","orders":[`+
+		`{"id":1,"issuance_id":1,"investor":{"id":4,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"59500","interest_rate":"900","state":"partially_accepted","created_at":%d,"updated_at":%d},`+
+		`{"id":2,"issuance_id":1,"investor":{"id":5,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"28000","interest_rate":"800","state":"accepted","created_at":%d,"updated_at":%d},`+
+		`{"id":3,"issuance_id":1,"investor":{"id":6,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"2000","interest_rate":"400","state":"accepted","created_at":%d,"updated_at":%d},`+
+		`{"id":4,"issuance_id":1,"investor":{"id":7,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"5000","interest_rate":"600","state":"accepted","created_at":%d,"updated_at":%d},`+
+		`{"id":5,"issuance_id":1,"investor":{"id":8,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"5500","interest_rate":"400","state":"accepted","created_at":%d,"updated_at":%d},`+
+		`{"id":6,"issuance_id":1,"investor":{"id":4,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"500","interest_rate":"900","state":"rejected","created_at":%d,"updated_at":%d}],`+
 		`"created_at":%d,"closes_at":%d,"maturity_at":%d,"updated_at":%d}`,
 		token.Hex(),
 		creator.Hex(),
 		baseTime,
+		reputationJSON(500, 1, 0, 0, 0, 0, "100000", closesAt),
 		baseTime,
 		collateral.Hex(),
 		badgeAddress.Hex(),
@@ -727,7 +738,7 @@ func (s *IssuanceSuite) TestCloseIssuance() {
 		investor05.Hex(), baseTime, baseTime, closesAt,
 		investor01.Hex(), baseTime, baseTime, closesAt,
 		baseTime, closesAt, maturityAt, closesAt)
-	s.Equal(expectedCloseIssuanceOutput, string(closeIssuanceOutput.Notices[0].Payload))
+	s.Equal(expectedCloseIssuanceOutput, s.envelopeData("issuance.closed", closeIssuanceOutput.Notices[0].Payload))
 
 	// Verify final balances after issuance close
 	// investor01: deposited 60000, partially accepted 59500, rejected 500
@@ -741,43 +752,43 @@ func (s *IssuanceSuite) TestCloseIssuance() {
 	erc20BalanceInput := []byte(fmt.Sprintf(`{"path":"user/balance","data":{"address":"%s","token":"%s"}}`, investor01.Hex(), token.Hex()))
 	erc20BalanceOutput := s.Tester.Inspect(erc20BalanceInput)
 	s.Len(erc20BalanceOutput.Reports, 1)
-	s.Equal(`"500"`, string(erc20BalanceOutput.Reports[0].Payload))
+	s.Equal(`"500"`, s.envelopeData("user.balance", erc20BalanceOutput.Reports[0].Payload))
 
 	// Verify investor02 balance (28000 - 28000 = 0)
 	erc20BalanceInput = []byte(fmt.Sprintf(`{"path":"user/balance","data":{"address":"%s","token":"%s"}}`, investor02.Hex(), token.Hex()))
 	erc20BalanceOutput = s.Tester.Inspect(erc20BalanceInput)
 	s.Len(erc20BalanceOutput.Reports, 1)
-	s.Equal(`"0"`, string(erc20BalanceOutput.Reports[0].Payload))
+	s.Equal(`"0"`, s.envelopeData("user.balance", erc20BalanceOutput.Reports[0].Payload))
 
 	// Verify investor03 balance (2000 - 2000 = 0)
 	erc20BalanceInput = []byte(fmt.Sprintf(`{"path":"user/balance","data":{"address":"%s","token":"%s"}}`, investor03.Hex(), token.Hex()))
 	erc20BalanceOutput = s.Tester.Inspect(erc20BalanceInput)
 	s.Len(erc20BalanceOutput.Reports, 1)
-	s.Equal(`"0"`, string(erc20BalanceOutput.Reports[0].Payload))
+	s.Equal(`"0"`, s.envelopeData("user.balance", erc20BalanceOutput.Reports[0].Payload))
 
 	// Verify investor04 balance (5000 - 5000 = 0)
 	erc20BalanceInput = []byte(fmt.Sprintf(`{"path":"user/balance","data":{"address":"%s","token":"%s"}}`, investor04.Hex(), token.Hex()))
 	erc20BalanceOutput = s.Tester.Inspect(erc20BalanceInput)
 	s.Len(erc20BalanceOutput.Reports, 1)
-	s.Equal(`"0"`, string(erc20BalanceOutput.Reports[0].Payload))
+	s.Equal(`"0"`, s.envelopeData("user.balance", erc20BalanceOutput.Reports[0].Payload))
 
 	// Verify investor05 balance (5500 - 5500 = 0)
 	erc20BalanceInput = []byte(fmt.Sprintf(`{"path":"user/balance","data":{"address":"%s","token":"%s"}}`, investor05.Hex(), token.Hex()))
 	erc20BalanceOutput = s.Tester.Inspect(erc20BalanceInput)
 	s.Len(erc20BalanceOutput.Reports, 1)
-	s.Equal(`"0"`, string(erc20BalanceOutput.Reports[0].Payload))
+	s.Equal(`"0"`, s.envelopeData("user.balance", erc20BalanceOutput.Reports[0].Payload))
 
 	// Verify creator balance (should have received 95% of 100000 = 95000 from investors, 5% goes to admin as fee)
 	erc20BalanceInput = []byte(fmt.Sprintf(`{"path":"user/balance","data":{"address":"%s","token":"%s"}}`, creator.Hex(), token.Hex()))
 	erc20BalanceOutput = s.Tester.Inspect(erc20BalanceInput)
 	s.Len(erc20BalanceOutput.Reports, 1)
-	s.Equal(`"95000"`, string(erc20BalanceOutput.Reports[0].Payload))
+	s.Equal(`"95000"`, s.envelopeData("user.balance", erc20BalanceOutput.Reports[0].Payload))
 
 	// Verify admin balance (should have received 5% of 100000 = 5000 as fee)
 	erc20BalanceInput = []byte(fmt.Sprintf(`{"path":"user/balance","data":{"address":"%s","token":"%s"}}`, admin.Hex(), token.Hex()))
 	erc20BalanceOutput = s.Tester.Inspect(erc20BalanceInput)
 	s.Len(erc20BalanceOutput.Reports, 1)
-	s.Equal(`"5000"`, string(erc20BalanceOutput.Reports[0].Payload))
+	s.Equal(`"5000"`, s.envelopeData("user.balance", erc20BalanceOutput.Reports[0].Payload))
 
 	// verify number of vouchers for badge safeERC1155MintAddress delegate calls
 	s.Len(closeIssuanceOutput.DelegateCallVouchers, 5)
@@ -858,54 +869,54 @@ func (s *IssuanceSuite) TestExecuteIssuanceCollateral() {
 	// create creator user
 	createUserInput := []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"creator"}}`, creator))
 	createUserOutput := s.Tester.Advance(admin, createUserInput)
-	s.Len(createUserOutput.Notices, 1)
+	s.Len(createUserOutput.Notices, 2)
 
-	expectedCreateUserOutput := fmt.Sprintf(`user created - {"id":3,"role":"creator","address":"%s","social_accounts":[],"created_at":%d}`, creator, baseTime)
-	s.Equal(expectedCreateUserOutput, string(createUserOutput.Notices[0].Payload))
+	expectedCreateUserOutput := fmt.Sprintf(`{"id":3,"roles":["creator"],"address":"%s","status":"active","social_accounts":[],"created_at":%d}`, creator, baseTime)
+	s.Equal(expectedCreateUserOutput, s.envelopeData("user.created", createUserOutput.Notices[0].Payload))
 
 	// verify social account
-	createSocialAccountInput := []byte(fmt.Sprintf(`{"path":"social/verifier/create","data":{"address":"%s","username":"test","platform":"twitter"}}`, creator))
-	createSocialAccountOutput := s.Tester.Advance(verifier, createSocialAccountInput)
-	s.Len(createSocialAccountOutput.Notices, 1)
+	createSocialAccountOutput := s.createSocialAccount(verifier, creatorKey, "test", "twitter")
+	s.Len(createSocialAccountOutput.Notices, 2)
 
-	expectedCreateSocialAccountOutput := fmt.Sprintf(`social account created - {"id":1,"user_id":3,"username":"test","platform":"twitter","created_at":%d}`, baseTime)
-	s.Equal(expectedCreateSocialAccountOutput, string(createSocialAccountOutput.Notices[0].Payload))
+	expectedCreateSocialAccountOutput := fmt.Sprintf(`{"id":1,"user_id":3,"username":"test","platform":"twitter","evidence":%s,"created_at":%d}`,
+		s.evidenceOf(createSocialAccountOutput), baseTime)
+	s.Equal(expectedCreateSocialAccountOutput, s.envelopeData("social_account.created", createSocialAccountOutput.Notices[0].Payload))
 
 	// create investors users
 	createUserInput = []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"investor"}}`, investor01))
 	createUserOutput = s.Tester.Advance(admin, createUserInput)
-	s.Len(createUserOutput.Notices, 1)
+	s.Len(createUserOutput.Notices, 2)
 
-	expectedCreateUserOutput = fmt.Sprintf(`user created - {"id":4,"role":"investor","address":"%s","social_accounts":[],"created_at":%d}`, investor01, baseTime)
-	s.Equal(expectedCreateUserOutput, string(createUserOutput.Notices[0].Payload))
+	expectedCreateUserOutput = fmt.Sprintf(`{"id":4,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d}`, investor01, baseTime)
+	s.Equal(expectedCreateUserOutput, s.envelopeData("user.created", createUserOutput.Notices[0].Payload))
 
 	createUserInput = []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"investor"}}`, investor02))
 	createUserOutput = s.Tester.Advance(admin, createUserInput)
-	s.Len(createUserOutput.Notices, 1)
+	s.Len(createUserOutput.Notices, 2)
 
-	expectedCreateUserOutput = fmt.Sprintf(`user created - {"id":5,"role":"investor","address":"%s","social_accounts":[],"created_at":%d}`, investor02, baseTime)
-	s.Equal(expectedCreateUserOutput, string(createUserOutput.Notices[0].Payload))
+	expectedCreateUserOutput = fmt.Sprintf(`{"id":5,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d}`, investor02, baseTime)
+	s.Equal(expectedCreateUserOutput, s.envelopeData("user.created", createUserOutput.Notices[0].Payload))
 
 	createUserInput = []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"investor"}}`, investor03))
 	createUserOutput = s.Tester.Advance(admin, createUserInput)
-	s.Len(createUserOutput.Notices, 1)
+	s.Len(createUserOutput.Notices, 2)
 
-	expectedCreateUserOutput = fmt.Sprintf(`user created - {"id":6,"role":"investor","address":"%s","social_accounts":[],"created_at":%d}`, investor03, baseTime)
-	s.Equal(expectedCreateUserOutput, string(createUserOutput.Notices[0].Payload))
+	expectedCreateUserOutput = fmt.Sprintf(`{"id":6,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d}`, investor03, baseTime)
+	s.Equal(expectedCreateUserOutput, s.envelopeData("user.created", createUserOutput.Notices[0].Payload))
 
 	createUserInput = []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"investor"}}`, investor04))
 	createUserOutput = s.Tester.Advance(admin, createUserInput)
-	s.Len(createUserOutput.Notices, 1)
+	s.Len(createUserOutput.Notices, 2)
 
-	expectedCreateUserOutput = fmt.Sprintf(`user created - {"id":7,"role":"investor","address":"%s","social_accounts":[],"created_at":%d}`, investor04, baseTime)
-	s.Equal(expectedCreateUserOutput, string(createUserOutput.Notices[0].Payload))
+	expectedCreateUserOutput = fmt.Sprintf(`{"id":7,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d}`, investor04, baseTime)
+	s.Equal(expectedCreateUserOutput, s.envelopeData("user.created", createUserOutput.Notices[0].Payload))
 
 	createUserInput = []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"investor"}}`, investor05))
 	createUserOutput = s.Tester.Advance(admin, createUserInput)
-	s.Len(createUserOutput.Notices, 1)
+	s.Len(createUserOutput.Notices, 2)
 
-	expectedCreateUserOutput = fmt.Sprintf(`user created - {"id":8,"role":"investor","address":"%s","social_accounts":[],"created_at":%d}`, investor05, baseTime)
-	s.Equal(expectedCreateUserOutput, string(createUserOutput.Notices[0].Payload))
+	expectedCreateUserOutput = fmt.Sprintf(`{"id":8,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d}`, investor05, baseTime)
+	s.Equal(expectedCreateUserOutput, s.envelopeData("user.created", createUserOutput.Notices[0].Payload))
 
 	addressType, _ := abi.NewType("address", "", nil)
 	constructorArgs, err := abi.Arguments{
@@ -915,7 +926,7 @@ func (s *IssuanceSuite) TestExecuteIssuanceCollateral() {
 
 	badgeAddress := crypto.CreateAddress2(
 		factory,
-		common.HexToHash(strconv.Itoa(7)),
+		common.HexToHash(strconv.Itoa(8)),
 		crypto.Keccak256(append(s.Bytecode, constructorArgs...)),
 	)
 
@@ -926,17 +937,18 @@ func (s *IssuanceSuite) TestExecuteIssuanceCollateral() {
 		maturityAt,
 	))
 	createIssuanceOutput := s.Tester.DepositERC20(collateral, creator, big.NewInt(10000), createIssuanceInput)
-	s.Len(createIssuanceOutput.Notices, 1)
+	s.Len(createIssuanceOutput.Notices, 2)
 
-	expectedCreateIssuanceOutput := fmt.Sprintf(`issuance created - {"id":1,"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"%s","creator":{"id":3,"role":"creator","address":"%s","social_accounts":[{"id":1,"user_id":3,"username":"test","platform":"twitter","created_at":%d}],"created_at":%d,"updated_at":0},"collateral":"%s","collateral_amount":"10000","badge_address":"%s","debt_issued":"100000","max_interest_rate":"1000","state":"ongoing","orders":[],"created_at":%d,"closes_at":%d,"maturity_at":%d}`,
+	expectedCreateIssuanceOutput := fmt.Sprintf(`{"id":1,"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"%s","creator":{"id":3,"roles":["creator"],"address":"%s","status":"active","social_accounts":[{"id":1,"user_id":3,"username":"test","platform":"twitter","created_at":%d}],"reputation":%s,"created_at":%d,"updated_at":0},"collateral":"%s","collateral_amount":"10000","badge_address":"%s","debt_issued":"100000","max_interest_rate":"1000","state":"ongoing","orders":[],"created_at":%d,"closes_at":%d,"maturity_at":%d}`,
 		token.Hex(),
 		creator.Hex(),
 		baseTime,
+		reputationJSON(500, 1, 0, 0, 0, 0, "0", baseTime),
 		baseTime,
 		collateral.Hex(),
 		badgeAddress.Hex(),
 		baseTime, closesAt, maturityAt)
-	s.Equal(expectedCreateIssuanceOutput, string(createIssuanceOutput.Notices[0].Payload))
+	s.Equal(expectedCreateIssuanceOutput, s.envelopeData("issuance.created", createIssuanceOutput.Notices[0].Payload))
 
 	s.Len(createIssuanceOutput.Vouchers, 1)
 	s.Equal(factory, createIssuanceOutput.Vouchers[0].Destination)
@@ -957,46 +969,47 @@ func (s *IssuanceSuite) TestExecuteIssuanceCollateral() {
 	s.Require().NoError(err)
 	s.Equal(applicationAddress, unpacked[0])
 	saltBytes := unpacked[1].([32]byte)
-	s.Equal(common.HexToHash(strconv.Itoa(7)), common.BytesToHash(saltBytes[:]))
+	s.Equal(common.HexToHash(strconv.Itoa(8)), common.BytesToHash(saltBytes[:]))
 
 	createOrderInput := []byte(`{"path": "order/create", "data": {"issuance_id":1,"interest_rate":"900"}}`)
 	createOrderOutput := s.Tester.DepositERC20(token, investor01, big.NewInt(60000), createOrderInput)
-	s.Len(createOrderOutput.Notices, 1)
+	s.Len(createOrderOutput.Notices, 2)
 
 	createOrderInput = []byte(`{"path": "order/create", "data": {"issuance_id":1,"interest_rate":"800"}}`)
 	createOrderOutput = s.Tester.DepositERC20(token, investor02, big.NewInt(28000), createOrderInput)
-	s.Len(createOrderOutput.Notices, 1)
+	s.Len(createOrderOutput.Notices, 2)
 
 	createOrderInput = []byte(`{"path": "order/create", "data": {"issuance_id":1,"interest_rate":"400"}}`)
 	createOrderOutput = s.Tester.DepositERC20(token, investor03, big.NewInt(2000), createOrderInput)
-	s.Len(createOrderOutput.Notices, 1)
+	s.Len(createOrderOutput.Notices, 2)
 
 	createOrderInput = []byte(`{"path": "order/create", "data": {"issuance_id":1,"interest_rate":"600"}}`)
 	createOrderOutput = s.Tester.DepositERC20(token, investor04, big.NewInt(5000), createOrderInput)
-	s.Len(createOrderOutput.Notices, 1)
+	s.Len(createOrderOutput.Notices, 2)
 
 	createOrderInput = []byte(`{"path": "order/create", "data": {"issuance_id":1,"interest_rate":"400"}}`)
 	createOrderOutput = s.Tester.DepositERC20(token, investor05, big.NewInt(5500), createOrderInput)
-	s.Len(createOrderOutput.Notices, 1)
+	s.Len(createOrderOutput.Notices, 2)
 
-	time.Sleep(5 * time.Second)
+	s.wait(5)
 
 	anyone := common.HexToAddress("0x0000000000000000000000000000000000000001")
 	closeIssuanceInput := []byte(fmt.Sprintf(`{"path":"issuance/close", "data":{"creator_address":"%s"}}`, creator))
 	closeIssuanceOutput := s.Tester.Advance(anyone, closeIssuanceInput)
-	s.Len(closeIssuanceOutput.Notices, 1)
-
-	expectedCloseIssuanceOutput := fmt.Sprintf(`issuance closed - {"id":1,"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"%s","creator":{"id":3,"role":"creator","address":"%s","social_accounts":[{"id":1,"user_id":3,"username":"test","platform":"twitter","created_at":%d}],"created_at":%d,"updated_at":0},"collateral":"%s","collateral_amount":"10000","badge_address":"%s","debt_issued":"100000","max_interest_rate":"1000","total_obligation":"108195","total_raised":"100000","state":"closed","orders":[`+
-		`{"id":1,"issuance_id":1,"investor":{"id":4,"role":"investor","address":"%s","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"59500","interest_rate":"900","state":"partially_accepted","created_at":%d,"updated_at":%d},`+
-		`{"id":2,"issuance_id":1,"investor":{"id":5,"role":"investor","address":"%s","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"28000","interest_rate":"800","state":"accepted","created_at":%d,"updated_at":%d},`+
-		`{"id":3,"issuance_id":1,"investor":{"id":6,"role":"investor","address":"%s","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"2000","interest_rate":"400","state":"accepted","created_at":%d,"updated_at":%d},`+
-		`{"id":4,"issuance_id":1,"investor":{"id":7,"role":"investor","address":"%s","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"5000","interest_rate":"600","state":"accepted","created_at":%d,"updated_at":%d},`+
-		`{"id":5,"issuance_id":1,"investor":{"id":8,"role":"investor","address":"%s","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"5500","interest_rate":"400","state":"accepted","created_at":%d,"updated_at":%d},`+
-		`{"id":6,"issuance_id":1,"investor":{"id":4,"role":"investor","address":"%s","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"500","interest_rate":"900","state":"rejected","created_at":%d,"updated_at":%d}],`+
+	s.Len(closeIssuanceOutput.Notices, 2)
+
+	expectedCloseIssuanceOutput := fmt.Sprintf(`{"id":1,"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"%s","creator":{"id":3,"roles":["creator"],"address":"%s","status":"active","social_accounts":[{"id":1,"user_id":3,"username":"test","platform":"twitter","created_at":%d}],"reputation":%s,"created_at":%d,"updated_at":0},"collateral":"%s","collateral_amount":"10000","badge_address":"%s","debt_issued":"100000","max_interest_rate":"1000","total_obligation":"108195","total_raised":"100000","state":"closed","orders":[`+
+		`{"id":1,"issuance_id":1,"investor":{"id":4,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"59500","interest_rate":"900","state":"partially_accepted","created_at":%d,"updated_at":%d},`+
+		`{"id":2,"issuance_id":1,"investor":{"id":5,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"28000","interest_rate":"800","state":"accepted","created_at":%d,"updated_at":%d},`+
+		`{"id":3,"issuance_id":1,"investor":{"id":6,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"2000","interest_rate":"400","state":"accepted","created_at":%d,"updated_at":%d},`+
+		`{"id":4,"issuance_id":1,"investor":{"id":7,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"5000","interest_rate":"600","state":"accepted","created_at":%d,"updated_at":%d},`+
+		`{"id":5,"issuance_id":1,"investor":{"id":8,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"5500","interest_rate":"400","state":"accepted","created_at":%d,"updated_at":%d},`+
+		`{"id":6,"issuance_id":1,"investor":{"id":4,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"500","interest_rate":"900","state":"rejected","created_at":%d,"updated_at":%d}],`+
 		`"created_at":%d,"closes_at":%d,"maturity_at":%d,"updated_at":%d}`,
 		token.Hex(),
 		creator.Hex(),
 		baseTime,
+		reputationJSON(500, 1, 0, 0, 0, 0, "100000", closesAt),
 		baseTime,
 		collateral.Hex(),
 		badgeAddress.Hex(),
@@ -1007,32 +1020,33 @@ func (s *IssuanceSuite) TestExecuteIssuanceCollateral() {
 		investor05.Hex(), baseTime, baseTime, closesAt,
 		investor01.Hex(), baseTime, baseTime, closesAt,
 		baseTime, closesAt, maturityAt, closesAt)
-	s.Equal(expectedCloseIssuanceOutput, string(closeIssuanceOutput.Notices[0].Payload))
+	s.Equal(expectedCloseIssuanceOutput, s.envelopeData("issuance.closed", closeIssuanceOutput.Notices[0].Payload))
 
 	// Withdraw raised amount (creator receives 95% of total raised = 95000, 5% goes to admin as fee)
 	withdrawRaisedAmountInput := []byte(fmt.Sprintf(`{"path":"user/withdraw","data":{"token":"%s","amount":"95000"}}`, token.Hex()))
 	withdrawRaisedAmountOutput := s.Tester.Advance(creator, withdrawRaisedAmountInput)
-	s.Len(withdrawRaisedAmountOutput.Notices, 1)
+	s.Len(withdrawRaisedAmountOutput.Notices, 2)
 
-	expectedWithdrawRaisedAmountOutput := fmt.Sprintf(`ERC20 withdrawn - token: %s, amount: 95000, user: %s`, token.Hex(), creator.Hex())
-	s.Equal(expectedWithdrawRaisedAmountOutput, string(withdrawRaisedAmountOutput.Notices[0].Payload))
+	expectedWithdrawRaisedAmountOutput := fmt.Sprintf(`{"token":"%s","amount":"95000","user":"%s"}`, token.Hex(), creator.Hex())
+	s.Equal(expectedWithdrawRaisedAmountOutput, s.envelopeData("user.erc20_withdrawn", withdrawRaisedAmountOutput.Notices[0].Payload))
 
 	findIssuanceByIdInput := []byte(`{"path":"issuance/id", "data":{"id":1}}`)
 
 	findIssuanceByIdOutput := s.Tester.Inspect(findIssuanceByIdInput)
 	s.Len(findIssuanceByIdOutput.Reports, 1)
 
-	expectedFindIssuanceByCreatorOutput := fmt.Sprintf(`[{"id":1,"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"%s","creator":{"id":3,"role":"creator","address":"%s","social_accounts":[{"id":1,"user_id":3,"username":"test","platform":"twitter","created_at":%d}],"created_at":%d,"updated_at":0},"collateral":"%s","collateral_amount":"10000","badge_address":"%s","debt_issued":"100000","max_interest_rate":"1000","total_obligation":"108195","total_raised":"100000","state":"closed","orders":[`+
-		`{"id":1,"issuance_id":1,"investor":{"id":4,"role":"investor","address":"%s","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"59500","interest_rate":"900","state":"partially_accepted","created_at":%d,"updated_at":%d},`+
-		`{"id":2,"issuance_id":1,"investor":{"id":5,"role":"investor","address":"%s","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"28000","interest_rate":"800","state":"accepted","created_at":%d,"updated_at":%d},`+
-		`{"id":3,"issuance_id":1,"investor":{"id":6,"role":"investor","address":"%s","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"2000","interest_rate":"400","state":"accepted","created_at":%d,"updated_at":%d},`+
-		`{"id":4,"issuance_id":1,"investor":{"id":7,"role":"investor","address":"%s","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"5000","interest_rate":"600","state":"accepted","created_at":%d,"updated_at":%d},`+
-		`{"id":5,"issuance_id":1,"investor":{"id":8,"role":"investor","address":"%s","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"5500","interest_rate":"400","state":"accepted","created_at":%d,"updated_at":%d},`+
-		`{"id":6,"issuance_id":1,"investor":{"id":4,"role":"investor","address":"%s","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"500","interest_rate":"900","state":"rejected","created_at":%d,"updated_at":%d}],`+
+	expectedFindIssuanceByCreatorOutput := fmt.Sprintf(`[{"id":1,"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"%s","creator":{"id":3,"roles":["creator"],"address":"%s","status":"active","social_accounts":[{"id":1,"user_id":3,"username":"test","platform":"twitter","created_at":%d}],"reputation":%s,"created_at":%d,"updated_at":0},"collateral":"%s","collateral_amount":"10000","badge_address":"%s","debt_issued":"100000","max_interest_rate":"1000","total_obligation":"108195","total_raised":"100000","state":"closed","orders":[`+
+		`{"id":1,"issuance_id":1,"investor":{"id":4,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"59500","interest_rate":"900","state":"partially_accepted","created_at":%d,"updated_at":%d},`+
+		`{"id":2,"issuance_id":1,"investor":{"id":5,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"28000","interest_rate":"800","state":"accepted","created_at":%d,"updated_at":%d},`+
+		`{"id":3,"issuance_id":1,"investor":{"id":6,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"2000","interest_rate":"400","state":"accepted","created_at":%d,"updated_at":%d},`+
+		`{"id":4,"issuance_id":1,"investor":{"id":7,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"5000","interest_rate":"600","state":"accepted","created_at":%d,"updated_at":%d},`+
+		`{"id":5,"issuance_id":1,"investor":{"id":8,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"5500","interest_rate":"400","state":"accepted","created_at":%d,"updated_at":%d},`+
+		`{"id":6,"issuance_id":1,"investor":{"id":4,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"500","interest_rate":"900","state":"rejected","created_at":%d,"updated_at":%d}],`+
 		`"created_at":%d,"closes_at":%d,"maturity_at":%d,"updated_at":%d}]`,
 		token.Hex(),
 		creator.Hex(),
 		baseTime,
+		reputationJSON(500, 1, 0, 0, 0, 0, "100000", closesAt),
 		baseTime,
 		collateral.Hex(),
 		badgeAddress.Hex(),
@@ -1048,27 +1062,28 @@ func (s *IssuanceSuite) TestExecuteIssuanceCollateral() {
 
 	findIssuancesByCreatorOutput := s.Tester.Inspect(findIssuancesByCreatorInput)
 	s.Len(findIssuancesByCreatorOutput.Reports, 1)
-	s.Equal(expectedFindIssuanceByCreatorOutput, string(findIssuancesByCreatorOutput.Reports[0].Payload))
+	s.Equal(expectedFindIssuanceByCreatorOutput, s.envelopeData("issuance.list", findIssuancesByCreatorOutput.Reports[0].Payload))
 
-	time.Sleep(6 * time.Second)
+	s.wait(6)
 
 	executeIssuanceCollateralInput := []byte(`{"path":"issuance/execute-collateral", "data":{"id":1}}`)
 	executeIssuanceCollateralOutput := s.Tester.Advance(creator, executeIssuanceCollateralInput)
-	s.Len(executeIssuanceCollateralOutput.Notices, 1)
+	s.Len(executeIssuanceCollateralOutput.Notices, 2)
 
 	updatedAt := baseTime + 11
 
-	expectedExecuteIssuanceCollateralOutput := fmt.Sprintf(`issuance collateral executed - {"id":1,"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"%s","creator":{"id":3,"role":"creator","address":"%s","social_accounts":[{"id":1,"user_id":3,"username":"test","platform":"twitter","created_at":%d}],"created_at":%d,"updated_at":0},"collateral":"%s","collateral_amount":"10000","badge_address":"%s","debt_issued":"100000","max_interest_rate":"1000","total_obligation":"108195","total_raised":"100000","state":"collateral_executed","orders":[`+
-		`{"id":1,"issuance_id":1,"investor":{"id":4,"role":"investor","address":"%s","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"59500","interest_rate":"900","state":"settled_by_collateral","created_at":%d,"updated_at":%d},`+
-		`{"id":2,"issuance_id":1,"investor":{"id":5,"role":"investor","address":"%s","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"28000","interest_rate":"800","state":"settled_by_collateral","created_at":%d,"updated_at":%d},`+
-		`{"id":3,"issuance_id":1,"investor":{"id":6,"role":"investor","address":"%s","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"2000","interest_rate":"400","state":"settled_by_collateral","created_at":%d,"updated_at":%d},`+
-		`{"id":4,"issuance_id":1,"investor":{"id":7,"role":"investor","address":"%s","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"5000","interest_rate":"600","state":"settled_by_collateral","created_at":%d,"updated_at":%d},`+
-		`{"id":5,"issuance_id":1,"investor":{"id":8,"role":"investor","address":"%s","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"5500","interest_rate":"400","state":"settled_by_collateral","created_at":%d,"updated_at":%d},`+
-		`{"id":6,"issuance_id":1,"investor":{"id":4,"role":"investor","address":"%s","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"500","interest_rate":"900","state":"rejected","created_at":%d,"updated_at":%d}],`+
+	expectedExecuteIssuanceCollateralOutput := fmt.Sprintf(`{"id":1,"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"%s","creator":{"id":3,"roles":["creator"],"address":"%s","status":"active","social_accounts":[{"id":1,"user_id":3,"username":"test","platform":"twitter","created_at":%d}],"reputation":%s,"created_at":%d,"updated_at":0},"collateral":"%s","collateral_amount":"10000","badge_address":"%s","debt_issued":"100000","max_interest_rate":"1000","total_obligation":"108195","total_raised":"100000","state":"collateral_executed","orders":[`+
+		`{"id":1,"issuance_id":1,"investor":{"id":4,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"59500","interest_rate":"900","state":"settled_by_collateral","created_at":%d,"updated_at":%d},`+
+		`{"id":2,"issuance_id":1,"investor":{"id":5,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"28000","interest_rate":"800","state":"settled_by_collateral","created_at":%d,"updated_at":%d},`+
+		`{"id":3,"issuance_id":1,"investor":{"id":6,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"2000","interest_rate":"400","state":"settled_by_collateral","created_at":%d,"updated_at":%d},`+
+		`{"id":4,"issuance_id":1,"investor":{"id":7,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"5000","interest_rate":"600","state":"settled_by_collateral","created_at":%d,"updated_at":%d},`+
+		`{"id":5,"issuance_id":1,"investor":{"id":8,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"5500","interest_rate":"400","state":"settled_by_collateral","created_at":%d,"updated_at":%d},`+
+		`{"id":6,"issuance_id":1,"investor":{"id":4,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"500","interest_rate":"900","state":"rejected","created_at":%d,"updated_at":%d}],`+
 		`"created_at":%d,"closes_at":%d,"maturity_at":%d,"updated_at":%d}`,
 		token.Hex(),
 		creator.Hex(),
 		baseTime,
+		reputationJSON(250, 1, 0, 0, 1, 0, "100000", updatedAt),
 		baseTime,
 		collateral.Hex(),
 		badgeAddress.Hex(),
@@ -1079,7 +1094,7 @@ func (s *IssuanceSuite) TestExecuteIssuanceCollateral() {
 		investor05.Hex(), baseTime, baseTime, updatedAt,
 		investor01.Hex(), baseTime, baseTime, closesAt,
 		baseTime, closesAt, maturityAt, updatedAt)
-	s.Equal(expectedExecuteIssuanceCollateralOutput, string(executeIssuanceCollateralOutput.Notices[0].Payload))
+	s.Equal(expectedExecuteIssuanceCollateralOutput, s.envelopeData("issuance.collateral_executed", executeIssuanceCollateralOutput.Notices[0].Payload))
 
 	// Verify final balances after issuance collateral execution
 	// The collateral (10000) is distributed proportionally to accepted orders based on their final value
@@ -1099,37 +1114,37 @@ func (s *IssuanceSuite) TestExecuteIssuanceCollateral() {
 	erc20BalanceInput := []byte(fmt.Sprintf(`{"path":"user/balance","data":{"address":"%s","token":"%s"}}`, investor01.Hex(), collateral.Hex()))
 	erc20BalanceOutput := s.Tester.Inspect(erc20BalanceInput)
 	s.Len(erc20BalanceOutput.Reports, 1)
-	s.Equal(`"5994"`, string(erc20BalanceOutput.Reports[0].Payload))
+	s.Equal(`"5994"`, s.envelopeData("user.balance", erc20BalanceOutput.Reports[0].Payload))
 
 	// Verify investor02 balance (received 2794 collateral)
 	erc20BalanceInput = []byte(fmt.Sprintf(`{"path":"user/balance","data":{"address":"%s","token":"%s"}}`, investor02.Hex(), collateral.Hex()))
 	erc20BalanceOutput = s.Tester.Inspect(erc20BalanceInput)
 	s.Len(erc20BalanceOutput.Reports, 1)
-	s.Equal(`"2794"`, string(erc20BalanceOutput.Reports[0].Payload))
+	s.Equal(`"2794"`, s.envelopeData("user.balance", erc20BalanceOutput.Reports[0].Payload))
 
 	// Verify investor03 balance (received 192 collateral)
 	erc20BalanceInput = []byte(fmt.Sprintf(`{"path":"user/balance","data":{"address":"%s","token":"%s"}}`, investor03.Hex(), collateral.Hex()))
 	erc20BalanceOutput = s.Tester.Inspect(erc20BalanceInput)
 	s.Len(erc20BalanceOutput.Reports, 1)
-	s.Equal(`"192"`, string(erc20BalanceOutput.Reports[0].Payload))
+	s.Equal(`"192"`, s.envelopeData("user.balance", erc20BalanceOutput.Reports[0].Payload))
 
 	// Verify investor04 balance (received 489 collateral)
 	erc20BalanceInput = []byte(fmt.Sprintf(`{"path":"user/balance","data":{"address":"%s","token":"%s"}}`, investor04.Hex(), collateral.Hex()))
 	erc20BalanceOutput = s.Tester.Inspect(erc20BalanceInput)
 	s.Len(erc20BalanceOutput.Reports, 1)
-	s.Equal(`"489"`, string(erc20BalanceOutput.Reports[0].Payload))
+	s.Equal(`"489"`, s.envelopeData("user.balance", erc20BalanceOutput.Reports[0].Payload))
 
 	// Verify investor05 balance (received 528 collateral)
 	erc20BalanceInput = []byte(fmt.Sprintf(`{"path":"user/balance","data":{"address":"%s","token":"%s"}}`, investor05.Hex(), collateral.Hex()))
 	erc20BalanceOutput = s.Tester.Inspect(erc20BalanceInput)
 	s.Len(erc20BalanceOutput.Reports, 1)
-	s.Equal(`"528"`, string(erc20BalanceOutput.Reports[0].Payload))
+	s.Equal(`"528"`, s.envelopeData("user.balance", erc20BalanceOutput.Reports[0].Payload))
 
 	// Verify creator balance (no additional deposit, just execution of existing collateral)
 	erc20BalanceInput = []byte(fmt.Sprintf(`{"path":"user/balance","data":{"address":"%s","token":"%s"}}`, creator.Hex(), collateral.Hex()))
 	erc20BalanceOutput = s.Tester.Inspect(erc20BalanceInput)
 	s.Len(erc20BalanceOutput.Reports, 1)
-	s.Equal(`"0"`, string(erc20BalanceOutput.Reports[0].Payload))
+	s.Equal(`"0"`, s.envelopeData("user.balance", erc20BalanceOutput.Reports[0].Payload))
 
 	// verify number of vouchers for badge safeERC1155MintAddress delegate calls
 	s.Len(closeIssuanceOutput.DelegateCallVouchers, 5)
@@ -1210,54 +1225,54 @@ func (s *IssuanceSuite) TestSettleIssuance() {
 	// create creator user
 	createUserInput := []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"creator"}}`, creator))
 	createUserOutput := s.Tester.Advance(admin, createUserInput)
-	s.Len(createUserOutput.Notices, 1)
+	s.Len(createUserOutput.Notices, 2)
 
-	expectedCreateUserOutput := fmt.Sprintf(`user created - {"id":3,"role":"creator","address":"%s","social_accounts":[],"created_at":%d}`, creator, baseTime)
-	s.Equal(expectedCreateUserOutput, string(createUserOutput.Notices[0].Payload))
+	expectedCreateUserOutput := fmt.Sprintf(`{"id":3,"roles":["creator"],"address":"%s","status":"active","social_accounts":[],"created_at":%d}`, creator, baseTime)
+	s.Equal(expectedCreateUserOutput, s.envelopeData("user.created", createUserOutput.Notices[0].Payload))
 
 	// verify social account
-	createSocialAccountInput := []byte(fmt.Sprintf(`{"path":"social/verifier/create","data":{"address":"%s","username":"test","platform":"twitter"}}`, creator))
-	createSocialAccountOutput := s.Tester.Advance(verifier, createSocialAccountInput)
-	s.Len(createSocialAccountOutput.Notices, 1)
+	createSocialAccountOutput := s.createSocialAccount(verifier, creatorKey, "test", "twitter")
+	s.Len(createSocialAccountOutput.Notices, 2)
 
-	expectedCreateSocialAccountOutput := fmt.Sprintf(`social account created - {"id":1,"user_id":3,"username":"test","platform":"twitter","created_at":%d}`, baseTime)
-	s.Equal(expectedCreateSocialAccountOutput, string(createSocialAccountOutput.Notices[0].Payload))
+	expectedCreateSocialAccountOutput := fmt.Sprintf(`{"id":1,"user_id":3,"username":"test","platform":"twitter","evidence":%s,"created_at":%d}`,
+		s.evidenceOf(createSocialAccountOutput), baseTime)
+	s.Equal(expectedCreateSocialAccountOutput, s.envelopeData("social_account.created", createSocialAccountOutput.Notices[0].Payload))
 
 	// create investors users
 	createUserInput = []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"investor"}}`, investor01))
 	createUserOutput = s.Tester.Advance(admin, createUserInput)
-	s.Len(createUserOutput.Notices, 1)
+	s.Len(createUserOutput.Notices, 2)
 
-	expectedCreateUserOutput = fmt.Sprintf(`user created - {"id":4,"role":"investor","address":"%s","social_accounts":[],"created_at":%d}`, investor01, baseTime)
-	s.Equal(expectedCreateUserOutput, string(createUserOutput.Notices[0].Payload))
+	expectedCreateUserOutput = fmt.Sprintf(`{"id":4,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d}`, investor01, baseTime)
+	s.Equal(expectedCreateUserOutput, s.envelopeData("user.created", createUserOutput.Notices[0].Payload))
 
 	createUserInput = []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"investor"}}`, investor02))
 	createUserOutput = s.Tester.Advance(admin, createUserInput)
-	s.Len(createUserOutput.Notices, 1)
+	s.Len(createUserOutput.Notices, 2)
 
-	expectedCreateUserOutput = fmt.Sprintf(`user created - {"id":5,"role":"investor","address":"%s","social_accounts":[],"created_at":%d}`, investor02, baseTime)
-	s.Equal(expectedCreateUserOutput, string(createUserOutput.Notices[0].Payload))
+	expectedCreateUserOutput = fmt.Sprintf(`{"id":5,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d}`, investor02, baseTime)
+	s.Equal(expectedCreateUserOutput, s.envelopeData("user.created", createUserOutput.Notices[0].Payload))
 
 	createUserInput = []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"investor"}}`, investor03))
 	createUserOutput = s.Tester.Advance(admin, createUserInput)
-	s.Len(createUserOutput.Notices, 1)
+	s.Len(createUserOutput.Notices, 2)
 
-	expectedCreateUserOutput = fmt.Sprintf(`user created - {"id":6,"role":"investor","address":"%s","social_accounts":[],"created_at":%d}`, investor03, baseTime)
-	s.Equal(expectedCreateUserOutput, string(createUserOutput.Notices[0].Payload))
+	expectedCreateUserOutput = fmt.Sprintf(`{"id":6,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d}`, investor03, baseTime)
+	s.Equal(expectedCreateUserOutput, s.envelopeData("user.created", createUserOutput.Notices[0].Payload))
 
 	createUserInput = []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"investor"}}`, investor04))
 	createUserOutput = s.Tester.Advance(admin, createUserInput)
-	s.Len(createUserOutput.Notices, 1)
+	s.Len(createUserOutput.Notices, 2)
 
-	expectedCreateUserOutput = fmt.Sprintf(`user created - {"id":7,"role":"investor","address":"%s","social_accounts":[],"created_at":%d}`, investor04, baseTime)
-	s.Equal(expectedCreateUserOutput, string(createUserOutput.Notices[0].Payload))
+	expectedCreateUserOutput = fmt.Sprintf(`{"id":7,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d}`, investor04, baseTime)
+	s.Equal(expectedCreateUserOutput, s.envelopeData("user.created", createUserOutput.Notices[0].Payload))
 
 	createUserInput = []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"investor"}}`, investor05))
 	createUserOutput = s.Tester.Advance(admin, createUserInput)
-	s.Len(createUserOutput.Notices, 1)
+	s.Len(createUserOutput.Notices, 2)
 
-	expectedCreateUserOutput = fmt.Sprintf(`user created - {"id":8,"role":"investor","address":"%s","social_accounts":[],"created_at":%d}`, investor05, baseTime)
-	s.Equal(expectedCreateUserOutput, string(createUserOutput.Notices[0].Payload))
+	expectedCreateUserOutput = fmt.Sprintf(`{"id":8,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d}`, investor05, baseTime)
+	s.Equal(expectedCreateUserOutput, s.envelopeData("user.created", createUserOutput.Notices[0].Payload))
 
 	addressType, _ := abi.NewType("address", "", nil)
 	constructorArgs, err := abi.Arguments{
@@ -1267,7 +1282,7 @@ func (s *IssuanceSuite) TestSettleIssuance() {
 
 	badgeAddress := crypto.CreateAddress2(
 		factory,
-		common.HexToHash(strconv.Itoa(7)),
+		common.HexToHash(strconv.Itoa(8)),
 		crypto.Keccak256(append(s.Bytecode, constructorArgs...)),
 	)
 
@@ -1278,17 +1293,18 @@ func (s *IssuanceSuite) TestSettleIssuance() {
 		maturityAt,
 	))
 	createIssuanceOutput := s.Tester.DepositERC20(collateral, creator, big.NewInt(10000), createIssuanceInput)
-	s.Len(createIssuanceOutput.Notices, 1)
+	s.Len(createIssuanceOutput.Notices, 2)
 
-	expectedCreateIssuanceOutput := fmt.Sprintf(`issuance created - {"id":1,"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"%s","creator":{"id":3,"role":"creator","address":"%s","social_accounts":[{"id":1,"user_id":3,"username":"test","platform":"twitter","created_at":%d}],"created_at":%d,"updated_at":0},"collateral":"%s","collateral_amount":"10000","badge_address":"%s","debt_issued":"100000","max_interest_rate":"1000","state":"ongoing","orders":[],"created_at":%d,"closes_at":%d,"maturity_at":%d}`,
+	expectedCreateIssuanceOutput := fmt.Sprintf(`{"id":1,"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"%s","creator":{"id":3,"roles":["creator"],"address":"%s","status":"active","social_accounts":[{"id":1,"user_id":3,"username":"test","platform":"twitter","created_at":%d}],"reputation":%s,"created_at":%d,"updated_at":0},"collateral":"%s","collateral_amount":"10000","badge_address":"%s","debt_issued":"100000","max_interest_rate":"1000","state":"ongoing","orders":[],"created_at":%d,"closes_at":%d,"maturity_at":%d}`,
 		token.Hex(),
 		creator.Hex(),
 		baseTime,
+		reputationJSON(500, 1, 0, 0, 0, 0, "0", baseTime),
 		baseTime,
 		collateral.Hex(),
 		badgeAddress.Hex(),
 		baseTime, closesAt, maturityAt)
-	s.Equal(expectedCreateIssuanceOutput, string(createIssuanceOutput.Notices[0].Payload))
+	s.Equal(expectedCreateIssuanceOutput, s.envelopeData("issuance.created", createIssuanceOutput.Notices[0].Payload))
 
 	s.Len(createIssuanceOutput.Vouchers, 1)
 	s.Equal(factory, createIssuanceOutput.Vouchers[0].Destination)
@@ -1311,42 +1327,43 @@ func (s *IssuanceSuite) TestSettleIssuance() {
 
 	createOrderInput := []byte(`{"path": "order/create", "data": {"issuance_id":1,"interest_rate":"900"}}`)
 	createOrderOutput := s.Tester.DepositERC20(token, investor01, big.NewInt(60000), createOrderInput)
-	s.Len(createOrderOutput.Notices, 1)
+	s.Len(createOrderOutput.Notices, 2)
 
 	createOrderInput = []byte(`{"path": "order/create", "data": {"issuance_id":1,"interest_rate":"800"}}`)
 	createOrderOutput = s.Tester.DepositERC20(token, investor02, big.NewInt(28000), createOrderInput)
-	s.Len(createOrderOutput.Notices, 1)
+	s.Len(createOrderOutput.Notices, 2)
 
 	createOrderInput = []byte(`{"path": "order/create", "data": {"issuance_id":1,"interest_rate":"400"}}`)
 	createOrderOutput = s.Tester.DepositERC20(token, investor03, big.NewInt(2000), createOrderInput)
-	s.Len(createOrderOutput.Notices, 1)
+	s.Len(createOrderOutput.Notices, 2)
 
 	createOrderInput = []byte(`{"path": "order/create", "data": {"issuance_id":1,"interest_rate":"600"}}`)
 	createOrderOutput = s.Tester.DepositERC20(token, investor04, big.NewInt(5000), createOrderInput)
-	s.Len(createOrderOutput.Notices, 1)
+	s.Len(createOrderOutput.Notices, 2)
 
 	createOrderInput = []byte(`{"path": "order/create", "data": {"issuance_id":1,"interest_rate":"400"}}`)
 	createOrderOutput = s.Tester.DepositERC20(token, investor05, big.NewInt(5500), createOrderInput)
-	s.Len(createOrderOutput.Notices, 1)
+	s.Len(createOrderOutput.Notices, 2)
 
-	time.Sleep(5 * time.Second)
+	s.wait(5)
 
 	anyone := common.HexToAddress("0x0000000000000000000000000000000000000001")
 	closeIssuanceInput := []byte(fmt.Sprintf(`{"path":"issuance/close", "data":{"creator_address":"%s"}}`, creator))
 	closeIssuanceOutput := s.Tester.Advance(anyone, closeIssuanceInput)
-	s.Len(closeIssuanceOutput.Notices, 1)
-
-	expectedCloseIssuanceOutput := fmt.Sprintf(`issuance closed - {"id":1,"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"%s","creator":{"id":3,"role":"creator","address":"%s","social_accounts":[{"id":1,"user_id":3,"username":"test","platform":"twitter","created_at":%d}],"created_at":%d,"updated_at":0},"collateral":"%s","collateral_amount":"10000","badge_address":"%s","debt_issued":"100000","max_interest_rate":"1000","total_obligation":"108195","total_raised":"100000","state":"closed","orders":[`+
-		`{"id":1,"issuance_id":1,"investor":{"id":4,"role":"investor","address":"%s","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"59500","interest_rate":"900","state":"partially_accepted","created_at":%d,"updated_at":%d},`+
-		`{"id":2,"issuance_id":1,"investor":{"id":5,"role":"investor","address":"%s","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"28000","interest_rate":"800","state":"accepted","created_at":%d,"updated_at":%d},`+
-		`{"id":3,"issuance_id":1,"investor":{"id":6,"role":"investor","address":"%s","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"2000","interest_rate":"400","state":"accepted","created_at":%d,"updated_at":%d},`+
-		`{"id":4,"issuance_id":1,"investor":{"id":7,"role":"investor","address":"%s","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"5000","interest_rate":"600","state":"accepted","created_at":%d,"updated_at":%d},`+
-		`{"id":5,"issuance_id":1,"investor":{"id":8,"role":"investor","address":"%s","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"5500","interest_rate":"400","state":"accepted","created_at":%d,"updated_at":%d},`+
-		`{"id":6,"issuance_id":1,"investor":{"id":4,"role":"investor","address":"%s","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"500","interest_rate":"900","state":"rejected","created_at":%d,"updated_at":%d}],`+
+	s.Len(closeIssuanceOutput.Notices, 2)
+
+	expectedCloseIssuanceOutput := fmt.Sprintf(`{"id":1,"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"%s","creator":{"id":3,"roles":["creator"],"address":"%s","status":"active","social_accounts":[{"id":1,"user_id":3,"username":"test","platform":"twitter","created_at":%d}],"reputation":%s,"created_at":%d,"updated_at":0},"collateral":"%s","collateral_amount":"10000","badge_address":"%s","debt_issued":"100000","max_interest_rate":"1000","total_obligation":"108195","total_raised":"100000","state":"closed","orders":[`+
+		`{"id":1,"issuance_id":1,"investor":{"id":4,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"59500","interest_rate":"900","state":"partially_accepted","created_at":%d,"updated_at":%d},`+
+		`{"id":2,"issuance_id":1,"investor":{"id":5,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"28000","interest_rate":"800","state":"accepted","created_at":%d,"updated_at":%d},`+
+		`{"id":3,"issuance_id":1,"investor":{"id":6,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"2000","interest_rate":"400","state":"accepted","created_at":%d,"updated_at":%d},`+
+		`{"id":4,"issuance_id":1,"investor":{"id":7,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"5000","interest_rate":"600","state":"accepted","created_at":%d,"updated_at":%d},`+
+		`{"id":5,"issuance_id":1,"investor":{"id":8,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"5500","interest_rate":"400","state":"accepted","created_at":%d,"updated_at":%d},`+
+		`{"id":6,"issuance_id":1,"investor":{"id":4,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"500","interest_rate":"900","state":"rejected","created_at":%d,"updated_at":%d}],`+
 		`"created_at":%d,"closes_at":%d,"maturity_at":%d,"updated_at":%d}`,
 		token.Hex(),
 		creator.Hex(),
 		baseTime,
+		reputationJSON(500, 1, 0, 0, 0, 0, "100000", closesAt),
 		baseTime,
 		collateral.Hex(),
 		badgeAddress.Hex(),
@@ -1357,7 +1374,7 @@ func (s *IssuanceSuite) TestSettleIssuance() {
 		investor05.Hex(), baseTime, baseTime, closesAt,
 		investor01.Hex(), baseTime, baseTime, closesAt,
 		baseTime, closesAt, maturityAt, closesAt)
-	s.Equal(expectedCloseIssuanceOutput, string(closeIssuanceOutput.Notices[0].Payload))
+	s.Equal(expectedCloseIssuanceOutput, s.envelopeData("issuance.closed", closeIssuanceOutput.Notices[0].Payload))
 
 	// verify number of vouchers for badge safeERC1155MintAddress delegate calls (closeIssuance - Bond Certificates)
 	s.Len(closeIssuanceOutput.DelegateCallVouchers, 5)
@@ -1432,30 +1449,31 @@ func (s *IssuanceSuite) TestSettleIssuance() {
 	// Withdraw raised amount (creator receives 95% of total raised = 95000, 5% goes to admin as fee)
 	withdrawRaisedAmountInput := []byte(fmt.Sprintf(`{"path":"user/withdraw","data":{"token":"%s","amount":"95000"}}`, token.Hex()))
 	withdrawRaisedAmountOutput := s.Tester.Advance(creator, withdrawRaisedAmountInput)
-	s.Len(withdrawRaisedAmountOutput.Notices, 1)
+	s.Len(withdrawRaisedAmountOutput.Notices, 2)
 
-	expectedWithdrawRaisedAmountOutput := fmt.Sprintf(`ERC20 withdrawn - token: %s, amount: 95000, user: %s`, token.Hex(), creator.Hex())
-	s.Equal(expectedWithdrawRaisedAmountOutput, string(withdrawRaisedAmountOutput.Notices[0].Payload))
+	expectedWithdrawRaisedAmountOutput := fmt.Sprintf(`{"token":"%s","amount":"95000","user":"%s"}`, token.Hex(), creator.Hex())
+	s.Equal(expectedWithdrawRaisedAmountOutput, s.envelopeData("user.erc20_withdrawn", withdrawRaisedAmountOutput.Notices[0].Payload))
 
-	time.Sleep(5 * time.Second)
+	s.wait(5)
 
 	settleIssuanceInput := []byte(`{"path":"issuance/creator/settle", "data":{"id":1}}`)
 	settleIssuanceOutput := s.Tester.DepositERC20(token, creator, big.NewInt(108195), settleIssuanceInput)
-	s.Len(settleIssuanceOutput.Notices, 1)
+	s.Len(settleIssuanceOutput.Notices, 2)
 
 	settledAt := baseTime + 10
 
-	expectedSettleIssuanceOutput := fmt.Sprintf(`issuance settled - {"id":1,"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"%s","creator":{"id":3,"role":"creator","address":"%s","social_accounts":[{"id":1,"user_id":3,"username":"test","platform":"twitter","created_at":%d}],"created_at":%d,"updated_at":0},"collateral":"%s","collateral_amount":"10000","badge_address":"%s","debt_issued":"100000","max_interest_rate":"1000","total_obligation":"108195","total_raised":"100000","state":"settled","orders":[`+
-		`{"id":1,"issuance_id":1,"investor":{"id":4,"role":"investor","address":"%s","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"59500","interest_rate":"900","state":"settled","created_at":%d,"updated_at":%d},`+
-		`{"id":2,"issuance_id":1,"investor":{"id":5,"role":"investor","address":"%s","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"28000","interest_rate":"800","state":"settled","created_at":%d,"updated_at":%d},`+
-		`{"id":3,"issuance_id":1,"investor":{"id":6,"role":"investor","address":"%s","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"2000","interest_rate":"400","state":"settled","created_at":%d,"updated_at":%d},`+
-		`{"id":4,"issuance_id":1,"investor":{"id":7,"role":"investor","address":"%s","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"5000","interest_rate":"600","state":"settled","created_at":%d,"updated_at":%d},`+
-		`{"id":5,"issuance_id":1,"investor":{"id":8,"role":"investor","address":"%s","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"5500","interest_rate":"400","state":"settled","created_at":%d,"updated_at":%d},`+
-		`{"id":6,"issuance_id":1,"investor":{"id":4,"role":"investor","address":"%s","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"500","interest_rate":"900","state":"rejected","created_at":%d,"updated_at":%d}],`+
+	expectedSettleIssuanceOutput := fmt.Sprintf(`{"id":1,"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"%s","creator":{"id":3,"roles":["creator"],"address":"%s","status":"active","social_accounts":[{"id":1,"user_id":3,"username":"test","platform":"twitter","created_at":%d}],"reputation":%s,"created_at":%d,"updated_at":0},"collateral":"%s","collateral_amount":"10000","badge_address":"%s","debt_issued":"100000","max_interest_rate":"1000","total_obligation":"108195","total_raised":"100000","state":"settled","orders":[`+
+		`{"id":1,"issuance_id":1,"investor":{"id":4,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"59500","interest_rate":"900","state":"settled","created_at":%d,"updated_at":%d},`+
+		`{"id":2,"issuance_id":1,"investor":{"id":5,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"28000","interest_rate":"800","state":"settled","created_at":%d,"updated_at":%d},`+
+		`{"id":3,"issuance_id":1,"investor":{"id":6,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"2000","interest_rate":"400","state":"settled","created_at":%d,"updated_at":%d},`+
+		`{"id":4,"issuance_id":1,"investor":{"id":7,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"5000","interest_rate":"600","state":"settled","created_at":%d,"updated_at":%d},`+
+		`{"id":5,"issuance_id":1,"investor":{"id":8,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"5500","interest_rate":"400","state":"settled","created_at":%d,"updated_at":%d},`+
+		`{"id":6,"issuance_id":1,"investor":{"id":4,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"500","interest_rate":"900","state":"rejected","created_at":%d,"updated_at":%d}],`+
 		`"created_at":%d,"closes_at":%d,"maturity_at":%d,"updated_at":%d}`,
 		token.Hex(),
 		creator.Hex(),
 		baseTime,
+		reputationJSON(600, 1, 1, 0, 0, 0, "100000", settledAt),
 		baseTime,
 		collateral.Hex(),
 		badgeAddress.Hex(),
@@ -1466,7 +1484,7 @@ func (s *IssuanceSuite) TestSettleIssuance() {
 		investor05.Hex(), baseTime, baseTime, settledAt,
 		investor01.Hex(), baseTime, baseTime, closesAt,
 		baseTime, closesAt, maturityAt, settledAt)
-	s.Equal(expectedSettleIssuanceOutput, string(settleIssuanceOutput.Notices[0].Payload))
+	s.Equal(expectedSettleIssuanceOutput, s.envelopeData("issuance.settled", settleIssuanceOutput.Notices[0].Payload))
 
 	// Verify final balances after issuance settlement
 	// investor01: should receive 59500 + (59500 * 9% = 5355) = 64855
@@ -1480,37 +1498,37 @@ func (s *IssuanceSuite) TestSettleIssuance() {
 	erc20BalanceInput := []byte(fmt.Sprintf(`{"path":"user/balance","data":{"address":"%s","token":"%s"}}`, investor01.Hex(), token.Hex()))
 	erc20BalanceOutput := s.Tester.Inspect(erc20BalanceInput)
 	s.Len(erc20BalanceOutput.Reports, 1)
-	s.Equal(`"65355"`, string(erc20BalanceOutput.Reports[0].Payload))
+	s.Equal(`"65355"`, s.envelopeData("user.balance", erc20BalanceOutput.Reports[0].Payload))
 
 	// Verify investor02 balance (received 30240)
 	erc20BalanceInput = []byte(fmt.Sprintf(`{"path":"user/balance","data":{"address":"%s","token":"%s"}}`, investor02.Hex(), token.Hex()))
 	erc20BalanceOutput = s.Tester.Inspect(erc20BalanceInput)
 	s.Len(erc20BalanceOutput.Reports, 1)
-	s.Equal(`"30240"`, string(erc20BalanceOutput.Reports[0].Payload))
+	s.Equal(`"30240"`, s.envelopeData("user.balance", erc20BalanceOutput.Reports[0].Payload))
 
 	// Verify investor03 balance (received 2080)
 	erc20BalanceInput = []byte(fmt.Sprintf(`{"path":"user/balance","data":{"address":"%s","token":"%s"}}`, investor03.Hex(), token.Hex()))
 	erc20BalanceOutput = s.Tester.Inspect(erc20BalanceInput)
 	s.Len(erc20BalanceOutput.Reports, 1)
-	s.Equal(`"2080"`, string(erc20BalanceOutput.Reports[0].Payload))
+	s.Equal(`"2080"`, s.envelopeData("user.balance", erc20BalanceOutput.Reports[0].Payload))
 
 	// Verify investor04 balance (received 5300)
 	erc20BalanceInput = []byte(fmt.Sprintf(`{"path":"user/balance","data":{"address":"%s","token":"%s"}}`, investor04.Hex(), token.Hex()))
 	erc20BalanceOutput = s.Tester.Inspect(erc20BalanceInput)
 	s.Len(erc20BalanceOutput.Reports, 1)
-	s.Equal(`"5300"`, string(erc20BalanceOutput.Reports[0].Payload))
+	s.Equal(`"5300"`, s.envelopeData("user.balance", erc20BalanceOutput.Reports[0].Payload))
 
 	// Verify investor05 balance (received 5720)
 	erc20BalanceInput = []byte(fmt.Sprintf(`{"path":"user/balance","data":{"address":"%s","token":"%s"}}`, investor05.Hex(), token.Hex()))
 	erc20BalanceOutput = s.Tester.Inspect(erc20BalanceInput)
 	s.Len(erc20BalanceOutput.Reports, 1)
-	s.Equal(`"5720"`, string(erc20BalanceOutput.Reports[0].Payload))
+	s.Equal(`"5720"`, s.envelopeData("user.balance", erc20BalanceOutput.Reports[0].Payload))
 
 	// Verify creator balance (had 95000 after fee, paid 108195, so should be 0 as he deposited additional amount)
 	erc20BalanceInput = []byte(fmt.Sprintf(`{"path":"user/balance","data":{"address":"%s","token":"%s"}}`, creator.Hex(), token.Hex()))
 	erc20BalanceOutput = s.Tester.Inspect(erc20BalanceInput)
 	s.Len(erc20BalanceOutput.Reports, 1)
-	s.Equal(`"0"`, string(erc20BalanceOutput.Reports[0].Payload))
+	s.Equal(`"0"`, s.envelopeData("user.balance", erc20BalanceOutput.Reports[0].Payload))
 
 	// Verify settle issuance outputs - ERC1155 Discharge Certificates (token ID 2)
 	// settleIssuanceOutput should have delegate call vouchers for each settled order
@@ -0,0 +1,123 @@
+package integration
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestLedgerSuite(t *testing.T) {
+	suite.Run(t, new(LedgerSuite))
+}
+
+type LedgerSuite struct {
+	DCMRollupSuite
+}
+
+func (s *LedgerSuite) TestLedgerRecordsEveryTransfer() {
+	admin, token, creator, _, verifier, collateral, _, application := s.setupCommonAddresses()
+	investor01, investor02, _, _, _ := s.setupInvestorAddresses()
+	_, closesAt, maturityAt := s.setupTimeValues()
+
+	createUserInput := []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"creator"}}`, creator))
+	s.Require().NoError(s.Tester.Advance(admin, createUserInput).Err)
+	for _, investor := range []common.Address{investor01, investor02} {
+		createUserInput = []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"investor"}}`, investor))
+		s.Require().NoError(s.Tester.Advance(admin, createUserInput).Err)
+	}
+	s.createSocialAccount(verifier, creatorKey, "test", "twitter")
+
+	createIssuanceInput := []byte(fmt.Sprintf(`{"path":"issuance/creator/create","data":{"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"%s","max_interest_rate":"1000","debt_issued":"100000","closes_at":%d,"maturity_at":%d}}`,
+		token, closesAt, maturityAt))
+	createIssuanceOutput := s.Tester.DepositERC20(collateral, creator, big.NewInt(10000), createIssuanceInput)
+	s.Require().NoError(createIssuanceOutput.Err)
+
+	createOrderInput := []byte(`{"path":"order/create","data":{"issuance_id":1,"interest_rate":"900"}}`)
+	createOrder01Output := s.Tester.DepositERC20(token, investor01, big.NewInt(60000), createOrderInput)
+	s.Require().NoError(createOrder01Output.Err)
+	createOrderInput = []byte(`{"path":"order/create","data":{"issuance_id":1,"interest_rate":"800"}}`)
+	createOrder02Output := s.Tester.DepositERC20(token, investor02, big.NewInt(50000), createOrderInput)
+	s.Require().NoError(createOrder02Output.Err)
+	createdAt := s.Now
+
+	s.wait(5)
+
+	anyone := common.HexToAddress("0x0000000000000000000000000000000000000001")
+	closeIssuanceInput := []byte(fmt.Sprintf(`{"path":"issuance/close","data":{"creator_address":"%s"}}`, creator))
+	closeIssuanceOutput := s.Tester.Advance(anyone, closeIssuanceInput)
+	s.Require().NoError(closeIssuanceOutput.Err)
+	closedAt := s.Now
+
+	// the remainder of the partially accepted order of investor01 is refunded as order 3
+	findEntriesOutput := s.Tester.Inspect([]byte(`{"path":"ledger/entries","data":{"issuance_id":1}}`))
+	s.Require().NoError(findEntriesOutput.Err)
+	expectedEntriesOutput := fmt.Sprintf(`[`+
+		`{"id":1,"debit_account":"%s","credit_account":"%s","token":"%s","amount":"10000","reason":"collateral_deposit","issuance_id":1,"input_index":%d,"created_at":%d},`+
+		`{"id":2,"debit_account":"%s","credit_account":"%s","token":"%s","amount":"60000","reason":"order_escrow","issuance_id":1,"order_id":1,"input_index":%d,"created_at":%d},`+
+		`{"id":3,"debit_account":"%s","credit_account":"%s","token":"%s","amount":"50000","reason":"order_escrow","issuance_id":1,"order_id":2,"input_index":%d,"created_at":%d},`+
+		`{"id":4,"debit_account":"%s","credit_account":"%s","token":"%s","amount":"10000","reason":"order_reject_refund","issuance_id":1,"order_id":3,"input_index":%d,"created_at":%d},`+
+		`{"id":5,"debit_account":"%s","credit_account":"%s","token":"%s","amount":"5000","reason":"issuance_fee","issuance_id":1,"input_index":%d,"created_at":%d},`+
+		`{"id":6,"debit_account":"%s","credit_account":"%s","token":"%s","amount":"95000","reason":"issuance_proceeds","issuance_id":1,"input_index":%d,"created_at":%d}]`,
+		application, creator, collateral, createIssuanceOutput.Index, createdAt,
+		application, investor01, token, createOrder01Output.Index, createdAt,
+		application, investor02, token, createOrder02Output.Index, createdAt,
+		investor01, application, token, closeIssuanceOutput.Index, closedAt,
+		admin, application, token, closeIssuanceOutput.Index, closedAt,
+		creator, application, token, closeIssuanceOutput.Index, closedAt)
+	s.Equal(expectedEntriesOutput, s.envelopeData("ledger.entries", findEntriesOutput.Reports[0].Payload))
+
+	// the application keeps the collateral and nothing of the raised token
+	reconcileOutput := s.Tester.Inspect([]byte(`{"path":"ledger/reconciliation"}`))
+	s.Require().NoError(reconcileOutput.Err)
+	expectedReconcileOutput := fmt.Sprintf(`{"account":"%s","reconciled":true,"tokens":[`+
+		`{"token":"%s","debits":"10000","credits":"0","wallet_balance":"10000","reconciled":true},`+
+		`{"token":"%s","debits":"110000","credits":"110000","wallet_balance":"0","reconciled":true}]}`,
+		application, collateral, token)
+	s.Equal(expectedReconcileOutput, s.envelopeData("ledger.reconciliation", reconcileOutput.Reports[0].Payload))
+}
+
+func (s *LedgerSuite) TestLedgerRecordsCanceledOrderRefund() {
+	admin, token, creator, _, verifier, collateral, _, application := s.setupCommonAddresses()
+	investor01, _, _, _, _ := s.setupInvestorAddresses()
+	_, closesAt, maturityAt := s.setupTimeValues()
+
+	createUserInput := []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"creator"}}`, creator))
+	s.Require().NoError(s.Tester.Advance(admin, createUserInput).Err)
+
+	// the application address is only known after the first advance
+	reconcileOutput := s.Tester.Inspect([]byte(`{"path":"ledger/reconciliation"}`))
+	s.Require().NoError(reconcileOutput.Err)
+	s.Equal(fmt.Sprintf(`{"account":"%s","reconciled":true,"tokens":[]}`, application),
+		s.envelopeData("ledger.reconciliation", reconcileOutput.Reports[0].Payload))
+
+	createUserInput = []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"investor"}}`, investor01))
+	s.Require().NoError(s.Tester.Advance(admin, createUserInput).Err)
+	s.createSocialAccount(verifier, creatorKey, "test", "twitter")
+
+	createIssuanceInput := []byte(fmt.Sprintf(`{"path":"issuance/creator/create","data":{"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"%s","max_interest_rate":"1000","debt_issued":"100000","closes_at":%d,"maturity_at":%d}}`,
+		token, closesAt, maturityAt))
+	s.Require().NoError(s.Tester.DepositERC20(collateral, creator, big.NewInt(10000), createIssuanceInput).Err)
+
+	createOrderInput := []byte(`{"path":"order/create","data":{"issuance_id":1,"interest_rate":"700"}}`)
+	createOrderOutput := s.Tester.DepositERC20(token, investor01, big.NewInt(20000), createOrderInput)
+	s.Require().NoError(createOrderOutput.Err)
+	cancelOrderOutput := s.Tester.Advance(investor01, []byte(`{"path":"order/cancel","data":{"id":1}}`))
+	s.Require().NoError(cancelOrderOutput.Err)
+
+	findEntriesInput := []byte(fmt.Sprintf(`{"path":"ledger/entries","data":{"account":"%s"}}`, investor01))
+	findEntriesOutput := s.Tester.Inspect(findEntriesInput)
+	s.Require().NoError(findEntriesOutput.Err)
+	expectedEntriesOutput := fmt.Sprintf(`[`+
+		`{"id":2,"debit_account":"%s","credit_account":"%s","token":"%s","amount":"20000","reason":"order_escrow","issuance_id":1,"order_id":1,"input_index":%d,"created_at":%d},`+
+		`{"id":3,"debit_account":"%s","credit_account":"%s","token":"%s","amount":"20000","reason":"order_cancel_refund","issuance_id":1,"order_id":1,"input_index":%d,"created_at":%d}]`,
+		application, investor01, token, createOrderOutput.Index, s.Now,
+		investor01, application, token, cancelOrderOutput.Index, s.Now)
+	s.Equal(expectedEntriesOutput, s.envelopeData("ledger.entries", findEntriesOutput.Reports[0].Payload))
+
+	reconcileOutput = s.Tester.Inspect([]byte(`{"path":"ledger/reconciliation"}`))
+	s.Require().NoError(reconcileOutput.Err)
+	s.Contains(s.envelopeData("ledger.reconciliation", reconcileOutput.Reports[0].Payload), `"reconciled":true,"tokens"`)
+}
@@ -0,0 +1,210 @@
+package integration
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/configs"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository/sqlite"
+	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+func TestMigrationSuite(t *testing.T) {
+	suite.Run(t, new(MigrationSuite))
+}
+
+type MigrationSuite struct {
+	suite.Suite
+}
+
+// connect opens a database file loaded with a fixture, without migrating it.
+func (s *MigrationSuite) connect(fixture string) *sqlite.SQLiteRepository {
+	conn := "sqlite://" + filepath.Join(s.T().TempDir(), "rollup.db")
+	repo, err := sqlite.ConnectSQLiteRepository(context.Background(), conn)
+	s.Require().NoError(err)
+	s.T().Cleanup(func() { repo.Close() })
+
+	if fixture != "" {
+		content, err := os.ReadFile(filepath.Join("testdata", fixture))
+		s.Require().NoError(err)
+		s.Require().NoError(repo.Db.Exec(string(content)).Error)
+	}
+	return repo
+}
+
+// requireSchemaOfEntities checks that every column of every entity exists.
+func requireSchemaOfEntities(s *suite.Suite, db *gorm.DB) {
+	entities := []any{
+		&entity.Issuance{},
+		&entity.Order{},
+		&entity.User{},
+		&entity.UserRoleAssignment{},
+		&entity.AddressRotation{},
+		&entity.SocialAccount{},
+		&entity.SocialAccountChallenge{},
+		&entity.PlatformSetting{},
+		&entity.CreatorReputation{},
+		&entity.Attestation{},
+		&entity.Proposal{},
+		&entity.ProposalApproval{},
+		&entity.EmergencyWithdrawal{},
+		&entity.LedgerEntry{},
+		&entity.StateLeaf{},
+		&entity.Genesis{},
+		&entity.AllowedToken{},
+		&entity.Migration{},
+	}
+	for _, model := range entities {
+		stmt := &gorm.Statement{DB: db}
+		s.Require().NoError(stmt.Parse(model))
+		s.Require().True(db.Migrator().HasTable(stmt.Schema.Table), "missing table %s", stmt.Schema.Table)
+		for _, column := range stmt.Schema.DBNames {
+			s.True(db.Migrator().HasColumn(model, column), "missing column %s.%s", stmt.Schema.Table, column)
+		}
+	}
+}
+
+func (s *MigrationSuite) TestMigrateEmptyDatabase() {
+	repo := s.connect("")
+
+	status, err := repo.MigrationStatus()
+	s.Require().NoError(err)
+	s.Require().NotEmpty(status)
+	for _, migration := range status {
+		s.Zero(migration.AppliedAt)
+	}
+
+	applied, err := repo.MigrateUp()
+	s.Require().NoError(err)
+	s.Len(applied, len(status))
+	requireSchemaOfEntities(&s.Suite, repo.Db)
+
+	status, err = repo.MigrationStatus()
+	s.Require().NoError(err)
+	for _, migration := range status {
+		s.NotZero(migration.AppliedAt)
+	}
+
+	// applying again is a no-op
+	applied, err = repo.MigrateUp()
+	s.Require().NoError(err)
+	s.Empty(applied)
+}
+
+func (s *MigrationSuite) TestMigrateDatabaseCreatedByAutoMigrate() {
+	application := HexToAddress("0xab7528bb862fb57e8a2bcd567a2e929a0be56a5e")
+	s.T().Setenv("APPLICATION_ADDRESS", application.Hex())
+	repo := s.connect("pre_migrations.sql")
+	s.True(repo.Db.Migrator().HasColumn("users", "role"))
+	s.False(repo.Db.Migrator().HasTable(&entity.UserRoleAssignment{}))
+
+	applied, err := repo.MigrateUp()
+	s.Require().NoError(err)
+	status, err := repo.MigrationStatus()
+	s.Require().NoError(err)
+	s.Require().Len(applied, len(status))
+	s.Equal("create_schema", applied[0].Name)
+	requireSchemaOfEntities(&s.Suite, repo.Db)
+	s.False(repo.Db.Migrator().HasColumn("users", "role"))
+
+	// the rows are kept, with the defaults of the columns added since
+	issuance, err := repo.FindIssuanceById(1)
+	s.Require().NoError(err)
+	s.Equal("100000", issuance.DebtIssued.Dec())
+	s.Empty(issuance.RequiredCreatorAttestations)
+	s.Require().Len(issuance.Orders, 1)
+	s.Equal("5000", issuance.Orders[0].Amount.Dec())
+	users, err := repo.FindAllUsers()
+	s.Require().NoError(err)
+	s.Require().Len(users, 4)
+	for _, user := range users {
+		s.Equal(entity.UserStatusActive, user.Status)
+		s.Zero(user.DeactivatedAt)
+	}
+
+	// every user keeps the single role it had
+	roles := map[string][]string{
+		"0xD554153658E8D466428Fa48487f5aba18dF5E628": {"admin"},
+		"0xc2D8eb4a934AEc7268E414a3Fa3D20E0572d714b": {"verifier"},
+		"0x70997970C51812dc3A010C7d01b50e0d17dc79C8": {"creator"},
+		"0x0000000000000000000000000000000000000001": {"investor"},
+	}
+	for address, names := range roles {
+		user, err := repo.FindUserByAddress(HexToAddress(address))
+		s.Require().NoError(err)
+		s.Equal(names, user.RoleNames(), address)
+		s.Require().Len(user.Roles, 1)
+		s.Equal(user.CreatedAt, user.Roles[0].CreatedAt)
+	}
+	admin, err := repo.FindUserByAddress(HexToAddress("0xD554153658E8D466428Fa48487f5aba18dF5E628"))
+	s.Require().NoError(err)
+	s.True(admin.HasPermission(entity.PermissionRoleManage))
+	accounts, err := repo.FindSocialAccountsByUserId(3)
+	s.Require().NoError(err)
+	s.Require().Len(accounts, 1)
+	s.Equal("creator", accounts[0].Username)
+	s.Zero(accounts[0].Evidence.ChallengeId)
+
+	// the upgraded tables take new rows
+	user, err := entity.NewUser(string(entity.UserRoleInvestor), HexToAddress("0x0000000000000000000000000000000000000002"), 1700000300)
+	s.Require().NoError(err)
+	_, err = repo.CreateUser(user)
+	s.Require().NoError(err)
+
+	// the collateral the open issuances deposited before the ledger is recorded
+	entries, err := repo.FindLedgerEntriesByIssuanceId(1)
+	s.Require().NoError(err)
+	s.Require().Len(entries, 1)
+	s.Equal(entity.LedgerReasonCollateralDeposit, entries[0].Reason)
+	s.Equal(application, entries[0].DebitAccount)
+	s.Equal(issuance.CreatorAddress, entries[0].CreditAccount)
+	s.Equal(issuance.CollateralAddress, entries[0].Token)
+	s.Equal("10000", entries[0].Amount.Dec())
+	s.Equal(issuance.CreatedAt, entries[0].CreatedAt)
+	_, err = entity.NewLedgerEntry(entries[0].DebitAccount, entries[0].CreditAccount, entries[0].Token, entries[0].Amount,
+		entries[0].Reason, entries[0].IssuanceId, entries[0].OrderId, entries[0].InputIndex, entries[0].CreatedAt)
+	s.NoError(err)
+
+	// a settled issuance no longer holds its collateral
+	entries, err = repo.FindLedgerEntriesByIssuanceId(2)
+	s.Require().NoError(err)
+	s.Empty(entries)
+}
+
+func (s *MigrationSuite) TestBackfillRequiresApplicationAddress() {
+	s.T().Setenv("APPLICATION_ADDRESS", "")
+	repo := s.connect("pre_migrations.sql")
+
+	_, err := repo.MigrateUp()
+	s.ErrorContains(err, "backfill_collateral_deposits")
+	s.ErrorIs(err, configs.ErrNotDefined)
+
+	// the migrations before it stay applied
+	status, err := repo.MigrationStatus()
+	s.Require().NoError(err)
+	last := status[len(status)-1]
+	s.Equal("backfill_collateral_deposits", last.Name)
+	s.Zero(last.AppliedAt)
+	s.NotZero(status[len(status)-2].AppliedAt)
+
+	// an empty database has nothing to backfill
+	_, err = s.connect("").MigrateUp()
+	s.NoError(err)
+}
+
+func (s *MigrationSuite) TestRejectsUnknownMigration() {
+	repo := s.connect("")
+	_, err := repo.MigrateUp()
+	s.Require().NoError(err)
+	s.Require().NoError(repo.Db.Create(&entity.Migration{Version: 1000, Name: "from_the_future", AppliedAt: 1}).Error)
+
+	_, err = repo.MigrationStatus()
+	s.ErrorContains(err, "newer than the last migration")
+	_, err = repo.MigrateUp()
+	s.ErrorContains(err, "newer than the last migration")
+}
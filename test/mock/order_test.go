@@ -26,8 +26,7 @@ func (s *OrderSuite) TestCreateOrder() {
 	s.Tester.Advance(admin, createUserInput)
 
 	// verify social account
-	createSocialAccountInput := []byte(fmt.Sprintf(`{"path":"social/verifier/create","data":{"address":"%s","username":"test","platform":"twitter"}}`, creator))
-	s.Tester.Advance(verifier, createSocialAccountInput)
+	s.createSocialAccount(verifier, creatorKey, "test", "twitter")
 
 	// create issuance
 	createIssuanceInput := []byte(fmt.Sprintf(`{"path":"issuance/creator/create","data":{"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"%s","max_interest_rate":"1000","debt_issued":"100000","closes_at":%d,"maturity_at":%d}}`,
@@ -44,13 +43,13 @@ func (s *OrderSuite) TestCreateOrder() {
 	// create order
 	createOrderInput := []byte(`{"path": "order/create", "data": {"issuance_id":1,"interest_rate":"900"}}`)
 	createOrderOutput := s.Tester.DepositERC20(token, investor01, big.NewInt(10000), createOrderInput)
-	s.Len(createOrderOutput.Notices, 1)
+	s.Len(createOrderOutput.Notices, 2)
 
-	expectedCreateOrderOutput := fmt.Sprintf(`order created - {"id":1,"issuance_id":1,"investor":{"id":4,"role":"investor","address":"%s","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"10000","interest_rate":"900","state":"pending","created_at":%d}`,
+	expectedCreateOrderOutput := fmt.Sprintf(`{"id":1,"issuance_id":1,"investor":{"id":4,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"10000","interest_rate":"900","state":"pending","created_at":%d}`,
 		investor01,
 		baseTime,
 		baseTime)
-	s.Equal(expectedCreateOrderOutput, string(createOrderOutput.Notices[0].Payload))
+	s.Equal(expectedCreateOrderOutput, s.envelopeData("order.created", createOrderOutput.Notices[0].Payload))
 }
 
 func (s *OrderSuite) TestFindAllOrders() {
@@ -62,8 +61,7 @@ func (s *OrderSuite) TestFindAllOrders() {
 	createUserInput := []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"creator"}}`, creator))
 	s.Tester.Advance(admin, createUserInput)
 
-	createSocialAccountInput := []byte(fmt.Sprintf(`{"path":"social/verifier/create","data":{"address":"%s","username":"test","platform":"twitter"}}`, creator))
-	s.Tester.Advance(verifier, createSocialAccountInput)
+	s.createSocialAccount(verifier, creatorKey, "test", "twitter")
 
 	createIssuanceInput := []byte(fmt.Sprintf(`{"path":"issuance/creator/create","data":{"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"%s","max_interest_rate":"1000","debt_issued":"100000","closes_at":%d,"maturity_at":%d}}`,
 		token,
@@ -91,10 +89,10 @@ func (s *OrderSuite) TestFindAllOrders() {
 	findAllOrdersOutput := s.Tester.Inspect(findAllOrdersInput)
 	s.Len(findAllOrdersOutput.Reports, 1)
 
-	expectedFindAllOrdersOutput := fmt.Sprintf(`[{"id":1,"issuance_id":1,"investor":{"id":4,"role":"investor","address":"%s","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"10000","interest_rate":"900","state":"pending","created_at":%d,"updated_at":0},{"id":2,"issuance_id":1,"investor":{"id":5,"role":"investor","address":"%s","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"20000","interest_rate":"800","state":"pending","created_at":%d,"updated_at":0}]`,
+	expectedFindAllOrdersOutput := fmt.Sprintf(`[{"id":1,"issuance_id":1,"investor":{"id":4,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"10000","interest_rate":"900","state":"pending","created_at":%d,"updated_at":0},{"id":2,"issuance_id":1,"investor":{"id":5,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"20000","interest_rate":"800","state":"pending","created_at":%d,"updated_at":0}]`,
 		investor01, baseTime, baseTime,
 		investor02, baseTime, baseTime)
-	s.Equal(expectedFindAllOrdersOutput, string(findAllOrdersOutput.Reports[0].Payload))
+	s.Equal(expectedFindAllOrdersOutput, s.envelopeData("order.list", findAllOrdersOutput.Reports[0].Payload))
 }
 
 func (s *OrderSuite) TestFindOrderById() {
@@ -106,8 +104,7 @@ func (s *OrderSuite) TestFindOrderById() {
 	createUserInput := []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"creator"}}`, creator))
 	s.Tester.Advance(admin, createUserInput)
 
-	createSocialAccountInput := []byte(fmt.Sprintf(`{"path":"social/verifier/create","data":{"address":"%s","username":"test","platform":"twitter"}}`, creator))
-	s.Tester.Advance(verifier, createSocialAccountInput)
+	s.createSocialAccount(verifier, creatorKey, "test", "twitter")
 
 	createIssuanceInput := []byte(fmt.Sprintf(`{"path":"issuance/creator/create","data":{"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"%s","max_interest_rate":"1000","debt_issued":"100000","closes_at":%d,"maturity_at":%d}}`,
 		token,
@@ -127,9 +124,9 @@ func (s *OrderSuite) TestFindOrderById() {
 	findOrderByIdOutput := s.Tester.Inspect(findOrderByIdInput)
 	s.Len(findOrderByIdOutput.Reports, 1)
 
-	expectedFindOrderByIdOutput := fmt.Sprintf(`{"id":1,"issuance_id":1,"investor":{"id":4,"role":"investor","address":"%s","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"10000","interest_rate":"900","state":"pending","created_at":%d,"updated_at":0}`,
+	expectedFindOrderByIdOutput := fmt.Sprintf(`{"id":1,"issuance_id":1,"investor":{"id":4,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"10000","interest_rate":"900","state":"pending","created_at":%d,"updated_at":0}`,
 		investor01, baseTime, baseTime)
-	s.Equal(expectedFindOrderByIdOutput, string(findOrderByIdOutput.Reports[0].Payload))
+	s.Equal(expectedFindOrderByIdOutput, s.envelopeData("order", findOrderByIdOutput.Reports[0].Payload))
 }
 
 func (s *OrderSuite) TestFindOrdersByIssuanceId() {
@@ -141,8 +138,7 @@ func (s *OrderSuite) TestFindOrdersByIssuanceId() {
 	createUserInput := []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"creator"}}`, creator))
 	s.Tester.Advance(admin, createUserInput)
 
-	createSocialAccountInput := []byte(fmt.Sprintf(`{"path":"social/verifier/create","data":{"address":"%s","username":"test","platform":"twitter"}}`, creator))
-	s.Tester.Advance(verifier, createSocialAccountInput)
+	s.createSocialAccount(verifier, creatorKey, "test", "twitter")
 
 	createIssuanceInput := []byte(fmt.Sprintf(`{"path":"issuance/creator/create","data":{"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"%s","max_interest_rate":"1000","debt_issued":"100000","closes_at":%d,"maturity_at":%d}}`,
 		token,
@@ -168,10 +164,10 @@ func (s *OrderSuite) TestFindOrdersByIssuanceId() {
 	findOrdersByIssuanceIdOutput := s.Tester.Inspect(findOrdersByIssuanceIdInput)
 	s.Len(findOrdersByIssuanceIdOutput.Reports, 1)
 
-	expectedFindOrdersByIssuanceIdOutput := fmt.Sprintf(`[{"id":1,"issuance_id":1,"investor":{"id":4,"role":"investor","address":"%s","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"10000","interest_rate":"900","state":"pending","created_at":%d,"updated_at":0},{"id":2,"issuance_id":1,"investor":{"id":5,"role":"investor","address":"%s","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"20000","interest_rate":"800","state":"pending","created_at":%d,"updated_at":0}]`,
+	expectedFindOrdersByIssuanceIdOutput := fmt.Sprintf(`[{"id":1,"issuance_id":1,"investor":{"id":4,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"10000","interest_rate":"900","state":"pending","created_at":%d,"updated_at":0},{"id":2,"issuance_id":1,"investor":{"id":5,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"20000","interest_rate":"800","state":"pending","created_at":%d,"updated_at":0}]`,
 		investor01, baseTime, baseTime,
 		investor02, baseTime, baseTime)
-	s.Equal(expectedFindOrdersByIssuanceIdOutput, string(findOrdersByIssuanceIdOutput.Reports[0].Payload))
+	s.Equal(expectedFindOrdersByIssuanceIdOutput, s.envelopeData("order.list", findOrdersByIssuanceIdOutput.Reports[0].Payload))
 }
 
 func (s *OrderSuite) TestFindOrdersByInvestorAddress() {
@@ -183,8 +179,7 @@ func (s *OrderSuite) TestFindOrdersByInvestorAddress() {
 	createUserInput := []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"creator"}}`, creator))
 	s.Tester.Advance(admin, createUserInput)
 
-	createSocialAccountInput := []byte(fmt.Sprintf(`{"path":"social/verifier/create","data":{"address":"%s","username":"test","platform":"twitter"}}`, creator))
-	s.Tester.Advance(verifier, createSocialAccountInput)
+	s.createSocialAccount(verifier, creatorKey, "test", "twitter")
 
 	createIssuanceInput := []byte(fmt.Sprintf(`{"path":"issuance/creator/create","data":{"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"%s","max_interest_rate":"1000","debt_issued":"100000","closes_at":%d,"maturity_at":%d}}`,
 		token,
@@ -204,9 +199,9 @@ func (s *OrderSuite) TestFindOrdersByInvestorAddress() {
 	findOrdersByInvestorAddressOutput := s.Tester.Inspect(findOrdersByInvestorAddressInput)
 	s.Len(findOrdersByInvestorAddressOutput.Reports, 1)
 
-	expectedFindOrdersByInvestorAddressOutput := fmt.Sprintf(`[{"id":1,"issuance_id":1,"investor":{"id":4,"role":"investor","address":"%s","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"10000","interest_rate":"900","state":"pending","created_at":%d,"updated_at":0}]`,
+	expectedFindOrdersByInvestorAddressOutput := fmt.Sprintf(`[{"id":1,"issuance_id":1,"investor":{"id":4,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"10000","interest_rate":"900","state":"pending","created_at":%d,"updated_at":0}]`,
 		investor01, baseTime, baseTime)
-	s.Equal(expectedFindOrdersByInvestorAddressOutput, string(findOrdersByInvestorAddressOutput.Reports[0].Payload))
+	s.Equal(expectedFindOrdersByInvestorAddressOutput, s.envelopeData("order.list", findOrdersByInvestorAddressOutput.Reports[0].Payload))
 }
 
 func (s *OrderSuite) TestCancelOrder() {
@@ -218,8 +213,7 @@ func (s *OrderSuite) TestCancelOrder() {
 	createUserInput := []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"creator"}}`, creator))
 	s.Tester.Advance(admin, createUserInput)
 
-	createSocialAccountInput := []byte(fmt.Sprintf(`{"path":"social/verifier/create","data":{"address":"%s","username":"test","platform":"twitter"}}`, creator))
-	s.Tester.Advance(verifier, createSocialAccountInput)
+	s.createSocialAccount(verifier, creatorKey, "test", "twitter")
 
 	createIssuanceInput := []byte(fmt.Sprintf(`{"path":"issuance/creator/create","data":{"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"%s","max_interest_rate":"1000","debt_issued":"100000","closes_at":%d,"maturity_at":%d}}`,
 		token,
@@ -237,9 +231,20 @@ func (s *OrderSuite) TestCancelOrder() {
 	// Cancel order
 	cancelOrderInput := []byte(`{"path":"order/cancel","data":{"id":1}}`)
 	cancelOrderOutput := s.Tester.Advance(investor01, cancelOrderInput)
-	s.Len(cancelOrderOutput.Notices, 1)
+	s.Len(cancelOrderOutput.Notices, 2)
 
-	expectedCancelOrderOutput := fmt.Sprintf(`order canceled - {"id":1,"issuance_id":1,"token":"%s","investor":{"id":4,"role":"investor","address":"%s","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"10000","interest_rate":"900","state":"canceled","created_at":%d,"updated_at":%d}`,
+	expectedCancelOrderOutput := fmt.Sprintf(`{"id":1,"issuance_id":1,"token":"%s","investor":{"id":4,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d,"updated_at":0},"amount":"10000","interest_rate":"900","state":"canceled","created_at":%d,"updated_at":%d}`,
 		token, investor01, baseTime, baseTime, baseTime)
-	s.Equal(expectedCancelOrderOutput, string(cancelOrderOutput.Notices[0].Payload))
+	s.Equal(expectedCancelOrderOutput, s.envelopeData("order.canceled", cancelOrderOutput.Notices[0].Payload))
+
+	// A canceled order is refunded only once
+	cancelOrderOutput = s.Tester.Advance(investor01, cancelOrderInput)
+	s.Error(cancelOrderOutput.Err)
+	s.Len(cancelOrderOutput.Notices, 0)
+	s.Len(cancelOrderOutput.Reports, 1)
+	s.Contains(string(cancelOrderOutput.Reports[0].Payload), `"code":"ORDER_NOT_PENDING"`)
+
+	reconcileOutput := s.Tester.Inspect([]byte(`{"path":"ledger/reconciliation"}`))
+	s.NoError(reconcileOutput.Err)
+	s.Contains(s.envelopeData("ledger.reconciliation", reconcileOutput.Reports[0].Payload), fmt.Sprintf(`{"token":"%s","debits":"10000","credits":"10000","wallet_balance":"0","reconciled":true}`, token))
 }
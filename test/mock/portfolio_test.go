@@ -0,0 +1,125 @@
+package integration
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestPortfolioSuite(t *testing.T) {
+	suite.Run(t, new(PortfolioSuite))
+}
+
+type PortfolioSuite struct {
+	DCMRollupSuite
+}
+
+// setupIssuanceWithOrders creates an issuance of 100000 with orders of 60000 at 900 from
+// investor01 and 50000 at 800 from investor02
+func (s *PortfolioSuite) setupIssuanceWithOrders(closesAt int64, maturityAt int64) {
+	admin, token, creator, _, verifier, collateral, _, _ := s.setupCommonAddresses()
+	investor01, investor02, _, _, _ := s.setupInvestorAddresses()
+
+	createUserInput := []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"creator"}}`, creator))
+	s.Require().NoError(s.Tester.Advance(admin, createUserInput).Err)
+	for _, investor := range []common.Address{investor01, investor02} {
+		createUserInput = []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"investor"}}`, investor))
+		s.Require().NoError(s.Tester.Advance(admin, createUserInput).Err)
+	}
+	s.createSocialAccount(verifier, creatorKey, "test", "twitter")
+
+	createIssuanceInput := []byte(fmt.Sprintf(`{"path":"issuance/creator/create","data":{"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"%s","max_interest_rate":"1000","debt_issued":"100000","closes_at":%d,"maturity_at":%d}}`,
+		token, closesAt, maturityAt))
+	s.Require().NoError(s.Tester.DepositERC20(collateral, creator, big.NewInt(10000), createIssuanceInput).Err)
+
+	createOrderInput := []byte(`{"path":"order/create","data":{"issuance_id":1,"interest_rate":"900"}}`)
+	s.Require().NoError(s.Tester.DepositERC20(token, investor01, big.NewInt(60000), createOrderInput).Err)
+	createOrderInput = []byte(`{"path":"order/create","data":{"issuance_id":1,"interest_rate":"800"}}`)
+	s.Require().NoError(s.Tester.DepositERC20(token, investor02, big.NewInt(50000), createOrderInput).Err)
+}
+
+// closeIssuance waits for the issuance to close and closes it
+func (s *PortfolioSuite) closeIssuance() {
+	_, _, creator, _, _, _, _, _ := s.setupCommonAddresses()
+	s.wait(5)
+
+	anyone := common.HexToAddress("0x0000000000000000000000000000000000000001")
+	closeIssuanceInput := []byte(fmt.Sprintf(`{"path":"issuance/close","data":{"creator_address":"%s"}}`, creator))
+	s.Require().NoError(s.Tester.Advance(anyone, closeIssuanceInput).Err)
+}
+
+func (s *PortfolioSuite) TestFindInvestorPortfolio() {
+	_, token, _, _, _, _, _, _ := s.setupCommonAddresses()
+	investor01, _, _, _, _ := s.setupInvestorAddresses()
+	baseTime, closesAt, maturityAt := s.setupTimeValues()
+	s.setupIssuanceWithOrders(closesAt, maturityAt)
+
+	findPortfolioInput := []byte(fmt.Sprintf(`{"path":"portfolio/investor","data":{"investor_address":"%s","at":%d}}`, investor01, baseTime))
+	findPortfolioOutput := s.Tester.Inspect(findPortfolioInput)
+	s.Require().NoError(findPortfolioOutput.Err)
+
+	// pending orders are escrowed and expect their payout at the contracted rate
+	expectedPortfolioOutput := fmt.Sprintf(`{"investor":"%s","at":%d,"positions":[`+
+		`{"order_id":1,"issuance_id":1,"issuance_title":"test","issuance_state":"ongoing","token":"%s","state":"pending","principal":"60000","interest_rate":"900","expected_payout":"65400","maturity_at":%d,"days_to_maturity":1,"realized":"0","realized_token":"%s","outstanding":"0"}],`+
+		`"totals":[{"token":"%s","invested":"0","escrowed":"60000","outstanding":"0","realized":"0"}]}`,
+		investor01, baseTime, token, maturityAt, token, token)
+	s.Equal(expectedPortfolioOutput, s.envelopeData("portfolio.investor", findPortfolioOutput.Reports[0].Payload))
+
+	s.closeIssuance()
+
+	// the order is split into an accepted position owed at maturity and a refunded one
+	findPortfolioInput = []byte(fmt.Sprintf(`{"path":"portfolio/investor","data":{"investor_address":"%s","at":%d}}`, investor01, maturityAt))
+	findPortfolioOutput = s.Tester.Inspect(findPortfolioInput)
+	s.Require().NoError(findPortfolioOutput.Err)
+
+	expectedPortfolioOutput = fmt.Sprintf(`{"investor":"%s","at":%d,"positions":[`+
+		`{"order_id":1,"issuance_id":1,"issuance_title":"test","issuance_state":"closed","token":"%s","state":"partially_accepted","principal":"50000","interest_rate":"900","expected_payout":"54500","maturity_at":%d,"days_to_maturity":0,"realized":"0","realized_token":"%s","outstanding":"54500"},`+
+		`{"order_id":3,"issuance_id":1,"issuance_title":"test","issuance_state":"closed","token":"%s","state":"rejected","principal":"10000","interest_rate":"900","expected_payout":"0","maturity_at":%d,"days_to_maturity":0,"realized":"0","realized_token":"%s","outstanding":"0"}],`+
+		`"totals":[{"token":"%s","invested":"50000","escrowed":"0","outstanding":"54500","realized":"0"}]}`,
+		investor01, maturityAt, token, maturityAt, token, token, maturityAt, token, token)
+	s.Equal(expectedPortfolioOutput, s.envelopeData("portfolio.investor", findPortfolioOutput.Reports[0].Payload))
+
+	unknown := common.HexToAddress("0x00000000000000000000000000000000000000ff")
+	findPortfolioInput = []byte(fmt.Sprintf(`{"path":"portfolio/investor","data":{"investor_address":"%s","at":%d}}`, unknown, baseTime))
+	findPortfolioOutput = s.Tester.Inspect(findPortfolioInput)
+	s.Error(findPortfolioOutput.Err)
+	s.Contains(string(findPortfolioOutput.Reports[0].Payload), `"code":"USER_NOT_FOUND"`)
+}
+
+func (s *PortfolioSuite) TestFindCreatorDashboard() {
+	_, token, creator, _, _, collateral, _, _ := s.setupCommonAddresses()
+	baseTime, closesAt, maturityAt := s.setupTimeValues()
+	s.setupIssuanceWithOrders(closesAt, maturityAt)
+
+	findDashboardInput := []byte(fmt.Sprintf(`{"path":"portfolio/creator","data":{"creator_address":"%s","at":%d}}`, creator, baseTime))
+	findDashboardOutput := s.Tester.Inspect(findDashboardInput)
+	s.Require().NoError(findDashboardOutput.Err)
+
+	// nothing is owed before the issuance closes
+	expectedDashboardOutput := fmt.Sprintf(`{"creator":"%s","at":%d,"obligations":[`+
+		`{"issuance_id":1,"title":"test","state":"ongoing","token":"%s","total_raised":"0","fees_deducted":"0","net_raised":"0","total_obligation":"0","maturity_at":%d,"seconds_to_maturity":10,"days_to_maturity":1,"collateral":"%s","collateral_at_risk":"10000","settlement_deposit":"0"}]}`,
+		creator, baseTime, token, maturityAt, collateral)
+	s.Equal(expectedDashboardOutput, s.envelopeData("portfolio.creator", findDashboardOutput.Reports[0].Payload))
+
+	s.closeIssuance()
+
+	// 50000 at 8% and 50000 at 9% are owed at maturity
+	findDashboardInput = []byte(fmt.Sprintf(`{"path":"portfolio/creator","data":{"creator_address":"%s","at":%d}}`, creator, closesAt))
+	findDashboardOutput = s.Tester.Inspect(findDashboardInput)
+	s.Require().NoError(findDashboardOutput.Err)
+
+	expectedDashboardOutput = fmt.Sprintf(`{"creator":"%s","at":%d,"obligations":[`+
+		`{"issuance_id":1,"title":"test","state":"closed","token":"%s","total_raised":"100000","fees_deducted":"5000","net_raised":"95000","total_obligation":"108500","maturity_at":%d,"seconds_to_maturity":5,"days_to_maturity":1,"collateral":"%s","collateral_at_risk":"10000","settlement_deposit":"108500"}]}`,
+		creator, closesAt, token, maturityAt, collateral)
+	s.Equal(expectedDashboardOutput, s.envelopeData("portfolio.creator", findDashboardOutput.Reports[0].Payload))
+
+	// past maturity the issuance can only have its collateral executed
+	findDashboardInput = []byte(fmt.Sprintf(`{"path":"portfolio/creator","data":{"creator_address":"%s","at":%d}}`, creator, maturityAt+1))
+	findDashboardOutput = s.Tester.Inspect(findDashboardInput)
+	s.Require().NoError(findDashboardOutput.Err)
+	s.Contains(s.envelopeData("portfolio.creator", findDashboardOutput.Reports[0].Payload),
+		`"seconds_to_maturity":0,"days_to_maturity":0,"collateral":"`+collateral.Hex()+`","collateral_at_risk":"10000","settlement_deposit":"0"`)
+}
@@ -0,0 +1,334 @@
+package integration
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository/postgres"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository/sqlite"
+	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+// TestRepositorySuite runs the repository conformance suite against every backend. PostgreSQL
+// runs when POSTGRES_TEST_URL points to a database the suite may wipe, which CI always
+// provides and make test-postgres starts, so it is only skipped on machines without one.
+func TestRepositorySuite(t *testing.T) {
+	t.Run("SQLite", func(t *testing.T) {
+		suite.Run(t, &RepositorySuite{conn: "sqlite://:memory:"})
+	})
+	t.Run("PostgreSQL", func(t *testing.T) {
+		conn := os.Getenv("POSTGRES_TEST_URL")
+		if conn == "" && os.Getenv("CI") != "" {
+			t.Fatal("POSTGRES_TEST_URL must be set in CI")
+		}
+		if conn == "" {
+			t.Skip("POSTGRES_TEST_URL is not set")
+		}
+		suite.Run(t, &RepositorySuite{conn: conn})
+	})
+}
+
+// RepositorySuite checks every backend stores and reads the state alike, down to the rows
+// the state commitment and snapshots are built from.
+type RepositorySuite struct {
+	suite.Suite
+	conn string
+	repo repository.Repository
+	db   *gorm.DB
+}
+
+const repositoryTestTime = int64(1700000000)
+
+var (
+	repositoryTestCreator  = HexToAddress("0x70997970C51812dc3A010C7d01b50e0d17dc79C8")
+	repositoryTestInvestor = HexToAddress("0x0000000000000000000000000000000000000001")
+	repositoryTestToken    = HexToAddress("0x0000000000000000000000000000000000000009")
+)
+
+// reset replaces the repository with an empty, migrated database.
+func (s *RepositorySuite) reset() {
+	if s.repo != nil {
+		s.Require().NoError(s.repo.Close())
+	}
+	ctx := context.Background()
+	if strings.HasPrefix(s.conn, "postgres") {
+		repo, err := postgres.ConnectPostgresRepository(ctx, s.conn)
+		s.Require().NoError(err)
+		s.Require().NoError(repo.Db.Exec("DROP SCHEMA public CASCADE").Error)
+		s.Require().NoError(repo.Db.Exec("CREATE SCHEMA public").Error)
+		s.repo, s.db = repo, repo.Db
+	} else {
+		repo, err := sqlite.ConnectSQLiteRepository(ctx, s.conn)
+		s.Require().NoError(err)
+		s.repo, s.db = repo, repo.Db
+	}
+	_, err := s.repo.MigrateUp()
+	s.Require().NoError(err)
+}
+
+func (s *RepositorySuite) SetupTest() {
+	s.repo = nil
+	s.reset()
+}
+
+func (s *RepositorySuite) TearDownTest() {
+	s.Require().NoError(s.repo.Close())
+}
+
+func (s *RepositorySuite) amount(decimal string) *uint256.Int {
+	amount, err := uint256.FromDecimal(decimal)
+	s.Require().NoError(err)
+	return amount
+}
+
+func (s *RepositorySuite) createIssuance(debt string) *entity.Issuance {
+	issuance, err := entity.NewIssuance("test", "testtesttesttesttest", "testtesttesttesttest",
+		repositoryTestToken, repositoryTestCreator, HexToAddress("0x0000000000000000000000000000000000000008"), s.amount("10000"),
+		HexToAddress("0x0000000000000000000000000000000000000007"), s.amount(debt), s.amount("1000"),
+		nil, nil, repositoryTestTime+5, repositoryTestTime+10, repositoryTestTime)
+	s.Require().NoError(err)
+	issuance, err = s.repo.CreateIssuance(issuance)
+	s.Require().NoError(err)
+	return issuance
+}
+
+func (s *RepositorySuite) createOrder(issuanceId uint, amount string, rate string, state entity.OrderState) *entity.Order {
+	order, err := entity.NewOrder(issuanceId, repositoryTestInvestor, s.amount(amount), s.amount(rate), state, repositoryTestTime)
+	s.Require().NoError(err)
+	order, err = s.repo.CreateOrder(order)
+	s.Require().NoError(err)
+	return order
+}
+
+func (s *RepositorySuite) TestSchemaOfEntities() {
+	requireSchemaOfEntities(&s.Suite, s.db)
+
+	status, err := s.repo.MigrationStatus()
+	s.Require().NoError(err)
+	s.Require().NotEmpty(status)
+	s.Equal("create_schema", status[0].Name)
+	for i, migration := range status {
+		s.Equal(uint(i+1), migration.Version)
+		s.NotZero(migration.AppliedAt)
+	}
+}
+
+func (s *RepositorySuite) TestIssuancesAndOrders() {
+	issuance := s.createIssuance("100000")
+	s.Equal(uint(1), issuance.Id)
+	for _, rate := range []string{"900", "800", "700"} {
+		s.createOrder(issuance.Id, "1000", rate, entity.OrderStatePending)
+	}
+
+	found, err := s.repo.FindIssuanceById(issuance.Id)
+	s.Require().NoError(err)
+	s.Equal("100000", found.DebtIssued.Dec())
+	s.Require().Len(found.Orders, 3)
+	for i, order := range found.Orders {
+		s.Equal(uint(i+1), order.Id)
+	}
+
+	// updated rows keep their place
+	order := found.Orders[0]
+	order.State = entity.OrderStateAccepted
+	order.UpdatedAt = repositoryTestTime + 1
+	_, err = s.repo.UpdateOrder(order)
+	s.Require().NoError(err)
+	orders, err := s.repo.FindOrdersByIssuanceId(issuance.Id)
+	s.Require().NoError(err)
+	s.Require().Len(orders, 3)
+	s.Equal(uint(1), orders[0].Id)
+	s.Equal(entity.OrderStateAccepted, orders[0].State)
+
+	pending, err := s.repo.FindOrdersByState(issuance.Id, string(entity.OrderStatePending))
+	s.Require().NoError(err)
+	s.Len(pending, 2)
+
+	ongoing, err := s.repo.FindOngoingIssuanceByCreatorAddress(repositoryTestCreator)
+	s.Require().NoError(err)
+	s.Equal(issuance.Id, ongoing.Id)
+
+	s.Require().NoError(s.repo.DeleteOrder(3))
+	s.ErrorIs(s.repo.DeleteOrder(3), entity.ErrOrderNotFound)
+	_, err = s.repo.FindIssuanceById(2)
+	s.ErrorIs(err, entity.ErrIssuanceNotFound)
+}
+
+func (s *RepositorySuite) TestUsersAndRoles() {
+	user, err := entity.NewUser(string(entity.UserRoleCreator), repositoryTestCreator, repositoryTestTime)
+	s.Require().NoError(err)
+	user, err = s.repo.CreateUser(user)
+	s.Require().NoError(err)
+	s.Require().NoError(s.repo.CreateUserRole(&entity.UserRoleAssignment{UserId: user.Id, Role: entity.UserRoleInvestor, CreatedAt: repositoryTestTime}))
+
+	found, err := s.repo.FindUserByAddress(repositoryTestCreator)
+	s.Require().NoError(err)
+	s.True(found.HasRole(entity.UserRoleCreator))
+	s.True(found.HasRole(entity.UserRoleInvestor))
+
+	investors, err := s.repo.FindUsersByRole(string(entity.UserRoleInvestor))
+	s.Require().NoError(err)
+	s.Require().Len(investors, 1)
+	s.Equal(user.Id, investors[0].Id)
+
+	s.Require().NoError(s.repo.DeleteUserRole(user.Id, entity.UserRoleInvestor))
+	investors, err = s.repo.FindUsersByRole(string(entity.UserRoleInvestor))
+	s.Require().NoError(err)
+	s.Empty(investors)
+
+	_, err = s.repo.FindUserByAddress(repositoryTestInvestor)
+	s.ErrorIs(err, entity.ErrUserNotFound)
+}
+
+// TestAmountsBeyond64Bits checks amounts are stored and aggregated exactly, past the integers
+// and floats databases sum natively.
+func (s *RepositorySuite) TestAmountsBeyond64Bits() {
+	large := "1267650600228229401496703205376" // 2^100
+	for range 2 {
+		issuance := s.createIssuance(large)
+		s.createOrder(issuance.Id, large, "900", entity.OrderStateAccepted)
+		s.createOrder(issuance.Id, "1", "500", entity.OrderStateAccepted)
+		issuance.State = entity.IssuanceStateClosed
+		issuance.TotalRaised = s.amount(large)
+		_, err := s.repo.UpdateIssuance(issuance)
+		s.Require().NoError(err)
+	}
+	max := new(uint256.Int).SetAllOne()
+	s.createIssuance(max.Dec())
+
+	found, err := s.repo.FindIssuanceById(3)
+	s.Require().NoError(err)
+	s.Equal(max.Dec(), found.DebtIssued.Dec())
+
+	sum := func(amounts []*uint256.Int) string {
+		total := new(uint256.Int)
+		for _, amount := range amounts {
+			total.Add(total, amount)
+		}
+		return total.Dec()
+	}
+	raised, err := s.repo.FindRaisedAmountsByToken()
+	s.Require().NoError(err)
+	s.Require().Len(raised, 1)
+	s.Equal(repositoryTestToken, raised[0].Token)
+	s.Equal("2535301200456458802993406410752", sum(raised[0].Amounts))
+
+	series, err := s.repo.FindActivitySeries(86400, 0, 0)
+	s.Require().NoError(err)
+	s.Require().Len(series, 1)
+	s.Equal(uint64(3), series[0].Issuances)
+	s.Equal(uint64(4), series[0].Orders)
+	s.Equal(uint64(1), series[0].Investors)
+	s.Require().Len(series[0].Volume, 1)
+	s.Equal("2535301200456458802993406410754", sum(series[0].Volume[0].Amounts))
+
+	rate, err := s.repo.FindAverageClearingRate()
+	s.Require().NoError(err)
+	s.Equal(float64(900), rate)
+
+	counts, err := s.repo.CountIssuancesByState()
+	s.Require().NoError(err)
+	s.Equal(map[entity.IssuanceState]uint64{entity.IssuanceStateClosed: 2, entity.IssuanceStateOngoing: 1}, counts)
+}
+
+// TestStateRows checks the rows the state commitment hashes hold the same values on every
+// backend: amounts as decimal strings, booleans as 0 or 1 and text sorted bytewise.
+func (s *RepositorySuite) TestStateRows() {
+	issuance := s.createIssuance("100000")
+	setting, err := entity.NewPlatformSetting("twitter", true, repositoryTestCreator, repositoryTestTime)
+	s.Require().NoError(err)
+	_, err = s.repo.SavePlatformSetting(setting)
+	s.Require().NoError(err)
+	for _, token := range []string{"0xaAaAaAaaAaAaAaaAaAAAAAAAAaaaAaAaAaaAaaAa", "0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC", "0x0000000000000000000000000000000000000010"} {
+		_, err := s.repo.CreateAllowedToken(&entity.AllowedToken{Token: HexToAddress(token), CreatedAt: repositoryTestTime})
+		s.Require().NoError(err)
+	}
+
+	changed, err := s.repo.TakeChangedStateRows()
+	s.Require().NoError(err)
+	s.Require().Len(changed["issuances"], 1)
+	s.Equal("100000", changed["issuances"][0].Content["debt_issued"])
+	s.Equal(int64(issuance.Id), changed["issuances"][0].Content["id"])
+	s.Require().Len(changed["platform_settings"], 1)
+	s.Equal(int64(1), changed["platform_settings"][0].Content["enabled"])
+
+	row, err := s.repo.FindStateRow("platform_settings/twitter")
+	s.Require().NoError(err)
+	s.Equal(changed["platform_settings"][0].Content, row.Content)
+
+	// only the tables written since are taken again
+	changed, err = s.repo.TakeChangedStateRows()
+	s.Require().NoError(err)
+	s.Empty(changed)
+
+	tokens, err := s.repo.FindAllAllowedTokens()
+	s.Require().NoError(err)
+	s.Require().Len(tokens, 3)
+	s.Equal("0x0000000000000000000000000000000000000010", tokens[0].Token.Hex())
+	s.Equal("0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC", tokens[1].Token.Hex())
+	s.Equal("0xaAaAaAaaAaAaAaaAaAAAAAAAAaaaAaAaAaaAaaAa", tokens[2].Token.Hex())
+}
+
+func (s *RepositorySuite) TestTransactionRollback() {
+	s.createIssuance("100000")
+
+	s.Require().NoError(s.repo.BeginTransaction())
+	s.createIssuance("200000")
+	s.Require().NoError(s.repo.RollbackTransaction())
+
+	issuances, err := s.repo.FindAllIssuances()
+	s.Require().NoError(err)
+	s.Len(issuances, 1)
+
+	s.Require().NoError(s.repo.BeginTransaction())
+	s.createIssuance("300000")
+	s.Require().NoError(s.repo.CommitTransaction())
+
+	issuances, err = s.repo.FindAllIssuances()
+	s.Require().NoError(err)
+	s.Require().Len(issuances, 2)
+	s.Equal("300000", issuances[1].DebtIssued.Dec())
+}
+
+// TestSnapshotRoundTrip imports an export into an empty database of the same backend. Rows
+// created afterwards continue the imported identifiers.
+func (s *RepositorySuite) TestSnapshotRoundTrip() {
+	issuance := s.createIssuance("100000")
+	s.createOrder(issuance.Id, "1000", "900", entity.OrderStatePending)
+	user, err := entity.NewUser(string(entity.UserRoleCreator), repositoryTestCreator, repositoryTestTime)
+	s.Require().NoError(err)
+	user, err = s.repo.CreateUser(user)
+	s.Require().NoError(err)
+	rotation, err := entity.NewAddressRotation(user.Id, repositoryTestCreator, repositoryTestInvestor, true, repositoryTestTime)
+	s.Require().NoError(err)
+	_, err = s.repo.CreateAddressRotation(rotation)
+	s.Require().NoError(err)
+	setting, err := entity.NewPlatformSetting("twitter", false, repositoryTestCreator, repositoryTestTime)
+	s.Require().NoError(err)
+	_, err = s.repo.SavePlatformSetting(setting)
+	s.Require().NoError(err)
+
+	exported, err := s.repo.ExportTables()
+	s.Require().NoError(err)
+	s.Equal(int64(1), exported["address_rotations"][0]["requires_approval"])
+	s.Equal(int64(0), exported["platform_settings"][0]["enabled"])
+
+	s.reset()
+	s.Require().NoError(s.repo.ImportTables(exported))
+	reexported, err := s.repo.ExportTables()
+	s.Require().NoError(err)
+	s.Equal(exported, reexported)
+
+	s.ErrorIs(s.repo.ImportTables(exported), entity.ErrRepositoryNotEmpty)
+
+	order := s.createOrder(issuance.Id, "2000", "800", entity.OrderStatePending)
+	s.Equal(uint(2), order.Id)
+	s.Equal(uint(2), s.createIssuance("100000").Id)
+}
@@ -0,0 +1,86 @@
+package integration
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain/entity"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/reputation"
+	. "github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestReputationSuite(t *testing.T) {
+	suite.Run(t, new(ReputationSuite))
+}
+
+type ReputationSuite struct {
+	DCMRollupSuite
+}
+
+func (s *ReputationSuite) SetupTest() {
+	s.T().Setenv("REPUTATION_GATES", "100000:480")
+	s.DCMRollupSuite.SetupTest()
+}
+
+func (s *ReputationSuite) TestCancellationLowersScoreBelowGate() {
+	admin, token, creator, _, verifier, collateral, _, _ := s.setupCommonAddresses()
+	baseTime, closesAt, maturityAt := s.setupTimeValues()
+
+	createUserInput := []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"creator"}}`, creator))
+	s.Tester.Advance(admin, createUserInput)
+	s.createSocialAccount(verifier, creatorKey, "test", "twitter")
+
+	createIssuanceInput := func(debtIssued string, closesAt int64, maturityAt int64) []byte {
+		return []byte(fmt.Sprintf(`{"path":"issuance/creator/create","data":{"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"%s","max_interest_rate":"1000","debt_issued":"%s","closes_at":%d,"maturity_at":%d}}`,
+			token, debtIssued, closesAt, maturityAt))
+	}
+
+	// a clean record passes the gate
+	createIssuanceOutput := s.Tester.DepositERC20(collateral, creator, big.NewInt(10000), createIssuanceInput("100000", closesAt, maturityAt))
+	s.Require().NoError(createIssuanceOutput.Err)
+
+	s.wait(5)
+
+	// nobody bought the issuance, so closing it is rejected and its effects are rolled back
+	anyone := common.HexToAddress("0x0000000000000000000000000000000000000001")
+	closeIssuanceInput := []byte(fmt.Sprintf(`{"path":"issuance/close","data":{"creator_address":"%s"}}`, creator))
+	closeIssuanceOutput := s.Tester.Advance(anyone, closeIssuanceInput)
+	s.Error(closeIssuanceOutput.Err)
+	s.Contains(string(closeIssuanceOutput.Reports[0].Payload), `"code":"ISSUANCE_UNDERFUNDED"`)
+
+	findUserInput := []byte(fmt.Sprintf(`{"path":"user/address","data":{"address":"%s"}}`, creator))
+	findUserOutput := s.Tester.Inspect(findUserInput)
+	s.NoError(findUserOutput.Err)
+	s.Contains(s.envelopeData("user", findUserOutput.Reports[0].Payload),
+		fmt.Sprintf(`"reputation":%s`, reputationJSON(500, 1, 0, 0, 0, 0, "0", baseTime)))
+
+	// cancel the issuance directly and refresh the reputation of its creator
+	issuance, err := s.Repo.FindIssuanceById(1)
+	s.Require().NoError(err)
+	s.Equal(entity.IssuanceStateOngoing, issuance.State)
+	issuance.State = entity.IssuanceStateCanceled
+	_, err = s.Repo.UpdateIssuance(issuance)
+	s.Require().NoError(err)
+	_, err = reputation.NewEngine(s.Repo, s.Repo, s.Repo).Refresh(Address(creator), closesAt)
+	s.Require().NoError(err)
+
+	findUserOutput = s.Tester.Inspect(findUserInput)
+	s.NoError(findUserOutput.Err)
+	s.Contains(s.envelopeData("user", findUserOutput.Reports[0].Payload),
+		fmt.Sprintf(`"reputation":%s`, reputationJSON(450, 1, 0, 0, 0, 1, "0", closesAt)))
+
+	// the gate applies from 100000 on
+	now := s.Now
+	createIssuanceOutput = s.Tester.DepositERC20(collateral, creator, big.NewInt(10000), createIssuanceInput("100000", now+5, now+10))
+	s.Error(createIssuanceOutput.Err)
+	expectedErr := fmt.Sprintf(`"code":"REPUTATION_TOO_LOW","category":"forbidden","message":"failed to create issuance: creator %s has a reputation score of 450, issuing 100000 requires 480"`, creator)
+	s.Contains(string(createIssuanceOutput.Reports[0].Payload), expectedErr)
+
+	createIssuanceOutput = s.Tester.DepositERC20(collateral, creator, big.NewInt(10000), createIssuanceInput("99999", now+5, now+10))
+	s.NoError(createIssuanceOutput.Err)
+	s.Contains(s.envelopeData("issuance.created", createIssuanceOutput.Notices[0].Payload),
+		fmt.Sprintf(`"reputation":%s`, reputationJSON(450, 2, 0, 0, 0, 1, "0", now)))
+}
@@ -0,0 +1,80 @@
+package integration
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/pkg/router"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestSchemaSuite(t *testing.T) {
+	suite.Run(t, new(SchemaSuite))
+}
+
+type SchemaSuite struct {
+	DCMRollupSuite
+}
+
+func (s *SchemaSuite) findRoutes() map[string]json.RawMessage {
+	schemaOutput := s.Tester.Inspect([]byte(`{"path":"__schema"}`))
+	s.Require().NoError(schemaOutput.Err)
+	s.Require().Len(schemaOutput.Reports, 1)
+
+	var routes []json.RawMessage
+	s.Require().NoError(json.Unmarshal([]byte(s.envelopeData("schema", schemaOutput.Reports[0].Payload)), &routes))
+
+	byPath := make(map[string]json.RawMessage, len(routes))
+	for _, raw := range routes {
+		var route router.RouteSchema
+		s.Require().NoError(json.Unmarshal(raw, &route))
+		byPath[string(route.Kind)+" "+route.Path] = raw
+	}
+	return byPath
+}
+
+func (s *SchemaSuite) TestAdvanceRouteSchema() {
+	routes := s.findRoutes()
+
+	s.Contains(routes, "inspect __schema")
+	s.JSONEq(`{
+		"kind": "advance",
+		"path": "user/admin/create",
+		"permissions": ["user.manage"],
+		"description": "Creates a user with the given role",
+		"abi": "createUser(string,address)",
+		"input": {
+			"$schema": "https://json-schema.org/draft/2020-12/schema",
+			"type": "object",
+			"properties": {
+				"role": {"type": "string"},
+				"address": {"type": "string", "description": "address", "pattern": "^0x[0-9a-fA-F]{40}$"}
+			},
+			"required": ["role", "address"]
+		}
+	}`, string(routes["advance user/admin/create"]))
+}
+
+func (s *SchemaSuite) TestValidateTagsSchema() {
+	routes := s.findRoutes()
+
+	var route struct {
+		Input *router.Schema `json:"input"`
+	}
+	s.Require().NoError(json.Unmarshal(routes["advance issuance/creator/create"], &route))
+	s.Require().NotNil(route.Input)
+
+	title := route.Input.Properties["title"]
+	s.Equal("string", title.Type)
+	s.Equal(3, *title.MinLength)
+	s.Equal(100, *title.MaxLength)
+	s.Equal("uint256", route.Input.Properties["debt_issued"].Description)
+	s.Equal("integer", route.Input.Properties["closes_at"].Type)
+	s.ElementsMatch([]string{"title", "description", "promotion", "token", "debt_issued", "max_interest_rate", "closes_at", "maturity_at"}, route.Input.Required)
+}
+
+func (s *SchemaSuite) TestInspectRouteWithoutInput() {
+	routes := s.findRoutes()
+
+	s.JSONEq(`{"kind":"inspect","path":"user","description":"Lists all users"}`, string(routes["inspect user"]))
+}
@@ -0,0 +1,102 @@
+package integration
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/domain"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository/factory"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/snapshot"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestSnapshotSuite(t *testing.T) {
+	suite.Run(t, new(SnapshotSuite))
+}
+
+type SnapshotSuite struct {
+	DCMRollupSuite
+}
+
+func (s *SnapshotSuite) setupState() {
+	admin, token, creator, _, verifier, collateral, _, _ := s.setupCommonAddresses()
+	investor01, _, _, _, _ := s.setupInvestorAddresses()
+	_, closesAt, maturityAt := s.setupTimeValues()
+
+	createUserInput := []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"creator"}}`, creator))
+	s.Require().NoError(s.Tester.Advance(admin, createUserInput).Err)
+	createUserInput = []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"investor"}}`, investor01))
+	s.Require().NoError(s.Tester.Advance(admin, createUserInput).Err)
+	s.createSocialAccount(verifier, creatorKey, "test", "twitter")
+
+	createIssuanceInput := []byte(fmt.Sprintf(`{"path":"issuance/creator/create","data":{"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"%s","max_interest_rate":"1000","debt_issued":"100000","closes_at":%d,"maturity_at":%d}}`,
+		token, closesAt, maturityAt))
+	s.Require().NoError(s.Tester.DepositERC20(collateral, creator, big.NewInt(10000), createIssuanceInput).Err)
+
+	createOrderInput := []byte(`{"path":"order/create","data":{"issuance_id":1,"interest_rate":"900"}}`)
+	s.Require().NoError(s.Tester.DepositERC20(token, investor01, big.NewInt(60000), createOrderInput).Err)
+}
+
+func (s *SnapshotSuite) TestExportImportRoundTrip() {
+	s.setupState()
+
+	exported, err := snapshot.NewExportSnapshotUseCase(s.Repo).Execute()
+	s.Require().NoError(err)
+	s.NotZero(exported.Rows)
+
+	// exporting the same state twice yields the same bytes
+	again, err := snapshot.NewExportSnapshotUseCase(s.Repo).Execute()
+	s.Require().NoError(err)
+	s.Equal(exported.Content, again.Content)
+
+	restored, err := factory.NewRepositoryFromConnectionString(context.Background(), "sqlite://:memory:")
+	s.Require().NoError(err)
+	defer restored.Close()
+
+	imported, err := snapshot.NewImportSnapshotUseCase(restored).Execute(&snapshot.ImportSnapshotInputDTO{Content: exported.Content})
+	s.Require().NoError(err)
+	s.Equal(exported.Hash, imported.Hash)
+	s.Equal(exported.Rows, imported.Rows)
+
+	reexported, err := snapshot.NewExportSnapshotUseCase(restored).Execute()
+	s.Require().NoError(err)
+	s.Equal(string(exported.Content), string(reexported.Content))
+
+	issuance, err := restored.FindIssuanceById(1)
+	s.Require().NoError(err)
+	s.Equal("100000", issuance.DebtIssued.Dec())
+	s.Len(issuance.Orders, 1)
+	s.Equal("60000", issuance.Orders[0].Amount.Dec())
+}
+
+func (s *SnapshotSuite) TestImportRejectsNonEmptyRepository() {
+	s.setupState()
+
+	exported, err := snapshot.NewExportSnapshotUseCase(s.Repo).Execute()
+	s.Require().NoError(err)
+
+	_, err = snapshot.NewImportSnapshotUseCase(s.Repo).Execute(&snapshot.ImportSnapshotInputDTO{Content: exported.Content})
+	s.ErrorIs(err, domain.ErrRepositoryNotEmpty)
+}
+
+func (s *SnapshotSuite) TestImportRejectsTamperedSnapshot() {
+	s.setupState()
+
+	exported, err := snapshot.NewExportSnapshotUseCase(s.Repo).Execute()
+	s.Require().NoError(err)
+	tampered := bytes.Replace(exported.Content, []byte(`"60000"`), []byte(`"90000"`), 1)
+	s.NotEqual(exported.Content, tampered)
+
+	restored, err := factory.NewRepositoryFromConnectionString(context.Background(), "sqlite://:memory:")
+	s.Require().NoError(err)
+	defer restored.Close()
+
+	_, err = snapshot.NewImportSnapshotUseCase(restored).Execute(&snapshot.ImportSnapshotInputDTO{Content: tampered})
+	s.ErrorIs(err, domain.ErrSnapshotHashMismatch)
+
+	_, err = snapshot.NewImportSnapshotUseCase(restored).Execute(&snapshot.ImportSnapshotInputDTO{Content: []byte(`{"version":2,"tables":{}}`)})
+	s.ErrorIs(err, domain.ErrInvalidSnapshot)
+}
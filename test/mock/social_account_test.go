@@ -1,9 +1,12 @@
 package integration
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -23,77 +26,122 @@ func (s *SocialAccountSuite) TestCreateSocialAccount() {
 	createUserInput := []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"creator"}}`, creator))
 	s.Tester.Advance(admin, createUserInput)
 
-	// create social account
-	createSocialAccountInput := []byte(fmt.Sprintf(`{"path":"social/verifier/create","data":{"address":"%s","username":"test","platform":"twitter"}}`, creator))
-	createSocialAccountOutput := s.Tester.Advance(verifier, createSocialAccountInput)
-	s.Len(createSocialAccountOutput.Notices, 1)
-
-	expectedCreateSocialAccountOutput := fmt.Sprintf(`social account created - {"id":1,"user_id":3,"username":"test","platform":"twitter","created_at":%d}`, baseTime)
-	s.Equal(expectedCreateSocialAccountOutput, string(createSocialAccountOutput.Notices[0].Payload))
+	// request a challenge from the creator wallet
+	requestInput := []byte(`{"path":"social/challenge","data":{"username":"test","platform":"twitter"}}`)
+	requestOutput := s.Tester.Advance(creator, requestInput)
+	s.NoError(requestOutput.Err)
+
+	var challenge struct {
+		Nonce string `json:"nonce"`
+	}
+	requestData := s.envelopeData("social_account.challenge_requested", requestOutput.Notices[0].Payload)
+	s.Require().NoError(json.Unmarshal([]byte(requestData), &challenge))
+	message := fmt.Sprintf("I control the twitter account test and the wallet %s. Nonce: %s", creator, challenge.Nonce)
+	expectedRequestOutput := fmt.Sprintf(`{"id":1,"user_id":3,"address":"%s","username":"test","platform":"twitter","nonce":"%s","message":"%s","expires_at":%d,"created_at":%d,"updated_at":0}`,
+		creator, challenge.Nonce, message, baseTime+86400, baseTime)
+	s.Equal(expectedRequestOutput, requestData)
+
+	contentHash := crypto.Keccak256Hash([]byte(challenge.Nonce)).Hex()
+	createInput := func(signature []byte) []byte {
+		return []byte(fmt.Sprintf(`{"path":"social/verifier/create","data":{"challenge_id":1,"post_id":"1234","content_hash":"%s","signature":"%s"}}`,
+			contentHash, hexutil.Encode(signature)))
+	}
+
+	// the message must be signed by the creator wallet
+	otherKey, err := crypto.GenerateKey()
+	s.Require().NoError(err)
+	createSocialAccountOutput := s.Tester.Advance(verifier, createInput(s.signMessage(otherKey, message)))
+	s.Error(createSocialAccountOutput.Err)
+	s.Contains(string(createSocialAccountOutput.Reports[0].Payload), `"code":"INVALID_SOCIAL_SIGNATURE"`)
+
+	signature := s.signMessage(creatorKey, message)
+	createSocialAccountOutput = s.Tester.Advance(verifier, createInput(signature))
+	s.NoError(createSocialAccountOutput.Err)
+	s.Len(createSocialAccountOutput.Notices, 2)
+
+	expectedCreateSocialAccountOutput := fmt.Sprintf(`{"id":1,"user_id":3,"username":"test","platform":"twitter","evidence":{"challenge_id":1,"post_id":"1234","content_hash":"%s","signature":"%s","verified_by":"%s"},"created_at":%d}`,
+		contentHash, hexutil.Encode(signature), verifier, baseTime)
+	s.Equal(expectedCreateSocialAccountOutput, s.envelopeData("social_account.created", createSocialAccountOutput.Notices[0].Payload))
+
+	// a challenge proves the ownership only once
+	createSocialAccountOutput = s.Tester.Advance(verifier, createInput(signature))
+	s.Error(createSocialAccountOutput.Err)
+	s.Contains(string(createSocialAccountOutput.Reports[0].Payload), `"code":"SOCIAL_CHALLENGE_USED"`)
 }
 
-func (s *SocialAccountSuite) TestFindSocialAccountById() {
+func (s *SocialAccountSuite) TestSocialAccountUsernameCanonicalization() {
 	admin, _, creator, _, verifier, _, _, _ := s.setupCommonAddresses()
-	baseTime, _, _ := s.setupTimeValues()
 
-	// create creator user first
 	createUserInput := []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"creator"}}`, creator))
 	s.Tester.Advance(admin, createUserInput)
 
-	// create social account
-	createSocialAccountInput := []byte(fmt.Sprintf(`{"path":"social/verifier/create","data":{"address":"%s","username":"test","platform":"twitter"}}`, creator))
-	s.Tester.Advance(verifier, createSocialAccountInput)
-
-	// find social account by id
-	findSocialAccountByIdInput := []byte(`{"path":"social/id","data":{"social_account_id":1}}`)
-	findSocialAccountByIdOutput := s.Tester.Inspect(findSocialAccountByIdInput)
-	s.Len(findSocialAccountByIdOutput.Reports, 1)
-
-	expectedFindSocialAccountByIdOutput := fmt.Sprintf(`{"id":1,"user_id":3,"username":"test","platform":"twitter","created_at":%d,"updated_at":0}`, baseTime)
-	s.Equal(expectedFindSocialAccountByIdOutput, string(findSocialAccountByIdOutput.Reports[0].Payload))
+	// usernames are lowercased and lose their leading @
+	createSocialAccountOutput := s.createSocialAccount(verifier, creatorKey, " @Creator-Dev ", "github")
+	s.NoError(createSocialAccountOutput.Err)
+	s.Contains(s.envelopeData("social_account.created", createSocialAccountOutput.Notices[0].Payload), `"username":"creator-dev","platform":"github"`)
+
+	createSocialAccountOutput = s.createSocialAccount(verifier, creatorKey, "Creator.ETH", "ens")
+	s.NoError(createSocialAccountOutput.Err)
+	s.Contains(s.envelopeData("social_account.created", createSocialAccountOutput.Notices[0].Payload), `"username":"creator.eth","platform":"ens"`)
+
+	// each platform has its own username rules
+	for _, input := range []struct{ username, platform string }{
+		{"creator-dev", "twitter"},
+		{"-creator", "github"},
+		{"creator_dev_with_a_long_name", "farcaster"},
+		{"creator", "ens"},
+	} {
+		requestInput := []byte(fmt.Sprintf(`{"path":"social/challenge","data":{"username":"%s","platform":"%s"}}`, input.username, input.platform))
+		requestOutput := s.Tester.Advance(creator, requestInput)
+		s.Error(requestOutput.Err, input.platform)
+		s.Contains(string(requestOutput.Reports[0].Payload), `"code":"INVALID_SOCIAL_CHALLENGE"`, input.platform)
+	}
+
+	requestInput := []byte(`{"path":"social/challenge","data":{"username":"creator","platform":"myspace"}}`)
+	requestOutput := s.Tester.Advance(creator, requestInput)
+	s.Error(requestOutput.Err)
+	s.Contains(string(requestOutput.Reports[0].Payload), `"code":"UNSUPPORTED_SOCIAL_PLATFORM"`)
 }
 
-func (s *SocialAccountSuite) TestFindSocialAccountsByUserId() {
+func (s *SocialAccountSuite) TestUpdatePlatformSetting() {
 	admin, _, creator, _, verifier, _, _, _ := s.setupCommonAddresses()
 	baseTime, _, _ := s.setupTimeValues()
 
-	// create creator user first
 	createUserInput := []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"creator"}}`, creator))
 	s.Tester.Advance(admin, createUserInput)
 
-	// create social account
-	createSocialAccountInput := []byte(fmt.Sprintf(`{"path":"social/verifier/create","data":{"address":"%s","username":"test","platform":"twitter"}}`, creator))
-	s.Tester.Advance(verifier, createSocialAccountInput)
-
-	// create another social account for the same user
-	createSocialAccountInput = []byte(fmt.Sprintf(`{"path":"social/verifier/create","data":{"address":"%s","username":"test2","platform":"instagram"}}`, creator))
-	s.Tester.Advance(verifier, createSocialAccountInput)
-
-	// find social accounts by user id
-	findSocialAccountsByUserIdInput := []byte(`{"path":"social/user/id","data":{"user_id":3}}`)
-	findSocialAccountsByUserIdOutput := s.Tester.Inspect(findSocialAccountsByUserIdInput)
-	s.Len(findSocialAccountsByUserIdOutput.Reports, 1)
-
-	expectedFindSocialAccountsByUserIdOutput := fmt.Sprintf(`[{"id":1,"user_id":3,"username":"test","platform":"twitter","created_at":%d,"updated_at":0},{"id":2,"user_id":3,"username":"test2","platform":"instagram","created_at":%d,"updated_at":0}]`, baseTime, baseTime)
-	s.Equal(expectedFindSocialAccountsByUserIdOutput, string(findSocialAccountsByUserIdOutput.Reports[0].Payload))
-}
-
-func (s *SocialAccountSuite) TestDeleteSocialAccount() {
-	admin, _, creator, _, verifier, _, _, _ := s.setupCommonAddresses()
-
-	// create creator user first
-	createUserInput := []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"creator"}}`, creator))
-	s.Tester.Advance(admin, createUserInput)
-
-	// create social account
-	createSocialAccountInput := []byte(fmt.Sprintf(`{"path":"social/verifier/create","data":{"address":"%s","username":"test","platform":"twitter"}}`, creator))
-	s.Tester.Advance(verifier, createSocialAccountInput)
-
-	// delete social account
-	deleteSocialAccountInput := []byte(`{"path":"social/admin/delete","data":{"social_account_id":1}}`)
-	deleteSocialAccountOutput := s.Tester.Advance(admin, deleteSocialAccountInput)
-	s.Len(deleteSocialAccountOutput.Notices, 1)
-
-	expectedDeleteSocialAccountOutput := `social account deleted - {"social_account_id":1}`
-	s.Equal(expectedDeleteSocialAccountOutput, string(deleteSocialAccountOutput.Notices[0].Payload))
+	findPlatformsOutput := s.Tester.Inspect([]byte(`{"path":"social/platforms"}`))
+	s.NoError(findPlatformsOutput.Err)
+	platforms := s.envelopeData("social_platform.list", findPlatformsOutput.Reports[0].Payload)
+	for _, platform := range []string{"twitter", "instagram", "github", "linkedin", "youtube", "tiktok", "farcaster", "ens"} {
+		s.Contains(platforms, fmt.Sprintf(`{"platform":"%s","enabled":true,`, platform))
+	}
+
+	// only admins manage platforms
+	disableInput := []byte(`{"path":"social/admin/platform","data":{"platform":"tiktok","enabled":false}}`)
+	disableOutput := s.Tester.Advance(verifier, disableInput)
+	s.Error(disableOutput.Err)
+	s.Contains(string(disableOutput.Reports[0].Payload), `"code":"PERMISSION_DENIED"`)
+
+	unsupportedOutput := s.Tester.Advance(admin, []byte(`{"path":"social/admin/platform","data":{"platform":"myspace","enabled":true}}`))
+	s.Error(unsupportedOutput.Err)
+	s.Contains(string(unsupportedOutput.Reports[0].Payload), `"code":"UNSUPPORTED_SOCIAL_PLATFORM"`)
+
+	disableOutput = s.Tester.Advance(admin, disableInput)
+	s.NoError(disableOutput.Err)
+	expectedDisableOutput := fmt.Sprintf(`{"platform":"tiktok","enabled":false,"min_length":2,"max_length":24,"updated_by":"%s","updated_at":%d}`, admin, baseTime)
+	s.Equal(expectedDisableOutput, s.envelopeData("social_platform.updated", disableOutput.Notices[0].Payload))
+
+	findPlatformsOutput = s.Tester.Inspect([]byte(`{"path":"social/platforms"}`))
+	s.Contains(s.envelopeData("social_platform.list", findPlatformsOutput.Reports[0].Payload), expectedDisableOutput)
+
+	// disabled platforms reject new challenges
+	requestInput := []byte(`{"path":"social/challenge","data":{"username":"creator","platform":"tiktok"}}`)
+	requestOutput := s.Tester.Advance(creator, requestInput)
+	s.Error(requestOutput.Err)
+	s.Contains(string(requestOutput.Reports[0].Payload), `"code":"SOCIAL_PLATFORM_DISABLED"`)
+
+	enableOutput := s.Tester.Advance(admin, []byte(`{"path":"social/admin/platform","data":{"platform":"tiktok","enabled":true}}`))
+	s.NoError(enableOutput.Err)
+	s.NoError(s.createSocialAccount(verifier, creatorKey, "creator", "tiktok").Err)
 }
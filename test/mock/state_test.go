@@ -0,0 +1,115 @@
+package integration
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/infra/repository/factory"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/snapshot"
+	"github.com/2025-2A-T20-G91-INTERNO/src/rollup/internal/usecase/state"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/rollmelette/rollmelette"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestStateSuite(t *testing.T) {
+	suite.Run(t, new(StateSuite))
+}
+
+type StateSuite struct {
+	DCMRollupSuite
+}
+
+// lastRoot returns the root emitted as the last notice of an advance input.
+func (s *StateSuite) lastRoot(output rollmelette.TestAdvanceResult) *state.StateRootOutputDTO {
+	s.Require().NoError(output.Err)
+	s.Require().NotEmpty(output.Notices)
+	var root state.StateRootOutputDTO
+	s.Require().NoError(json.Unmarshal([]byte(s.envelopeData("state.root", output.Notices[len(output.Notices)-1].Payload)), &root))
+	return &root
+}
+
+func (s *StateSuite) inspectRoot() *state.StateRootOutputDTO {
+	output := s.Tester.Inspect([]byte(`{"path":"state/root"}`))
+	s.Require().NoError(output.Err)
+	var root state.StateRootOutputDTO
+	s.Require().NoError(json.Unmarshal([]byte(s.envelopeData("state.root", output.Reports[0].Payload)), &root))
+	return &root
+}
+
+// recomputedRoot restores the state into a new repository and computes its root from scratch.
+func (s *StateSuite) recomputedRoot() *state.StateRootOutputDTO {
+	exported, err := snapshot.NewExportSnapshotUseCase(s.Repo).Execute()
+	s.Require().NoError(err)
+	restored, err := factory.NewRepositoryFromConnectionString(context.Background(), "sqlite://:memory:")
+	s.Require().NoError(err)
+	defer restored.Close()
+	_, err = snapshot.NewImportSnapshotUseCase(restored).Execute(&snapshot.ImportSnapshotInputDTO{Content: exported.Content})
+	s.Require().NoError(err)
+	root, err := state.NewUpdateStateRootUseCase(restored).Execute()
+	s.Require().NoError(err)
+	return root
+}
+
+func (s *StateSuite) TestRootFollowsEveryAdvance() {
+	admin, token, creator, _, verifier, collateral, _, _ := s.setupCommonAddresses()
+	investor01, _, _, _, _ := s.setupInvestorAddresses()
+	_, closesAt, maturityAt := s.setupTimeValues()
+
+	createUserInput := []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"creator"}}`, creator))
+	first := s.lastRoot(s.Tester.Advance(admin, createUserInput))
+	s.Equal(*first, *s.inspectRoot())
+	s.Equal(*first, *s.recomputedRoot())
+
+	createUserInput = []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"investor"}}`, investor01))
+	second := s.lastRoot(s.Tester.Advance(admin, createUserInput))
+	s.NotEqual(first.Root, second.Root)
+	s.Equal(first.Leaves+2, second.Leaves)
+	s.Equal(*second, *s.recomputedRoot())
+
+	// a rejected input leaves the root unchanged
+	rejectedOutput := s.Tester.Advance(admin, createUserInput)
+	s.Error(rejectedOutput.Err)
+	s.Equal(*second, *s.inspectRoot())
+
+	s.createSocialAccount(verifier, creatorKey, "test", "twitter")
+	createIssuanceInput := []byte(fmt.Sprintf(`{"path":"issuance/creator/create","data":{"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"%s","max_interest_rate":"1000","debt_issued":"100000","closes_at":%d,"maturity_at":%d}}`,
+		token, closesAt, maturityAt))
+	s.lastRoot(s.Tester.DepositERC20(collateral, creator, big.NewInt(10000), createIssuanceInput))
+	createOrderInput := []byte(`{"path":"order/create","data":{"issuance_id":1,"interest_rate":"900"}}`)
+	last := s.lastRoot(s.Tester.DepositERC20(token, investor01, big.NewInt(60000), createOrderInput))
+	s.Equal(*last, *s.inspectRoot())
+	s.Equal(*last, *s.recomputedRoot())
+}
+
+func (s *StateSuite) TestProofVerifiesAgainstRoot() {
+	admin, token, creator, _, verifier, collateral, _, _ := s.setupCommonAddresses()
+	_, closesAt, maturityAt := s.setupTimeValues()
+
+	createUserInput := []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"creator"}}`, creator))
+	s.Require().NoError(s.Tester.Advance(admin, createUserInput).Err)
+	s.createSocialAccount(verifier, creatorKey, "test", "twitter")
+	createIssuanceInput := []byte(fmt.Sprintf(`{"path":"issuance/creator/create","data":{"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"%s","max_interest_rate":"1000","debt_issued":"100000","closes_at":%d,"maturity_at":%d}}`,
+		token, closesAt, maturityAt))
+	root := s.lastRoot(s.Tester.DepositERC20(collateral, creator, big.NewInt(10000), createIssuanceInput))
+
+	for _, key := range []string{"issuances/1", "users/3", "user_roles/3/creator"} {
+		output := s.Tester.Inspect([]byte(fmt.Sprintf(`{"path":"state/proof","data":{"key":"%s"}}`, key)))
+		s.Require().NoError(output.Err)
+		var proof state.FindStateProofOutputDTO
+		s.Require().NoError(json.Unmarshal([]byte(s.envelopeData("state.proof", output.Reports[0].Payload)), &proof))
+
+		s.Equal(key, proof.Key)
+		s.Equal(root.Root, proof.Root)
+		s.Equal(state.LeafHash(key, proof.Content), proof.Leaf)
+		s.True(state.Verify(root.Root, proof.Leaf, proof.Siblings))
+		s.False(state.Verify(root.Root, common.Hash{}, proof.Siblings))
+	}
+
+	output := s.Tester.Inspect([]byte(`{"path":"state/proof","data":{"key":"issuances/2"}}`))
+	s.Error(output.Err)
+	s.Contains(string(output.Reports[0].Payload), `"code":"STATE_LEAF_NOT_FOUND"`)
+}
@@ -0,0 +1,21 @@
+-- The database AutoMigrate created before schema migrations existed, dumped from the baseline
+-- repository after seeding its admin and verifier and adding a few rows.
+CREATE TABLE `issuances` (`id` integer PRIMARY KEY AUTOINCREMENT,`title` text NOT NULL,`description` text NOT NULL,`promotion` text NOT NULL,`token` text NOT NULL,`creator_address` text NOT NULL,`collateral_address` text NOT NULL,`collateral_amount` text NOT NULL,`badge_address` text NOT NULL,`debt_issued` text NOT NULL,`max_interest_rate` text NOT NULL,`total_obligation` text NOT NULL DEFAULT "0",`total_raised` text NOT NULL DEFAULT "0",`state` text NOT NULL,`closes_at` integer NOT NULL,`maturity_at` integer NOT NULL,`created_at` integer NOT NULL,`updated_at` integer DEFAULT 0);
+INSERT INTO issuances VALUES(1,'test','testtesttesttesttest','testtesttesttesttest','0x0000000000000000000000000000000000000009','0x70997970C51812dc3A010C7d01b50e0d17dc79C8','0x0000000000000000000000000000000000000008','10000','0x0000000000000000000000000000000000000007','100000','1000','0','0','ongoing',1700000100,1700000200,1700000000,0);
+INSERT INTO issuances VALUES(2,'test','testtesttesttesttest','testtesttesttesttest','0x0000000000000000000000000000000000000009','0x70997970C51812dc3A010C7d01b50e0d17dc79C8','0x0000000000000000000000000000000000000008','20000','0x0000000000000000000000000000000000000007','100000','1000','0','0','settled',1700000100,1700000200,1700000001,0);
+CREATE TABLE `orders` (`id` integer PRIMARY KEY AUTOINCREMENT,`issuance_id` integer NOT NULL,`investor_address` text NOT NULL,`amount` text NOT NULL,`interest_rate` text NOT NULL,`state` text NOT NULL,`created_at` integer NOT NULL,`updated_at` integer DEFAULT 0,CONSTRAINT `fk_issuances_orders` FOREIGN KEY (`issuance_id`) REFERENCES `issuances`(`id`) ON DELETE CASCADE);
+INSERT INTO orders VALUES(1,1,'0x0000000000000000000000000000000000000001','5000','900','pending',1700000010,0);
+CREATE TABLE `users` (`id` integer PRIMARY KEY AUTOINCREMENT,`role` text NOT NULL,`address` text NOT NULL,`created_at` integer NOT NULL,`updated_at` integer DEFAULT 0);
+INSERT INTO users VALUES(1,'admin','0xD554153658E8D466428Fa48487f5aba18dF5E628',1700000000,0);
+INSERT INTO users VALUES(2,'verifier','0xc2D8eb4a934AEc7268E414a3Fa3D20E0572d714b',1700000000,0);
+INSERT INTO users VALUES(3,'creator','0x70997970C51812dc3A010C7d01b50e0d17dc79C8',1700000000,0);
+INSERT INTO users VALUES(4,'investor','0x0000000000000000000000000000000000000001',1700000000,0);
+CREATE TABLE `social_accounts` (`id` integer PRIMARY KEY AUTOINCREMENT,`user_id` integer NOT NULL,`username` text NOT NULL,`platform` text NOT NULL,`created_at` integer NOT NULL,`updated_at` integer DEFAULT 0,CONSTRAINT `fk_users_social_accounts` FOREIGN KEY (`user_id`) REFERENCES `users`(`id`) ON DELETE CASCADE);
+INSERT INTO social_accounts VALUES(1,3,'creator','twitter',1700000000,0);
+INSERT INTO sqlite_sequence VALUES('users',4);
+INSERT INTO sqlite_sequence VALUES('social_accounts',1);
+INSERT INTO sqlite_sequence VALUES('issuances',2);
+INSERT INTO sqlite_sequence VALUES('orders',1);
+CREATE INDEX `idx_orders_issuance_id` ON `orders`(`issuance_id`);
+CREATE UNIQUE INDEX `idx_users_address` ON `users`(`address`);
+CREATE UNIQUE INDEX `idx_username_platform` ON `social_accounts`(`username`,`platform`);
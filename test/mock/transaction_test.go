@@ -0,0 +1,61 @@
+package integration
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+)
+
+func TestTransactionSuite(t *testing.T) {
+	suite.Run(t, new(TransactionSuite))
+}
+
+type TransactionSuite struct {
+	DCMRollupSuite
+}
+
+func (s *TransactionSuite) TestRejectedInputIsRolledBack() {
+	admin, token, creator, _, verifier, collateral, _, _ := s.setupCommonAddresses()
+	investor01, _, _, _, _ := s.setupInvestorAddresses()
+	_, closesAt, maturityAt := s.setupTimeValues()
+
+	createUserInput := []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"creator"}}`, creator))
+	s.Require().NoError(s.Tester.Advance(admin, createUserInput).Err)
+	createUserInput = []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"investor"}}`, investor01))
+	s.Require().NoError(s.Tester.Advance(admin, createUserInput).Err)
+	s.createSocialAccount(verifier, creatorKey, "test", "twitter")
+
+	createIssuanceInput := []byte(fmt.Sprintf(`{"path":"issuance/creator/create","data":{"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"%s","max_interest_rate":"1000","debt_issued":"100000","closes_at":%d,"maturity_at":%d}}`,
+		token, closesAt, maturityAt))
+	s.Require().NoError(s.Tester.DepositERC20(collateral, creator, big.NewInt(10000), createIssuanceInput).Err)
+
+	createOrderInput := []byte(`{"path":"order/create","data":{"issuance_id":1,"interest_rate":"900"}}`)
+	s.Require().NoError(s.Tester.DepositERC20(token, investor01, big.NewInt(20000), createOrderInput).Err)
+
+	s.wait(5)
+
+	// the close rejects the order and cancels the issuance before finding it underfunded
+	anyone := common.HexToAddress("0x0000000000000000000000000000000000000001")
+	closeIssuanceInput := []byte(fmt.Sprintf(`{"path":"issuance/close","data":{"creator_address":"%s"}}`, creator))
+	closeIssuanceOutput := s.Tester.Advance(anyone, closeIssuanceInput)
+	s.Error(closeIssuanceOutput.Err)
+	s.Contains(string(closeIssuanceOutput.Reports[0].Payload), `"code":"ISSUANCE_UNDERFUNDED"`)
+
+	issuance, err := s.Repo.FindIssuanceById(1)
+	s.Require().NoError(err)
+	s.Equal("ongoing", string(issuance.State))
+	s.Zero(issuance.UpdatedAt)
+
+	order, err := s.Repo.FindOrderById(1)
+	s.Require().NoError(err)
+	s.Equal("pending", string(order.State))
+	s.Zero(order.UpdatedAt)
+
+	// the next input sees the state left by the last accepted one
+	cancelOrderOutput := s.Tester.Advance(investor01, []byte(`{"path":"order/cancel","data":{"id":1}}`))
+	s.Require().NoError(cancelOrderOutput.Err)
+	s.Contains(s.envelopeData("order.canceled", cancelOrderOutput.Notices[0].Payload), `"state":"canceled"`)
+}
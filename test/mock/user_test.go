@@ -2,6 +2,7 @@ package integration
 
 import (
 	"fmt"
+	"math/big"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -23,10 +24,10 @@ func (s *UserSuite) TestCreateUser() {
 	// create creator user
 	createUserInput := []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"creator"}}`, creator))
 	createUserOutput := s.Tester.Advance(admin, createUserInput)
-	s.Len(createUserOutput.Notices, 1)
+	s.Len(createUserOutput.Notices, 2)
 
-	expectedCreateUserOutput := fmt.Sprintf(`user created - {"id":3,"role":"creator","address":"%s","social_accounts":[],"created_at":%d}`, creator, baseTime)
-	s.Equal(expectedCreateUserOutput, string(createUserOutput.Notices[0].Payload))
+	expectedCreateUserOutput := fmt.Sprintf(`{"id":3,"roles":["creator"],"address":"%s","status":"active","social_accounts":[],"created_at":%d}`, creator, baseTime)
+	s.Equal(expectedCreateUserOutput, s.envelopeData("user.created", createUserOutput.Notices[0].Payload))
 }
 
 func (s *UserSuite) TestCreateInvestorUser() {
@@ -37,10 +38,10 @@ func (s *UserSuite) TestCreateInvestorUser() {
 	// create investor user
 	createUserInput := []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"investor"}}`, investor01))
 	createUserOutput := s.Tester.Advance(admin, createUserInput)
-	s.Len(createUserOutput.Notices, 1)
+	s.Len(createUserOutput.Notices, 2)
 
-	expectedCreateUserOutput := fmt.Sprintf(`user created - {"id":3,"role":"investor","address":"%s","social_accounts":[],"created_at":%d}`, investor01, baseTime)
-	s.Equal(expectedCreateUserOutput, string(createUserOutput.Notices[0].Payload))
+	expectedCreateUserOutput := fmt.Sprintf(`{"id":3,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d}`, investor01, baseTime)
+	s.Equal(expectedCreateUserOutput, s.envelopeData("user.created", createUserOutput.Notices[0].Payload))
 }
 
 func (s *UserSuite) TestFindAllUsers() {
@@ -61,7 +62,7 @@ func (s *UserSuite) TestFindAllUsers() {
 	findAllUsersOutput := s.Tester.Inspect(findAllUsersInput)
 	s.Len(findAllUsersOutput.Reports, 1)
 
-	expectedFindAllUsersOutput := fmt.Sprintf(`[{"id":1,"role":"admin","address":"%s","social_accounts":[],"created_at":%d,"updated_at":0},{"id":2,"role":"verifier","address":"%s","social_accounts":[],"created_at":%d,"updated_at":0},{"id":3,"role":"creator","address":"%s","social_accounts":[],"created_at":%d,"updated_at":0},{"id":4,"role":"investor","address":"%s","social_accounts":[],"created_at":%d,"updated_at":0}]`,
+	expectedFindAllUsersOutput := fmt.Sprintf(`[{"id":1,"roles":["admin"],"address":"%s","status":"active","social_accounts":[],"created_at":%d,"updated_at":0},{"id":2,"roles":["verifier"],"address":"%s","status":"active","social_accounts":[],"created_at":%d,"updated_at":0},{"id":3,"roles":["creator"],"address":"%s","status":"active","social_accounts":[],"reputation":{"score":500,"issuances":0,"settled_on_time":0,"settled_late":0,"collateral_executions":0,"cancellations":0,"total_volume":"0","updated_at":0},"created_at":%d,"updated_at":0},{"id":4,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d,"updated_at":0}]`,
 		admin,
 		baseTime,
 		common.HexToAddress("0x0000000000000000000000000000000000000025"),
@@ -70,7 +71,7 @@ func (s *UserSuite) TestFindAllUsers() {
 		baseTime,
 		investor01,
 		baseTime)
-	s.Equal(expectedFindAllUsersOutput, string(findAllUsersOutput.Reports[0].Payload))
+	s.Equal(expectedFindAllUsersOutput, s.envelopeData("user.list", findAllUsersOutput.Reports[0].Payload))
 }
 
 func (s *UserSuite) TestFindUserByAddress() {
@@ -86,15 +87,16 @@ func (s *UserSuite) TestFindUserByAddress() {
 	findUserByAddressOutput := s.Tester.Inspect(findUserByAddressInput)
 	s.Len(findUserByAddressOutput.Reports, 1)
 
-	expectedFindUserByAddressOutput := fmt.Sprintf(`{"id":3,"role":"creator","address":"%s","social_accounts":[],"created_at":%d,"updated_at":0}`,
+	expectedFindUserByAddressOutput := fmt.Sprintf(`{"id":3,"roles":["creator"],"address":"%s","status":"active","social_accounts":[],"reputation":{"score":500,"issuances":0,"settled_on_time":0,"settled_late":0,"collateral_executions":0,"cancellations":0,"total_volume":"0","updated_at":0},"created_at":%d,"updated_at":0}`,
 		creator,
 		baseTime)
-	s.Equal(expectedFindUserByAddressOutput, string(findUserByAddressOutput.Reports[0].Payload))
+	s.Equal(expectedFindUserByAddressOutput, s.envelopeData("user", findUserByAddressOutput.Reports[0].Payload))
 }
 
 func (s *UserSuite) TestDeleteUser() {
 	admin, _, _, _, _, _, _, _ := s.setupCommonAddresses()
 	investor01, _, _, _, _ := s.setupInvestorAddresses()
+	baseTime, _, _ := s.setupTimeValues()
 
 	// create investor user
 	createUserInput := []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"investor"}}`, investor01))
@@ -103,8 +105,210 @@ func (s *UserSuite) TestDeleteUser() {
 	// delete user
 	deleteUserInput := []byte(fmt.Sprintf(`{"path":"user/admin/delete","data":{"address":"%s"}}`, investor01))
 	deleteUserOutput := s.Tester.Advance(admin, deleteUserInput)
-	s.Len(deleteUserOutput.Notices, 1)
+	s.Len(deleteUserOutput.Notices, 2)
+
+	expectedDeleteUserOutput := fmt.Sprintf(`{"id":3,"roles":["investor"],"address":"%s","status":"deactivated","social_accounts":[],"deactivated_at":%d,"created_at":%d,"updated_at":%d}`,
+		investor01, baseTime, baseTime, baseTime)
+	s.Equal(expectedDeleteUserOutput, s.envelopeData("user.deactivated", deleteUserOutput.Notices[0].Payload))
+
+	// the record is kept for audit
+	findUserInput := []byte(fmt.Sprintf(`{"path":"user/address","data":{"address":"%s"}}`, investor01))
+	findUserOutput := s.Tester.Inspect(findUserInput)
+	s.NoError(findUserOutput.Err)
+	s.Equal(expectedDeleteUserOutput, s.envelopeData("user", findUserOutput.Reports[0].Payload))
+
+	// a deactivated user cannot be deactivated again
+	deleteUserOutput = s.Tester.Advance(admin, deleteUserInput)
+	s.Error(deleteUserOutput.Err)
+	s.Contains(string(deleteUserOutput.Reports[0].Payload), `"code":"USER_DEACTIVATED"`)
+}
+
+func (s *UserSuite) TestDeleteUserWithOpenPositions() {
+	admin, token, creator, _, verifier, collateral, _, _ := s.setupCommonAddresses()
+	investor01, _, _, _, _ := s.setupInvestorAddresses()
+	_, closesAt, maturityAt := s.setupTimeValues()
+
+	createUserInput := []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"creator"}}`, creator))
+	s.Tester.Advance(admin, createUserInput)
+	s.createSocialAccount(verifier, creatorKey, "test", "twitter")
+	createIssuanceInput := []byte(fmt.Sprintf(`{"path":"issuance/creator/create","data":{"title":"test","description":"testtesttesttesttest","promotion":"testtesttesttesttest","token":"%s","max_interest_rate":"1000","debt_issued":"100000","closes_at":%d,"maturity_at":%d}}`,
+		token,
+		closesAt,
+		maturityAt,
+	))
+	s.Require().NoError(s.Tester.DepositERC20(collateral, creator, big.NewInt(10000), createIssuanceInput).Err)
+
+	createInvestorInput := []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"investor"}}`, investor01))
+	s.Tester.Advance(admin, createInvestorInput)
+	createOrderInput := []byte(`{"path":"order/create","data":{"issuance_id":1,"interest_rate":"900"}}`)
+	s.Require().NoError(s.Tester.DepositERC20(token, investor01, big.NewInt(10000), createOrderInput).Err)
+
+	// the creator owes an ongoing issuance
+	deleteCreatorInput := []byte(fmt.Sprintf(`{"path":"user/admin/delete","data":{"address":"%s"}}`, creator))
+	deleteCreatorOutput := s.Tester.Advance(admin, deleteCreatorInput)
+	s.Error(deleteCreatorOutput.Err)
+	expectedDeleteCreatorOutput := fmt.Sprintf(`{"v":1,"type":"error","input_index":%d,"code":"USER_OPEN_POSITIONS","category":"conflict","message":"failed to delete user: user %s has open positions: issuance 1","params":{"positions":"issuance 1","user":"%s"}}`,
+		deleteCreatorOutput.Index, creator, creator)
+	s.Equal(expectedDeleteCreatorOutput, string(deleteCreatorOutput.Reports[0].Payload))
+
+	// the investor holds a pending order
+	deleteInvestorInput := []byte(fmt.Sprintf(`{"path":"user/admin/delete","data":{"address":"%s"}}`, investor01))
+	deleteInvestorOutput := s.Tester.Advance(admin, deleteInvestorInput)
+	s.Error(deleteInvestorOutput.Err)
+	s.Contains(string(deleteInvestorOutput.Reports[0].Payload), `has open positions: order 1`)
+
+	// once the order is canceled the investor can be deactivated
+	cancelOrderInput := []byte(`{"path":"order/cancel","data":{"id":1}}`)
+	s.Require().NoError(s.Tester.Advance(investor01, cancelOrderInput).Err)
+	s.NoError(s.Tester.Advance(admin, deleteInvestorInput).Err)
+}
+
+func (s *UserSuite) TestReactivateUser() {
+	admin, _, _, _, _, _, _, _ := s.setupCommonAddresses()
+	investor01, _, _, _, _ := s.setupInvestorAddresses()
+	baseTime, _, _ := s.setupTimeValues()
+
+	createUserInput := []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"admin"}}`, investor01))
+	s.Tester.Advance(admin, createUserInput)
+
+	reactivateUserInput := []byte(fmt.Sprintf(`{"path":"user/admin/reactivate","data":{"address":"%s"}}`, investor01))
+	reactivateUserOutput := s.Tester.Advance(admin, reactivateUserInput)
+	s.Error(reactivateUserOutput.Err)
+	s.Contains(string(reactivateUserOutput.Reports[0].Payload), `"code":"USER_NOT_DEACTIVATED"`)
+
+	deleteUserInput := []byte(fmt.Sprintf(`{"path":"user/admin/delete","data":{"address":"%s"}}`, investor01))
+	s.Require().NoError(s.Tester.Advance(admin, deleteUserInput).Err)
+
+	// deactivated users are rejected by permission checks
+	createInvestorInput := []byte(`{"path":"user/admin/create","data":{"address":"0x0000000000000000000000000000000000000009","role":"investor"}}`)
+	createInvestorOutput := s.Tester.Advance(investor01, createInvestorInput)
+	s.Error(createInvestorOutput.Err)
+	expectedCreateInvestorOutput := fmt.Sprintf(`{"v":1,"type":"error","input_index":%d,"code":"USER_DEACTIVATED","category":"forbidden","message":"user %s is deactivated","params":{"user":"%s"}}`,
+		createInvestorOutput.Index, investor01, investor01)
+	s.Equal(expectedCreateInvestorOutput, string(createInvestorOutput.Reports[0].Payload))
+
+	reactivateUserOutput = s.Tester.Advance(admin, reactivateUserInput)
+	s.NoError(reactivateUserOutput.Err)
+	expectedReactivateUserOutput := fmt.Sprintf(`{"id":3,"roles":["admin"],"address":"%s","status":"active","social_accounts":[],"created_at":%d,"updated_at":%d}`,
+		investor01, baseTime, baseTime)
+	s.Equal(expectedReactivateUserOutput, s.envelopeData("user.reactivated", reactivateUserOutput.Notices[0].Payload))
+
+	s.NoError(s.Tester.Advance(investor01, createInvestorInput).Err)
+}
+
+func (s *UserSuite) TestNoticeEnvelope() {
+	admin, _, creator, _, _, _, _, _ := s.setupCommonAddresses()
+	baseTime, _, _ := s.setupTimeValues()
+
+	// create creator user
+	createUserInput := []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"creator"}}`, creator))
+	createUserOutput := s.Tester.Advance(admin, createUserInput)
+	s.Len(createUserOutput.Notices, 2)
+
+	expectedCreateUserOutput := fmt.Sprintf(`{"v":1,"type":"user.created","input_index":%d,"data":{"id":3,"roles":["creator"],"address":"%s","status":"active","social_accounts":[],"created_at":%d}}`, createUserOutput.Index, creator, baseTime)
+	s.Equal(expectedCreateUserOutput, string(createUserOutput.Notices[0].Payload))
+}
+
+func (s *UserSuite) TestErrorEnvelope() {
+	admin, _, _, _, _, _, _, _ := s.setupCommonAddresses()
+	investor01, _, _, _, _ := s.setupInvestorAddresses()
+
+	// delete an unknown user
+	deleteUserInput := []byte(fmt.Sprintf(`{"path":"user/admin/delete","data":{"address":"%s"}}`, investor01))
+	deleteUserOutput := s.Tester.Advance(admin, deleteUserInput)
+	s.Error(deleteUserOutput.Err)
+	s.Len(deleteUserOutput.Reports, 1)
+
+	expectedDeleteUserOutput := fmt.Sprintf(`{"v":1,"type":"error","input_index":%d,"code":"USER_NOT_FOUND","category":"not_found","message":"failed to delete user: user not found"}`, deleteUserOutput.Index)
+	s.Equal(expectedDeleteUserOutput, string(deleteUserOutput.Reports[0].Payload))
+
+	// unknown route
+	unknownRouteOutput := s.Tester.Inspect([]byte(`{"path":"unknown"}`))
+	s.Error(unknownRouteOutput.Err)
+	s.Len(unknownRouteOutput.Reports, 1)
+	s.Equal(`{"v":1,"type":"error","code":"ROUTE_NOT_FOUND","category":"not_found","message":"no handler found for path: unknown"}`, string(unknownRouteOutput.Reports[0].Payload))
+}
+
+func (s *UserSuite) TestGrantUserRole() {
+	admin, _, creator, _, _, _, _, _ := s.setupCommonAddresses()
+	baseTime, _, _ := s.setupTimeValues()
+
+	// create creator user
+	createUserInput := []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"creator"}}`, creator))
+	s.Tester.Advance(admin, createUserInput)
+
+	// grant investor role to the creator
+	grantRoleInput := []byte(fmt.Sprintf(`{"path":"user/admin/grant-role","data":{"address":"%s","role":"investor"}}`, creator))
+	grantRoleOutput := s.Tester.Advance(admin, grantRoleInput)
+	s.NoError(grantRoleOutput.Err)
+	s.Len(grantRoleOutput.Notices, 2)
+
+	expectedGrantRoleOutput := fmt.Sprintf(`{"id":3,"roles":["creator","investor"],"address":"%s","status":"active","social_accounts":[],"reputation":{"score":500,"issuances":0,"settled_on_time":0,"settled_late":0,"collateral_executions":0,"cancellations":0,"total_volume":"0","updated_at":0},"created_at":%d,"updated_at":%d}`, creator, baseTime, baseTime)
+	s.Equal(expectedGrantRoleOutput, s.envelopeData("user.role_granted", grantRoleOutput.Notices[0].Payload))
+
+	// the creator can now cancel orders as an investor, failing only because the order doesn't exist
+	cancelOrderInput := []byte(`{"path":"order/cancel","data":{"id":1}}`)
+	cancelOrderOutput := s.Tester.Advance(creator, cancelOrderInput)
+	s.Error(cancelOrderOutput.Err)
+	s.Contains(string(cancelOrderOutput.Reports[0].Payload), `"code":"ORDER_NOT_FOUND"`)
+
+	// granting the same role again is a conflict
+	grantRoleOutput = s.Tester.Advance(admin, grantRoleInput)
+	s.Error(grantRoleOutput.Err)
+	s.Contains(string(grantRoleOutput.Reports[0].Payload), `"code":"ROLE_ALREADY_GRANTED"`)
+}
+
+func (s *UserSuite) TestRevokeUserRole() {
+	admin, _, creator, _, _, _, _, _ := s.setupCommonAddresses()
+
+	// create creator user with the investor role
+	createUserInput := []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"creator"}}`, creator))
+	s.Tester.Advance(admin, createUserInput)
+	grantRoleInput := []byte(fmt.Sprintf(`{"path":"user/admin/grant-role","data":{"address":"%s","role":"investor"}}`, creator))
+	s.Tester.Advance(admin, grantRoleInput)
+
+	// revoke the creator role
+	revokeRoleInput := []byte(fmt.Sprintf(`{"path":"user/admin/revoke-role","data":{"address":"%s","role":"creator"}}`, creator))
+	revokeRoleOutput := s.Tester.Advance(admin, revokeRoleInput)
+	s.NoError(revokeRoleOutput.Err)
+	s.Len(revokeRoleOutput.Notices, 2)
+	s.Contains(s.envelopeData("user.role_revoked", revokeRoleOutput.Notices[0].Payload), `"roles":["investor"]`)
+
+	// creating an issuance now lacks the issuance.create permission
+	createIssuanceInput := []byte(`{"path":"issuance/creator/create","data":{}}`)
+	createIssuanceOutput := s.Tester.Advance(creator, createIssuanceInput)
+	s.Error(createIssuanceOutput.Err)
+	s.Contains(string(createIssuanceOutput.Reports[0].Payload), `"params":{"permissions":"issuance.create"`)
+
+	// the last role cannot be revoked
+	revokeRoleInput = []byte(fmt.Sprintf(`{"path":"user/admin/revoke-role","data":{"address":"%s","role":"investor"}}`, creator))
+	revokeRoleOutput = s.Tester.Advance(admin, revokeRoleInput)
+	s.Error(revokeRoleOutput.Err)
+	s.Contains(string(revokeRoleOutput.Reports[0].Payload), `"code":"LAST_ROLE"`)
+}
+
+func (s *UserSuite) TestUpdateUserRole() {
+	admin, _, creator, _, _, _, _, _ := s.setupCommonAddresses()
+	baseTime, _, _ := s.setupTimeValues()
+
+	createUserInput := []byte(fmt.Sprintf(`{"path":"user/admin/create","data":{"address":"%s","role":"creator"}}`, creator))
+	s.Tester.Advance(admin, createUserInput)
+	grantRoleInput := []byte(fmt.Sprintf(`{"path":"user/admin/grant-role","data":{"address":"%s","role":"verifier"}}`, creator))
+	s.Require().NoError(s.Tester.Advance(admin, grantRoleInput).Err)
+
+	// every other role is replaced
+	updateRoleInput := []byte(fmt.Sprintf(`{"path":"user/admin/update-role","data":{"address":"%s","role":"investor"}}`, creator))
+	updateRoleOutput := s.Tester.Advance(admin, updateRoleInput)
+	s.NoError(updateRoleOutput.Err)
+	expectedUpdateRoleOutput := fmt.Sprintf(`{"id":3,"roles":["investor"],"address":"%s","status":"active","social_accounts":[],"created_at":%d,"updated_at":%d}`, creator, baseTime, baseTime)
+	s.Equal(expectedUpdateRoleOutput, s.envelopeData("user.role_updated", updateRoleOutput.Notices[0].Payload))
+
+	updateRoleOutput = s.Tester.Advance(admin, updateRoleInput)
+	s.Error(updateRoleOutput.Err)
+	s.Contains(string(updateRoleOutput.Reports[0].Payload), `"code":"ROLE_ALREADY_GRANTED"`)
 
-	expectedDeleteUserOutput := fmt.Sprintf(`user deleted - {"address":"%s"}`, investor01)
-	s.Equal(expectedDeleteUserOutput, string(deleteUserOutput.Notices[0].Payload))
+	// only role managers can update roles
+	updateRoleOutput = s.Tester.Advance(creator, updateRoleInput)
+	s.Error(updateRoleOutput.Err)
+	s.Contains(string(updateRoleOutput.Reports[0].Payload), `"code":"PERMISSION_DENIED"`)
 }